@@ -59,6 +59,14 @@ const (
 	AlicloudProtocolTypeNFS = AlicloudProtocolType("NFS")
 )
 
+// +kubebuilder:validation:Enum=Premium_LRS;Premium_ZRS
+type AzureNfsSku string
+
+const (
+	AzureNfsSkuPremiumLRS = AzureNfsSku("Premium_LRS")
+	AzureNfsSkuPremiumZRS = AzureNfsSku("Premium_ZRS")
+)
+
 // NfsInstanceSpec defines the desired state of NfsInstance
 // +kubebuilder:validation:XValidation:rule=self.ipRange.name != "", message="IpRange is required."
 // +kubebuilder:validation:XValidation:rule=self.remoteRef.name != "", message="RemoteRef is required."
@@ -100,6 +108,14 @@ type NfsInstanceInfo struct {
 type NfsInstanceGcp NfsOptionsGcp
 
 type NfsInstanceAzure struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=102400
+	CapacityGb int `json:"capacityGb"`
+
+	// +kubebuilder:default=Premium_LRS
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Sku is immutable."
+	Sku AzureNfsSku `json:"sku,omitempty"`
 }
 
 type NfsInstanceOpenStack struct {
@@ -36,9 +36,19 @@ const (
 	NukeResourceStatusDeleted    NukeResourceStatus = "Deleted"
 )
 
+// NukeStateDryRunCompleted is the Nuke status state once all orphaned resources
+// of a dry run Nuke are discovered and reported in the status.
+const NukeStateDryRunCompleted = "DryRunCompleted"
+
 // NukeSpec defines the desired state of Nuke
 type NukeSpec struct {
 	Scope ScopeRef `json:"scope"`
+
+	// DryRun only discovers the orphaned resources and reports them in the status
+	// without deleting anything. Setting it to false on a Nuke in DryRunCompleted
+	// state continues with the deletion.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 // NukeStatus defines the observed state of Nuke
@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=RedisInstance;RedisCluster;AzureManagedRedis
+type RedisUserTargetKind string
+
+const (
+	RedisUserTargetKindRedisInstance     = RedisUserTargetKind("RedisInstance")
+	RedisUserTargetKindRedisCluster      = RedisUserTargetKind("RedisCluster")
+	RedisUserTargetKindAzureManagedRedis = RedisUserTargetKind("AzureManagedRedis")
+)
+
+// RedisUserTarget references the KCP redis object in the same namespace the user is created in.
+type RedisUserTarget struct {
+	// +kubebuilder:validation:Required
+	Kind RedisUserTargetKind `json:"kind"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+type RedisUserAzure struct {
+	// ObjectId is the Entra ID object id of the principal the access policy is assigned to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="ObjectId is immutable."
+	ObjectId string `json:"objectId"`
+}
+
+// RedisUserSpec defines the desired state of RedisUser
+type RedisUserSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="RemoteRef is immutable."
+	RemoteRef RemoteRef `json:"remoteRef"`
+
+	// +kubebuilder:validation:Required
+	Scope ScopeRef `json:"scope"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Redis is immutable."
+	Redis RedisUserTarget `json:"redis"`
+
+	// UserName is the name clients authenticate with.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="UserName is immutable."
+	// +kubebuilder:validation:XValidation:rule=(self != 'default'), message="UserName default is reserved."
+	UserName string `json:"userName"`
+
+	// KeyPatterns are the ACL key patterns the user is allowed to access, for example "app1:*".
+	// +optional
+	KeyPatterns []string `json:"keyPatterns,omitempty"`
+
+	// Commands are the ACL command rules granted to the user, for example "+@read" or "-flushall".
+	// +optional
+	Commands []string `json:"commands,omitempty"`
+
+	// +optional
+	Azure *RedisUserAzure `json:"azure,omitempty"`
+}
+
+// RedisUserStatus defines the observed state of RedisUser
+type RedisUserStatus struct {
+	// Id is the cloud provider identifier of the user or access policy assignment.
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Endpoint is the host:port of the referenced redis.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// AuthString is the password of the user. Empty for token based authentication.
+	// +optional
+	AuthString string `json:"authString,omitempty"`
+
+	// +optional
+	State StatusState `json:"state,omitempty"`
+
+	// List of status conditions to indicate the status of a RedisUser.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope.name"
+// +kubebuilder:printcolumn:name="Redis",type="string",JSONPath=".spec.redis.name"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// RedisUser is the Schema for the redisusers API
+type RedisUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisUserSpec   `json:"spec,omitempty"`
+	Status RedisUserStatus `json:"status,omitempty"`
+}
+
+func (in *RedisUser) ScopeRef() ScopeRef {
+	return in.Spec.Scope
+}
+
+func (in *RedisUser) SetScopeRef(scopeRef ScopeRef) {
+	in.Spec.Scope = scopeRef
+}
+
+func (in *RedisUser) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *RedisUser) ObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *RedisUser) SetObservedGeneration(v int64) {
+	in.Status.ObservedGeneration = v
+}
+
+func (in *RedisUser) GetStatus() any {
+	return &in.Status
+}
+
+func (in *RedisUser) State() string {
+	return string(in.Status.State)
+}
+
+func (in *RedisUser) SetState(v string) {
+	in.Status.State = StatusState(v)
+}
+
+// +kubebuilder:object:root=true
+
+// RedisUserList contains a list of RedisUser
+type RedisUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisUser `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RedisUser{}, &RedisUserList{})
+}
@@ -20,6 +20,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// LabelSkrStatusFailingPrefix prefixes the label set on an SkrStatus for each controller
+	// whose last run reconciled objects in Error state or got a reconcile error. The name
+	// part of the label is the lowercase reconciled kind, ie
+	// failing.skrstatus.cloud-control.kyma-project.io/gcpredisinstance=true
+	LabelSkrStatusFailingPrefix = "failing.skrstatus.cloud-control.kyma-project.io/"
+)
+
 type SkrStatusCondition struct {
 	Title           string   `json:"title"`
 	ObjKindGroup    string   `json:"objKindGroup"`
@@ -50,14 +58,56 @@ type SkrStatusSpec struct {
 	Conditions []SkrStatusCondition `json:"conditions"`
 }
 
+// SkrStatusControllerResult is the outcome of one SKR controller during the last SKR run
+// it was started in.
+type SkrStatusControllerResult struct {
+	// ObjKindGroup is the lowercase kind.group of the reconciled kind
+	ObjKindGroup string `json:"objKindGroup"`
+
+	// ObjectsReconciled is the number of distinct objects reconciled during the run
+	ObjectsReconciled int `json:"objectsReconciled"`
+
+	// ObjectsInError is the number of reconciled objects last seen in Error state
+	ObjectsInError int `json:"objectsInError"`
+
+	// LastError is the last reconcile error, or the Error condition message of an object in Error state
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// DurationMilliseconds is the total time spent in reconcile calls
+	DurationMilliseconds int64 `json:"durationMilliseconds"`
+
+	// TimedOut is true if the controller was still reconciling when the run hit the SKR worker timeout
+	// +optional
+	TimedOut bool `json:"timedOut,omitempty"`
+}
+
 // SkrStatusStatus defines the observed state of SkrStatus.
 type SkrStatusStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// LastRunTime is the time the last SKR run completed
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// TimedOut is true if the last SKR run hit the SKR worker timeout
+	// +optional
+	TimedOut bool `json:"timedOut,omitempty"`
+
+	// FailingControllers is the number of controllers with objects in Error state or reconcile errors
+	// +optional
+	FailingControllers int `json:"failingControllers,omitempty"`
+
+	// Controllers holds the outcome of each controller started in the last full SKR run,
+	// updated by the notification-driven runs narrowed to some controllers
+	// +optional
+	Controllers []SkrStatusControllerResult `json:"controllers,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider"
+// +kubebuilder:printcolumn:name="Failing",type="integer",JSONPath=".status.failingControllers"
+// +kubebuilder:printcolumn:name="TimedOut",type="boolean",JSONPath=".status.timedOut"
+// +kubebuilder:printcolumn:name="LastRun",type="date",JSONPath=".status.lastRunTime"
 
 // SkrStatus is the Schema for the skrstatuses API.
 type SkrStatus struct {
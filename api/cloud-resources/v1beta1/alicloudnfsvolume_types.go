@@ -0,0 +1,176 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/elliotchance/pie/v2"
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:validation:Enum=Performance;Capacity
+type AlicloudNfsStorageType string
+
+const (
+	AlicloudNfsStorageTypePerformance = AlicloudNfsStorageType("Performance")
+	AlicloudNfsStorageTypeCapacity    = AlicloudNfsStorageType("Capacity")
+)
+
+// AlicloudNfsVolumeSpec defines the desired state of AlicloudNfsVolume
+type AlicloudNfsVolumeSpec struct {
+
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="IpRange is immutable."
+	IpRange IpRangeRef `json:"ipRange"`
+
+	// CapacityGb is the size declared on the PersistentVolume and PersistentVolumeClaim.
+	// General-purpose NAS file systems grow elastically, so it is not enforced as a limit.
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=102400
+	CapacityGb int `json:"capacityGb"`
+
+	// +kubebuilder:default=Performance
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="StorageType is immutable."
+	StorageType AlicloudNfsStorageType `json:"storageType,omitempty"`
+
+	PersistentVolume *AlicloudNfsVolumePvSpec `json:"volume,omitempty"`
+
+	PersistentVolumeClaim *AlicloudNfsVolumePvcSpec `json:"volumeClaim,omitempty"`
+}
+
+type AlicloudNfsVolumePvSpec struct {
+	Name        string            `json:"name,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type AlicloudNfsVolumePvcSpec struct {
+	Name        string            `json:"name,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AlicloudNfsVolumeStatus defines the observed state of AlicloudNfsVolume
+type AlicloudNfsVolumeStatus struct {
+
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Capacity is the size currently used on the NAS file system
+	// +optional
+	Capacity resource.Quantity `json:"capacity"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="Capacity",type="string",JSONPath=".spec.capacityGb"
+// +kubebuilder:printcolumn:name="Used",type="string",JSONPath=".status.capacity"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AlicloudNfsVolume is the Schema for the alicloudnfsvolumes API
+type AlicloudNfsVolume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlicloudNfsVolumeSpec   `json:"spec,omitempty"`
+	Status AlicloudNfsVolumeStatus `json:"status,omitempty"`
+}
+
+func (in *AlicloudNfsVolume) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AlicloudNfsVolume) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AlicloudNfsVolume) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureNfs
+}
+
+func (in *AlicloudNfsVolume) SpecificToProviders() []string {
+	return []string{"alicloud"}
+}
+
+func (in *AlicloudNfsVolume) GetIpRangeRef() IpRangeRef {
+	return in.Spec.IpRange
+}
+
+func (in *AlicloudNfsVolume) State() string {
+	return in.Status.State
+}
+
+func (in *AlicloudNfsVolume) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AlicloudNfsVolume) CloneForPatchStatus() client.Object {
+	return &AlicloudNfsVolume{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AlicloudNfsVolume",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// AlicloudNfsVolumeList contains a list of AlicloudNfsVolume
+type AlicloudNfsVolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlicloudNfsVolume `json:"items"`
+}
+
+func (l *AlicloudNfsVolumeList) GetItemCount() int {
+	return len(l.Items)
+}
+
+func (l *AlicloudNfsVolumeList) GetItems() []client.Object {
+	return pie.Map(l.Items, func(item AlicloudNfsVolume) client.Object {
+		return &item
+	})
+}
+
+func init() {
+	SchemeBuilder.Register(&AlicloudNfsVolume{}, &AlicloudNfsVolumeList{})
+}
@@ -96,6 +96,22 @@ type AwsNfsBackupScheduleSpec struct {
 	// By default, deleteCascade will be false
 	// +kubebuilder:default=false
 	DeleteCascade bool `json:"deleteCascade,omitempty"`
+
+	// Verify enables the periodic test restore of the backups created by this schedule.
+	// The verified backup is restored into a temporary volume, and the result is recorded in the backup status.
+	// +optional
+	Verify *BackupVerification `json:"verify,omitempty"`
+
+	// RetentionPolicy specifies the grandfather-father-son retention of the Ready backups, for example,
+	// 7 daily, 4 weekly and 12 monthly backups.
+	// When set, MaxRetentionDays and MaxReadyBackups are not applied to Ready backups.
+	// +optional
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// Hooks specifies the commands executed in the application pods before and after each backup
+	// created by this schedule is triggered, to make the backups application-consistent.
+	// +optional
+	Hooks *BackupHooks `json:"hooks,omitempty"`
 }
 
 // AwsNfsBackupScheduleStatus defines the observed state of AwsNfsBackupSchedule
@@ -249,6 +265,12 @@ func (sc *AwsNfsBackupSchedule) GetMaxFailedBackups() int {
 func (sc *AwsNfsBackupSchedule) SetMaxFailedBackups(count int) {
 	sc.Spec.MaxFailedBackups = count
 }
+func (sc *AwsNfsBackupSchedule) GetVerify() *BackupVerification {
+	return sc.Spec.Verify
+}
+func (sc *AwsNfsBackupSchedule) GetRetentionPolicy() *RetentionPolicy {
+	return sc.Spec.RetentionPolicy
+}
 
 func (sc *AwsNfsBackupSchedule) GetNextRunTimes() []string {
 	return sc.Status.NextRunTimes
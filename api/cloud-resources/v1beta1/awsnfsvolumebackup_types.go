@@ -37,6 +37,12 @@ type AwsNfsVolumeBackupSpec struct {
 	// AWS Region Code (as specified in https://docs.aws.amazon.com/global-infrastructure/latest/regions/aws-regions.html#available-regions) where this backup should be created.
 	// If not specified, region of the AwsNfsVolume is used for the backup.
 	Location string `json:"location"`
+
+	// Hooks specifies the commands executed in the application pods before and after the backup is triggered,
+	// to make the backup application-consistent.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Hooks are immutable."
+	Hooks *BackupHooks `json:"hooks,omitempty"`
 }
 
 type AwsNfsVolumeBackupSource struct {
@@ -125,6 +131,14 @@ type AwsNfsVolumeBackupStatus struct {
 	// AWS locations where the backups are created.
 	// +optional
 	Locations []string `json:"locations"`
+
+	// Verification records the result of the test restore of this backup by its backup schedule
+	// +optional
+	Verification *BackupVerificationStatus `json:"verification,omitempty"`
+
+	// Hooks records the outcome of the backup hooks
+	// +optional
+	Hooks *BackupHooksStatus `json:"hooks,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -148,6 +162,26 @@ func (in *AwsNfsVolumeBackup) SetState(v string) {
 	in.Status.State = v
 }
 
+func (in *AwsNfsVolumeBackup) GetVerification() *BackupVerificationStatus {
+	return in.Status.Verification
+}
+
+func (in *AwsNfsVolumeBackup) SetVerification(v *BackupVerificationStatus) {
+	in.Status.Verification = v
+}
+
+func (in *AwsNfsVolumeBackup) GetHooks() *BackupHooks {
+	return in.Spec.Hooks
+}
+
+func (in *AwsNfsVolumeBackup) GetHooksStatus() *BackupHooksStatus {
+	return in.Status.Hooks
+}
+
+func (in *AwsNfsVolumeBackup) SetHooksStatus(v *BackupHooksStatus) {
+	in.Status.Hooks = v
+}
+
 func (in *AwsNfsVolumeBackup) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
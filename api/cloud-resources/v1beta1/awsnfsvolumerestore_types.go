@@ -31,6 +31,11 @@ type AwsNfsVolumeRestoreSpec struct {
 	// Source specifies the backup which is getting restored. It also indirectly specifies the backup's source volume.
 	// +kubebuilder:validation:Required
 	Source AwsNfsVolumeRestoreSource `json:"source"`
+
+	// Destination specifies the AwsNfsVolume the backup is restored into.
+	// If not specified, the backup is restored in place into its source volume.
+	// +optional
+	Destination *AwsNfsVolumeRestoreDestination `json:"destination,omitempty"`
 }
 
 type AwsNfsVolumeRestoreSource struct {
@@ -39,6 +44,12 @@ type AwsNfsVolumeRestoreSource struct {
 	Backup BackupRef `json:"backup"`
 }
 
+type AwsNfsVolumeRestoreDestination struct {
+	// Volume specifies the AwsNfsVolume resource that the backup is restored into.
+	// +kubebuilder:validation:Required
+	Volume VolumeRef `json:"volume"`
+}
+
 type BackupRef struct {
 	// Name specifies the name of the AwsNfsBackup resource.
 	// +kubebuilder:validation:Required
@@ -0,0 +1,172 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/elliotchance/pie/v2"
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:validation:Enum=PremiumLRS;PremiumZRS
+type AzureNfsTier string
+
+const (
+	AzureNfsTierPremiumLRS = AzureNfsTier("PremiumLRS")
+	AzureNfsTierPremiumZRS = AzureNfsTier("PremiumZRS")
+)
+
+// AzureNfsVolumeSpec defines the desired state of AzureNfsVolume
+type AzureNfsVolumeSpec struct {
+
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="IpRange is immutable."
+	IpRange IpRangeRef `json:"ipRange"`
+
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=102400
+	CapacityGb int `json:"capacityGb"`
+
+	// +kubebuilder:default=PremiumLRS
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Tier is immutable."
+	Tier AzureNfsTier `json:"tier,omitempty"`
+
+	PersistentVolume *AzureNfsVolumePvSpec `json:"volume,omitempty"`
+
+	PersistentVolumeClaim *AzureNfsVolumePvcSpec `json:"volumeClaim,omitempty"`
+}
+
+type AzureNfsVolumePvSpec struct {
+	Name        string            `json:"name,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type AzureNfsVolumePvcSpec struct {
+	Name        string            `json:"name,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AzureNfsVolumeStatus defines the observed state of AzureNfsVolume
+type AzureNfsVolumeStatus struct {
+
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// +optional
+	Capacity resource.Quantity `json:"capacity"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="Capacity",type="string",JSONPath=".spec.capacityGb"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AzureNfsVolume is the Schema for the azurenfsvolumes API
+type AzureNfsVolume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureNfsVolumeSpec   `json:"spec,omitempty"`
+	Status AzureNfsVolumeStatus `json:"status,omitempty"`
+}
+
+func (in *AzureNfsVolume) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AzureNfsVolume) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AzureNfsVolume) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureNfs
+}
+
+func (in *AzureNfsVolume) SpecificToProviders() []string {
+	return []string{"azure"}
+}
+
+func (in *AzureNfsVolume) GetIpRangeRef() IpRangeRef {
+	return in.Spec.IpRange
+}
+
+func (in *AzureNfsVolume) State() string {
+	return in.Status.State
+}
+
+func (in *AzureNfsVolume) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AzureNfsVolume) CloneForPatchStatus() client.Object {
+	return &AzureNfsVolume{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AzureNfsVolume",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// AzureNfsVolumeList contains a list of AzureNfsVolume
+type AzureNfsVolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureNfsVolume `json:"items"`
+}
+
+func (l *AzureNfsVolumeList) GetItemCount() int {
+	return len(l.Items)
+}
+
+func (l *AzureNfsVolumeList) GetItems() []client.Object {
+	return pie.Map(l.Items, func(item AzureNfsVolume) client.Object {
+		return &item
+	})
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureNfsVolume{}, &AzureNfsVolumeList{})
+}
@@ -95,6 +95,17 @@ type AzureRwxBackupScheduleSpec struct {
 	// By default, deleteCascade will be false
 	// +kubebuilder:default=false
 	DeleteCascade bool `json:"deleteCascade,omitempty"`
+
+	// Verify enables the periodic test restore of the backups created by this schedule.
+	// The verified backup is restored into a temporary volume, and the result is recorded in the backup status.
+	// +optional
+	Verify *BackupVerification `json:"verify,omitempty"`
+
+	// RetentionPolicy specifies the grandfather-father-son retention of the Ready backups, for example,
+	// 7 daily, 4 weekly and 12 monthly backups.
+	// When set, MaxRetentionDays and MaxReadyBackups are not applied to Ready backups.
+	// +optional
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
 }
 
 // AzureRwxBackupScheduleStatus defines the observed state of AzureRwxBackupSchedule
@@ -248,6 +259,12 @@ func (sc *AzureRwxBackupSchedule) GetMaxFailedBackups() int {
 func (sc *AzureRwxBackupSchedule) SetMaxFailedBackups(count int) {
 	sc.Spec.MaxFailedBackups = count
 }
+func (sc *AzureRwxBackupSchedule) GetVerify() *BackupVerification {
+	return sc.Spec.Verify
+}
+func (sc *AzureRwxBackupSchedule) GetRetentionPolicy() *RetentionPolicy {
+	return sc.Spec.RetentionPolicy
+}
 
 func (sc *AzureRwxBackupSchedule) GetNextRunTimes() []string {
 	return sc.Status.NextRunTimes
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -93,6 +94,14 @@ type AzureRwxVolumeBackupStatus struct {
 	// +optional
 	Id string `json:"id,omitempty"`
 
+	// StartTime specifies the time when the backup was triggered
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Capacity specifies the size of the recovery point
+	// +optional
+	Capacity resource.Quantity `json:"capacity"`
+
 	// RecoveryPointId specifies the corresponding snapshot used for restore
 	// +optional
 	RecoveryPointId string `json:"recoveryPointId,omitempty"`
@@ -100,6 +109,10 @@ type AzureRwxVolumeBackupStatus struct {
 	// StorageAccountPath specifies the Azure Storage Account path
 	// +optional
 	StorageAccountPath string `json:"storageAccountPath,omitempty"`
+
+	// Verification records the result of the test restore of this backup by its backup schedule
+	// +optional
+	Verification *BackupVerificationStatus `json:"verification,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -123,6 +136,14 @@ func (bu *AzureRwxVolumeBackup) SetState(v string) {
 	bu.Status.State = AzureRwxBackupState(v)
 }
 
+func (bu *AzureRwxVolumeBackup) GetVerification() *BackupVerificationStatus {
+	return bu.Status.Verification
+}
+
+func (bu *AzureRwxVolumeBackup) SetVerification(v *BackupVerificationStatus) {
+	bu.Status.Verification = v
+}
+
 func (bu *AzureRwxVolumeBackup) Conditions() *[]metav1.Condition {
 	return &bu.Status.Conditions
 }
@@ -170,4 +170,9 @@ const (
 	ConditionReasonRestoreJobInvalidStatus         = "RestoreJobInvalidStatus"
 	ConditionReasonRestoreJobCompletedWithWarnings = "RestoreJobCompletedWithWarnings"
 	ConditionReasonErrorStartingRestore            = "ErrorStartingRestore"
+	ConditionReasonBackupJobNotFound               = "BackupJobNotFound"
+	ConditionReasonBackupJobFailed                 = "BackupJobFailed"
+	ConditionReasonBackupJobCancelled              = "BackupJobCancelled"
+	ConditionReasonRecoveryPointNotFound           = "RecoveryPointNotFound"
+	ConditionReasonRecoveryPointDeleteFailed       = "RecoveryPointDeleteFailed"
 )
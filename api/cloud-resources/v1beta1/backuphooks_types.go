@@ -0,0 +1,102 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	BackupHookFailurePolicyFail   = "Fail"
+	BackupHookFailurePolicyIgnore = "Ignore"
+)
+
+const (
+	BackupHookSucceeded = "Succeeded"
+	BackupHookFailed    = "Failed"
+)
+
+// BackupHooks configures the commands executed in the application pods before and after
+// the cloud backup is triggered, so that the workload can be quiesced and the backup is
+// application-consistent. The commands are executed by the cloud manager through the
+// Kubernetes API of the cluster, the same way as `kubectl exec`.
+// +kubebuilder:validation:XValidation:rule=(has(self.pre) || has(self.post)), message="At least one of pre or post hooks must be specified."
+type BackupHooks struct {
+	// PodSelector selects the pods in the namespace of the backup that the hooks are executed in.
+	// Only running pods with the cloud-resources.kyma-project.io/backupHooks: "true" label are selected.
+	// +kubebuilder:validation:Required
+	PodSelector metav1.LabelSelector `json:"podSelector"`
+
+	// Container specifies the name of the container the hooks are executed in.
+	// If not specified, the first container of the pod is used.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Pre specifies the hook executed before the cloud backup is triggered.
+	// +optional
+	Pre *BackupHook `json:"pre,omitempty"`
+
+	// Post specifies the hook executed after the cloud backup is triggered.
+	// It is also executed if the pre hook or the backup failed, so that the workload is always resumed.
+	// +optional
+	Post *BackupHook `json:"post,omitempty"`
+}
+
+type BackupHook struct {
+	// Command specifies the command and its arguments executed in the container.
+	// The command is not run in a shell.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+
+	// Timeout specifies how long the command may run in each pod before it is considered failed.
+	// If not provided, it will be defaulted to 30 seconds.
+	// +optional
+	// +kubebuilder:default="30s"
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// FailurePolicy specifies how a failure of the command in any of the selected pods is handled.
+	// Fail marks the backup as failed, and Ignore continues with the backup.
+	// If not provided, it will be defaulted to Fail.
+	// +optional
+	// +kubebuilder:default=Fail
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+}
+
+// BackupHooksStatus records the outcome of the backup hooks.
+type BackupHooksStatus struct {
+	// +optional
+	Pre *BackupHookStatus `json:"pre,omitempty"`
+
+	// +optional
+	Post *BackupHookStatus `json:"post,omitempty"`
+}
+
+// BackupHookStatus records the outcome of a backup hook in all selected pods.
+type BackupHookStatus struct {
+	// +kubebuilder:validation:Enum=Succeeded;Failed
+	State string `json:"state,omitempty"`
+
+	// CompletionTime specifies the time when the hook completed in all selected pods
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Pods lists the outcome of the hook in each of the selected pods
+	// +optional
+	Pods []BackupHookPodStatus `json:"pods,omitempty"`
+
+	// Message describes why the hook failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type BackupHookPodStatus struct {
+	// Name of the pod
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Enum=Succeeded;Failed
+	State string `json:"state"`
+
+	// Message contains the error and the tail of the command's stderr if the hook failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,47 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	BackupVerificationInProgress = "InProgress"
+	BackupVerificationSucceeded  = "Succeeded"
+	BackupVerificationFailed     = "Failed"
+)
+
+// BackupVerification configures the periodic test restore of the backups created by a backup schedule.
+// The verified backup is restored into a temporary volume using the provider's restore resource,
+// and the temporary volume is deleted once the restore completes.
+type BackupVerification struct {
+	// Every specifies that every N-th backup created by the schedule is verified.
+	// If not provided, every backup is verified.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Every int `json:"every,omitempty"`
+
+	// Timeout specifies how long the test restore may take before the verification is considered failed.
+	// If not provided, it will be defaulted to 6 hours.
+	// +optional
+	// +kubebuilder:default="6h"
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// BackupVerificationStatus records the result of the test restore of a backup.
+type BackupVerificationStatus struct {
+	// +kubebuilder:validation:Enum=InProgress;Succeeded;Failed
+	State string `json:"state,omitempty"`
+
+	// StartTime specifies the time when the test restore was started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime specifies the time when the verification succeeded or failed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message describes why the verification failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
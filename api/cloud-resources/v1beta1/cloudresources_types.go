@@ -68,6 +68,26 @@ type CloudResourcesStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Effective quotas in this cluster and their current usage
+	// +optional
+	// +listType=atomic
+	Quotas []QuotaUsage `json:"quotas,omitempty"`
+}
+
+// QuotaUsage is the effective limit and the current usage of one quota of one kind
+type QuotaUsage struct {
+	// Kind of the cloud resources the quota applies to
+	Kind string `json:"kind"`
+
+	// Name of the quota, one of totalCount, totalCapacityGb or totalMemoryGb
+	Name string `json:"name"`
+
+	// Limit is the effective value of the quota in this cluster
+	Limit int `json:"limit"`
+
+	// Used is the current usage of the quota by the resources of the kind
+	Used int `json:"used"`
 }
 
 // +kubebuilder:object:root=true
@@ -57,6 +57,8 @@ const (
 
 const (
 	ConditionTypeQuotaExceeded = "QuotaExceeded"
+
+	ConditionReasonQuotaExceededOnResize = "QuotaExceededOnResize"
 )
 
 const (
@@ -71,6 +73,7 @@ const (
 	ReasonInvalidStartTime      = "InvalidStartTime"
 	ReasonInvalidEndTime        = "InvalidEndTime"
 	ReasonBackupFailed          = "BackupFailed"
+	ReasonBackupHookFailed      = "BackupHookFailed"
 )
 
 const (
@@ -105,6 +105,50 @@ type GcpNfsBackupScheduleSpec struct {
 	// +kubebuilder:validation:MaxItems=10
 	// +kubebuilder:validation:XValidation:rule="(self.all(x, x == 'all') || self.all(x, x != 'all'))", message="The value 'all' cannot be combined with other values."
 	AccessibleFrom []string `json:"accessibleFrom,omitempty"`
+
+	// Copies specifies additional GCP regions where a copy of each scheduled backup is created,
+	// e.g. for disaster recovery. Each copy is a separate GcpNfsVolumeBackup object
+	// and is retained according to the retention settings of its copy entry.
+	// +optional
+	// +listType=map
+	// +listMapKey=location
+	// +kubebuilder:validation:MaxItems=3
+	Copies []GcpNfsBackupScheduleCopy `json:"copies,omitempty"`
+
+	// Verify enables the periodic test restore of the backups created by this schedule.
+	// The verified backup is restored into a temporary volume, and the result is recorded in the backup status.
+	// +optional
+	Verify *BackupVerification `json:"verify,omitempty"`
+
+	// RetentionPolicy specifies the grandfather-father-son retention of the Ready backups, for example,
+	// 7 daily, 4 weekly and 12 monthly backups.
+	// When set, MaxRetentionDays and MaxReadyBackups are not applied to Ready backups.
+	// +optional
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// Hooks specifies the commands executed in the application pods before and after each backup
+	// created by this schedule is triggered, to make the backups application-consistent.
+	// +optional
+	Hooks *BackupHooks `json:"hooks,omitempty"`
+}
+
+// GcpNfsBackupScheduleCopy defines an additional region where the scheduled backups are copied to
+type GcpNfsBackupScheduleCopy struct {
+	// GCP Region Name (as specified in https://cloud.google.com/filestore/docs/regions) where the copy should be created.
+	// +kubebuilder:validation:Required
+	Location string `json:"location"`
+
+	// MaxRetentionDays specifies the maximum number of days to retain the copies in this location.
+	// If not provided, MaxRetentionDays of the schedule is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRetentionDays int `json:"maxRetentionDays,omitempty"`
+
+	// MaxReadyBackups specifies the maximum number of copies in "Ready" state to be retained in this location.
+	// If not provided, MaxReadyBackups of the schedule is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReadyBackups int `json:"maxReadyBackups,omitempty"`
 }
 
 // GcpNfsBackupScheduleStatus defines the observed state of GcpNfsBackupSchedule
@@ -135,6 +179,10 @@ type GcpNfsBackupScheduleStatus struct {
 	// +optional
 	LastCreatedBackup corev1.ObjectReference `json:"lastCreatedBackup,omitempty"`
 
+	// LastCreatedCopies contains the object references of the backup copies created during last run.
+	// +optional
+	LastCreatedCopies []corev1.ObjectReference `json:"lastCreatedCopies,omitempty"`
+
 	// LastDeleteRun specifies the time when the backups exceeding the retention period were deleted
 	// +optional
 	LastDeleteRun *metav1.Time `json:"lastDeleteRun,omitempty"`
@@ -258,6 +306,12 @@ func (sc *GcpNfsBackupSchedule) GetMaxFailedBackups() int {
 func (sc *GcpNfsBackupSchedule) SetMaxFailedBackups(count int) {
 	sc.Spec.MaxFailedBackups = count
 }
+func (sc *GcpNfsBackupSchedule) GetVerify() *BackupVerification {
+	return sc.Spec.Verify
+}
+func (sc *GcpNfsBackupSchedule) GetRetentionPolicy() *RetentionPolicy {
+	return sc.Spec.RetentionPolicy
+}
 
 func (sc *GcpNfsBackupSchedule) GetNextRunTimes() []string {
 	return sc.Status.NextRunTimes
@@ -97,6 +97,12 @@ type GcpNfsVolumeBackupSpec struct {
 	// +kubebuilder:validation:MaxItems=10
 	// +kubebuilder:validation:XValidation:rule="(self.all(x, x == 'all') || self.all(x, x != 'all'))", message="The value 'all' cannot be combined with other values."
 	AccessibleFrom []string `json:"accessibleFrom,omitempty"`
+
+	// Hooks specifies the commands executed in the application pods before and after the backup is triggered,
+	// to make the backup application-consistent.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Hooks are immutable."
+	Hooks *BackupHooks `json:"hooks,omitempty"`
 }
 
 // GcpNfsVolumeBackupStatus defines the observed state of GcpNfsVolumeBackup
@@ -132,6 +138,14 @@ type GcpNfsVolumeBackupStatus struct {
 
 	// +optional
 	FileStoreBackupLabels map[string]string `json:"fileStoreBackupLabels,omitempty"`
+
+	// Verification records the result of the test restore of this backup by its backup schedule
+	// +optional
+	Verification *BackupVerificationStatus `json:"verification,omitempty"`
+
+	// Hooks records the outcome of the backup hooks
+	// +optional
+	Hooks *BackupHooksStatus `json:"hooks,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -159,6 +173,26 @@ func (in *GcpNfsVolumeBackup) SetState(v string) {
 	in.Status.State = GcpNfsBackupState(v)
 }
 
+func (in *GcpNfsVolumeBackup) GetVerification() *BackupVerificationStatus {
+	return in.Status.Verification
+}
+
+func (in *GcpNfsVolumeBackup) SetVerification(v *BackupVerificationStatus) {
+	in.Status.Verification = v
+}
+
+func (in *GcpNfsVolumeBackup) GetHooks() *BackupHooks {
+	return in.Spec.Hooks
+}
+
+func (in *GcpNfsVolumeBackup) GetHooksStatus() *BackupHooksStatus {
+	return in.Status.Hooks
+}
+
+func (in *GcpNfsVolumeBackup) SetHooksStatus(v *BackupHooksStatus) {
+	in.Status.Hooks = v
+}
+
 func (in *GcpNfsVolumeBackup) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
@@ -13,6 +13,14 @@ const (
 	LabelRedisClusterStatusId  = "cloud-resources.kyma-project.io/redisClusterStatusId"
 	LabelRedisClusterNamespace = "cloud-resources.kyma-project.io/redisClusterNamespace"
 
-	LabelScheduleName      = "cloud-resources.kyma-project.io/scheduleName"
-	LabelScheduleNamespace = "cloud-resources.kyma-project.io/scheduleNamespace"
+	LabelRedisUserStatusId  = "cloud-resources.kyma-project.io/redisUserStatusId"
+	LabelRedisUserNamespace = "cloud-resources.kyma-project.io/redisUserNamespace"
+
+	LabelScheduleName         = "cloud-resources.kyma-project.io/scheduleName"
+	LabelScheduleNamespace    = "cloud-resources.kyma-project.io/scheduleNamespace"
+	LabelScheduleCopyLocation = "cloud-resources.kyma-project.io/scheduleCopyLocation"
+	LabelVerifiedBackup       = "cloud-resources.kyma-project.io/verifiedBackup"
+
+	// LabelBackupHooks must be set to "true" on the pods the backup hooks are allowed to be executed in
+	LabelBackupHooks = "cloud-resources.kyma-project.io/backupHooks"
 )
@@ -0,0 +1,53 @@
+package v1beta1
+
+func NewRedisUserBuilder() *RedisUserBuilder {
+	return &RedisUserBuilder{
+		RedisUser: RedisUser{
+			Spec: RedisUserSpec{},
+		},
+	}
+}
+
+// +kubebuilder:object:generate=false
+
+type RedisUserBuilder struct {
+	RedisUser RedisUser
+}
+
+func (b *RedisUserBuilder) Reset() *RedisUserBuilder {
+	b.RedisUser = RedisUser{
+		Spec: RedisUserSpec{},
+	}
+	return b
+}
+
+func (b *RedisUserBuilder) WithRedisRef(kind RedisUserRedisKind, name string) *RedisUserBuilder {
+	b.RedisUser.Spec.RedisRef = RedisUserRedisRef{
+		Kind: kind,
+		Name: name,
+	}
+	return b
+}
+
+func (b *RedisUserBuilder) WithKeyPatterns(keyPatterns ...string) *RedisUserBuilder {
+	b.RedisUser.Spec.KeyPatterns = keyPatterns
+	return b
+}
+
+func (b *RedisUserBuilder) WithCommands(commands ...string) *RedisUserBuilder {
+	b.RedisUser.Spec.Commands = commands
+	return b
+}
+
+func (b *RedisUserBuilder) WithAzureObjectId(objectId string) *RedisUserBuilder {
+	b.RedisUser.Spec.Azure = &RedisUserAzure{ObjectId: objectId}
+	return b
+}
+
+func (b *RedisUserBuilder) WithAuthSecretName(name string) *RedisUserBuilder {
+	if b.RedisUser.Spec.AuthSecret == nil {
+		b.RedisUser.Spec.AuthSecret = &RedisAuthSecretSpec{}
+	}
+	b.RedisUser.Spec.AuthSecret.Name = name
+	return b
+}
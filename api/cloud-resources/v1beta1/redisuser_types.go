@@ -0,0 +1,178 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ConditionReasonRedisNotFound = "RedisNotFound"
+	ConditionReasonRedisNotReady = "RedisNotReady"
+)
+
+// +kubebuilder:validation:Enum=AwsRedisInstance;AwsRedisCluster;AzureManagedRedis
+type RedisUserRedisKind string
+
+const (
+	RedisUserRedisKindAwsRedisInstance  = RedisUserRedisKind("AwsRedisInstance")
+	RedisUserRedisKindAwsRedisCluster   = RedisUserRedisKind("AwsRedisCluster")
+	RedisUserRedisKindAzureManagedRedis = RedisUserRedisKind("AzureManagedRedis")
+)
+
+// RedisUserRedisRef references a redis in the same namespace as the RedisUser.
+type RedisUserRedisRef struct {
+	// +kubebuilder:validation:Required
+	Kind RedisUserRedisKind `json:"kind"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+type RedisUserAzure struct {
+	// ObjectId is the Entra ID object id of the managed identity or service principal
+	// the workload authenticates with.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ObjectId string `json:"objectId"`
+}
+
+// RedisUserSpec defines the desired state of RedisUser
+// +kubebuilder:validation:XValidation:rule="self.redisRef.kind != 'AzureManagedRedis' || ((!has(self.keyPatterns) || self.keyPatterns == ['*']) && (!has(self.commands) || self.commands == ['+@all']))", message="AzureManagedRedis only supports the default access policy, keyPatterns and commands can not be set."
+// +kubebuilder:validation:XValidation:rule="self.redisRef.kind != 'AzureManagedRedis' || has(self.azure)", message="Azure is required for AzureManagedRedis."
+type RedisUserSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="RedisRef is immutable."
+	RedisRef RedisUserRedisRef `json:"redisRef"`
+
+	// KeyPatterns restrict the keys the user can access, for example "app1:*".
+	// Not supported for AzureManagedRedis.
+	// +optional
+	// +kubebuilder:default={"*"}
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=256
+	// +kubebuilder:validation:items:Pattern=`^\S+$`
+	KeyPatterns []string `json:"keyPatterns,omitempty"`
+
+	// Commands are the ACL command rules granted to the user, for example "+@read" or "-flushall".
+	// Not supported for AzureManagedRedis.
+	// +optional
+	// +kubebuilder:default={"+@all"}
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=256
+	// +kubebuilder:validation:items:Pattern=`^\S+$`
+	Commands []string `json:"commands,omitempty"`
+
+	// Azure is required when the user references an AzureManagedRedis.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Azure is immutable."
+	Azure *RedisUserAzure `json:"azure,omitempty"`
+
+	// AuthSecret customises the name and labels of the generated user secret.
+	// +optional
+	AuthSecret *RedisAuthSecretSpec `json:"authSecret,omitempty"`
+}
+
+// RedisUserStatus defines the observed state of RedisUser
+type RedisUserStatus struct {
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.redisRef.kind"
+// +kubebuilder:printcolumn:name="Redis",type="string",JSONPath=".spec.redisRef.name"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name != 'default'",message="The name default is reserved for the redis default user."
+
+// RedisUser is the Schema for the redisusers API
+type RedisUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisUserSpec   `json:"spec,omitempty"`
+	Status RedisUserStatus `json:"status,omitempty"`
+}
+
+func (in *RedisUser) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *RedisUser) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *RedisUser) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureRedis
+}
+
+func (in *RedisUser) SpecificToProviders() []string {
+	return []string{"aws", "azure"}
+}
+
+func (in *RedisUser) State() string {
+	return in.Status.State
+}
+
+func (in *RedisUser) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *RedisUser) CloneForPatchStatus() client.Object {
+	result := &RedisUser{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RedisUser",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+	if result.Status.Conditions == nil {
+		result.Status.Conditions = []metav1.Condition{}
+	}
+	return result
+}
+
+// +kubebuilder:object:root=true
+
+// RedisUserList contains a list of RedisUser
+type RedisUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisUser `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RedisUser{}, &RedisUserList{})
+}
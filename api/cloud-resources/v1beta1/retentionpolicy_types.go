@@ -0,0 +1,33 @@
+package v1beta1
+
+// RetentionPolicy configures the grandfather-father-son retention of the backups created by a backup schedule.
+// The Ready backups are classified into daily, weekly, monthly and yearly buckets by their creation time in UTC,
+// and the most recent backup of each of the last N days, weeks, months and years is retained.
+// Ready backups that fall into none of the buckets are deleted.
+// When set, it replaces MaxRetentionDays and MaxReadyBackups for Ready backups.
+// +kubebuilder:validation:XValidation:rule=(self.daily + self.weekly + self.monthly + self.yearly > 0), message="At least one of daily, weekly, monthly or yearly must be set."
+type RetentionPolicy struct {
+	// Daily specifies the number of most recent days for which the last backup of the day is retained.
+	// +optional
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	Daily int `json:"daily,omitempty"`
+
+	// Weekly specifies the number of most recent ISO weeks for which the last backup of the week is retained.
+	// +optional
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	Weekly int `json:"weekly,omitempty"`
+
+	// Monthly specifies the number of most recent months for which the last backup of the month is retained.
+	// +optional
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	Monthly int `json:"monthly,omitempty"`
+
+	// Yearly specifies the number of most recent years for which the last backup of the year is retained.
+	// +optional
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	Yearly int `json:"yearly,omitempty"`
+}
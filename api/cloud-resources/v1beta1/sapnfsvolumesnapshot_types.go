@@ -35,6 +35,12 @@ type SapNfsVolumeSnapshotSpec struct {
 	// will be automatically deleted. 0 means no automatic deletion.
 	// +optional
 	DeleteAfterDays int `json:"deleteAfterDays,omitempty"`
+
+	// Hooks specifies the commands executed in the application pods before and after the snapshot is triggered,
+	// to make the snapshot application-consistent.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Hooks are immutable."
+	Hooks *BackupHooks `json:"hooks,omitempty"`
 }
 
 // SapNfsVolumeSnapshotStatus defines the observed state of SapNfsVolumeSnapshot
@@ -64,6 +70,10 @@ type SapNfsVolumeSnapshotStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Hooks records the outcome of the backup hooks
+	// +optional
+	Hooks *BackupHooksStatus `json:"hooks,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -90,6 +100,18 @@ func (in *SapNfsVolumeSnapshot) SetState(v string) {
 	in.Status.State = v
 }
 
+func (in *SapNfsVolumeSnapshot) GetHooks() *BackupHooks {
+	return in.Spec.Hooks
+}
+
+func (in *SapNfsVolumeSnapshot) GetHooksStatus() *BackupHooksStatus {
+	return in.Status.Hooks
+}
+
+func (in *SapNfsVolumeSnapshot) SetHooksStatus(v *BackupHooksStatus) {
+	in.Status.Hooks = v
+}
+
 func (in *SapNfsVolumeSnapshot) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
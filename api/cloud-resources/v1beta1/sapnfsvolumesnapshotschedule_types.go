@@ -75,6 +75,12 @@ type SapNfsVolumeSnapshotScheduleSpec struct {
 	// +kubebuilder:default=false
 	DeleteCascade bool `json:"deleteCascade,omitempty"`
 
+	// RetentionPolicy specifies the grandfather-father-son retention of the Ready snapshots, for example,
+	// 7 daily, 4 weekly and 12 monthly snapshots.
+	// When set, MaxRetentionDays and MaxReadySnapshots are not applied to Ready snapshots.
+	// +optional
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
+
 	// Template defines the SapNfsVolumeSnapshot to create on each run.
 	// +kubebuilder:validation:Required
 	Template SapNfsVolumeSnapshotTemplate `json:"template"`
@@ -266,6 +272,10 @@ func (sc *SapNfsVolumeSnapshotSchedule) SetMaxFailedBackups(count int) {
 	sc.Spec.MaxFailedSnapshots = count
 }
 
+func (sc *SapNfsVolumeSnapshotSchedule) GetRetentionPolicy() *RetentionPolicy {
+	return sc.Spec.RetentionPolicy
+}
+
 func (sc *SapNfsVolumeSnapshotSchedule) GetNextRunTimes() []string {
 	return sc.Status.NextRunTimes
 }
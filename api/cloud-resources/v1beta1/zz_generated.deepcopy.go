@@ -26,6 +26,172 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNfsVolume) DeepCopyInto(out *AlicloudNfsVolume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNfsVolume.
+func (in *AlicloudNfsVolume) DeepCopy() *AlicloudNfsVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNfsVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlicloudNfsVolume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNfsVolumeList) DeepCopyInto(out *AlicloudNfsVolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlicloudNfsVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNfsVolumeList.
+func (in *AlicloudNfsVolumeList) DeepCopy() *AlicloudNfsVolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNfsVolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlicloudNfsVolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNfsVolumePvSpec) DeepCopyInto(out *AlicloudNfsVolumePvSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNfsVolumePvSpec.
+func (in *AlicloudNfsVolumePvSpec) DeepCopy() *AlicloudNfsVolumePvSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNfsVolumePvSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNfsVolumePvcSpec) DeepCopyInto(out *AlicloudNfsVolumePvcSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNfsVolumePvcSpec.
+func (in *AlicloudNfsVolumePvcSpec) DeepCopy() *AlicloudNfsVolumePvcSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNfsVolumePvcSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNfsVolumeSpec) DeepCopyInto(out *AlicloudNfsVolumeSpec) {
+	*out = *in
+	out.IpRange = in.IpRange
+	if in.PersistentVolume != nil {
+		in, out := &in.PersistentVolume, &out.PersistentVolume
+		*out = new(AlicloudNfsVolumePvSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(AlicloudNfsVolumePvcSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNfsVolumeSpec.
+func (in *AlicloudNfsVolumeSpec) DeepCopy() *AlicloudNfsVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNfsVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNfsVolumeStatus) DeepCopyInto(out *AlicloudNfsVolumeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Capacity = in.Capacity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNfsVolumeStatus.
+func (in *AlicloudNfsVolumeStatus) DeepCopy() *AlicloudNfsVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNfsVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlicloudRedisCluster) DeepCopyInto(out *AlicloudRedisCluster) {
 	*out = *in
@@ -320,6 +486,21 @@ func (in *AwsNfsBackupScheduleSpec) DeepCopyInto(out *AwsNfsBackupScheduleSpec)
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(BackupVerification)
+		**out = **in
+	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RetentionPolicy)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsBackupScheduleSpec.
@@ -512,6 +693,11 @@ func (in *AwsNfsVolumeBackupSpec) DeepCopyInto(out *AwsNfsVolumeBackupSpec) {
 	*out = *in
 	out.Source = in.Source
 	in.Lifecycle.DeepCopyInto(&out.Lifecycle)
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeBackupSpec.
@@ -544,6 +730,16 @@ func (in *AwsNfsVolumeBackupStatus) DeepCopyInto(out *AwsNfsVolumeBackupStatus)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooksStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeBackupStatus.
@@ -651,7 +847,7 @@ func (in *AwsNfsVolumeRestore) DeepCopyInto(out *AwsNfsVolumeRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -673,6 +869,22 @@ func (in *AwsNfsVolumeRestore) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsNfsVolumeRestoreDestination) DeepCopyInto(out *AwsNfsVolumeRestoreDestination) {
+	*out = *in
+	out.Volume = in.Volume
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeRestoreDestination.
+func (in *AwsNfsVolumeRestoreDestination) DeepCopy() *AwsNfsVolumeRestoreDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsNfsVolumeRestoreDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsNfsVolumeRestoreList) DeepCopyInto(out *AwsNfsVolumeRestoreList) {
 	*out = *in
@@ -725,6 +937,11 @@ func (in *AwsNfsVolumeRestoreSource) DeepCopy() *AwsNfsVolumeRestoreSource {
 func (in *AwsNfsVolumeRestoreSpec) DeepCopyInto(out *AwsNfsVolumeRestoreSpec) {
 	*out = *in
 	out.Source = in.Source
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(AwsNfsVolumeRestoreDestination)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeRestoreSpec.
@@ -1235,6 +1452,172 @@ func (in *AzureManagedRedisStatus) DeepCopy() *AzureManagedRedisStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureNfsVolume) DeepCopyInto(out *AzureNfsVolume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureNfsVolume.
+func (in *AzureNfsVolume) DeepCopy() *AzureNfsVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureNfsVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureNfsVolume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureNfsVolumeList) DeepCopyInto(out *AzureNfsVolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureNfsVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureNfsVolumeList.
+func (in *AzureNfsVolumeList) DeepCopy() *AzureNfsVolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureNfsVolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureNfsVolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureNfsVolumePvSpec) DeepCopyInto(out *AzureNfsVolumePvSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureNfsVolumePvSpec.
+func (in *AzureNfsVolumePvSpec) DeepCopy() *AzureNfsVolumePvSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureNfsVolumePvSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureNfsVolumePvcSpec) DeepCopyInto(out *AzureNfsVolumePvcSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureNfsVolumePvcSpec.
+func (in *AzureNfsVolumePvcSpec) DeepCopy() *AzureNfsVolumePvcSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureNfsVolumePvcSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureNfsVolumeSpec) DeepCopyInto(out *AzureNfsVolumeSpec) {
+	*out = *in
+	out.IpRange = in.IpRange
+	if in.PersistentVolume != nil {
+		in, out := &in.PersistentVolume, &out.PersistentVolume
+		*out = new(AzureNfsVolumePvSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(AzureNfsVolumePvcSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureNfsVolumeSpec.
+func (in *AzureNfsVolumeSpec) DeepCopy() *AzureNfsVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureNfsVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureNfsVolumeStatus) DeepCopyInto(out *AzureNfsVolumeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Capacity = in.Capacity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureNfsVolumeStatus.
+func (in *AzureNfsVolumeStatus) DeepCopy() *AzureNfsVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureNfsVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureRedisCluster) DeepCopyInto(out *AzureRedisCluster) {
 	*out = *in
@@ -1512,6 +1895,16 @@ func (in *AzureRwxBackupScheduleSpec) DeepCopyInto(out *AzureRwxBackupScheduleSp
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(BackupVerification)
+		**out = **in
+	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RetentionPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupScheduleSpec.
@@ -1672,6 +2065,16 @@ func (in *AzureRwxVolumeBackupStatus) DeepCopyInto(out *AzureRwxVolumeBackupStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupStatus.
@@ -1893,118 +2296,268 @@ func (in *AzureVpcDnsLinkStatus) DeepCopy() *AzureVpcDnsLinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcDnsLinkStatus)
+	out := new(AzureVpcDnsLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeering) DeepCopyInto(out *AzureVpcPeering) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeering.
+func (in *AzureVpcPeering) DeepCopy() *AzureVpcPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureVpcPeering) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeeringList) DeepCopyInto(out *AzureVpcPeeringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureVpcPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringList.
+func (in *AzureVpcPeeringList) DeepCopy() *AzureVpcPeeringList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeeringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureVpcPeeringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeeringSpec) DeepCopyInto(out *AzureVpcPeeringSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringSpec.
+func (in *AzureVpcPeeringSpec) DeepCopy() *AzureVpcPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeeringStatus) DeepCopyInto(out *AzureVpcPeeringStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringStatus.
+func (in *AzureVpcPeeringStatus) DeepCopy() *AzureVpcPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupHook) DeepCopyInto(out *BackupHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHook.
+func (in *BackupHook) DeepCopy() *BackupHook {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupHookPodStatus) DeepCopyInto(out *BackupHookPodStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHookPodStatus.
+func (in *BackupHookPodStatus) DeepCopy() *BackupHookPodStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupHookPodStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeering) DeepCopyInto(out *AzureVpcPeering) {
+func (in *BackupHookStatus) DeepCopyInto(out *BackupHookStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]BackupHookPodStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeering.
-func (in *AzureVpcPeering) DeepCopy() *AzureVpcPeering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHookStatus.
+func (in *BackupHookStatus) DeepCopy() *BackupHookStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcPeering)
+	out := new(BackupHookStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureVpcPeering) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeeringList) DeepCopyInto(out *AzureVpcPeeringList) {
+func (in *BackupHooks) DeepCopyInto(out *BackupHooks) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]AzureVpcPeering, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = new(BackupHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = new(BackupHook)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringList.
-func (in *AzureVpcPeeringList) DeepCopy() *AzureVpcPeeringList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHooks.
+func (in *BackupHooks) DeepCopy() *BackupHooks {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcPeeringList)
+	out := new(BackupHooks)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureVpcPeeringList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupHooksStatus) DeepCopyInto(out *BackupHooksStatus) {
+	*out = *in
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = new(BackupHookStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = new(BackupHookStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHooksStatus.
+func (in *BackupHooksStatus) DeepCopy() *BackupHooksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupHooksStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeeringSpec) DeepCopyInto(out *AzureVpcPeeringSpec) {
+func (in *BackupRef) DeepCopyInto(out *BackupRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringSpec.
-func (in *AzureVpcPeeringSpec) DeepCopy() *AzureVpcPeeringSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRef.
+func (in *BackupRef) DeepCopy() *BackupRef {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcPeeringSpec)
+	out := new(BackupRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeeringStatus) DeepCopyInto(out *AzureVpcPeeringStatus) {
+func (in *BackupVerification) DeepCopyInto(out *BackupVerification) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.Timeout = in.Timeout
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringStatus.
-func (in *AzureVpcPeeringStatus) DeepCopy() *AzureVpcPeeringStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerification.
+func (in *BackupVerification) DeepCopy() *BackupVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcPeeringStatus)
+	out := new(BackupVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackupRef) DeepCopyInto(out *BackupRef) {
+func (in *BackupVerificationStatus) DeepCopyInto(out *BackupVerificationStatus) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRef.
-func (in *BackupRef) DeepCopy() *BackupRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationStatus.
+func (in *BackupVerificationStatus) DeepCopy() *BackupVerificationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BackupRef)
+	out := new(BackupVerificationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2093,6 +2646,11 @@ func (in *CloudResourcesStatus) DeepCopyInto(out *CloudResourcesStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]QuotaUsage, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResourcesStatus.
@@ -2148,6 +2706,21 @@ func (in *GcpNfsBackupSchedule) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsBackupScheduleCopy) DeepCopyInto(out *GcpNfsBackupScheduleCopy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleCopy.
+func (in *GcpNfsBackupScheduleCopy) DeepCopy() *GcpNfsBackupScheduleCopy {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsBackupScheduleCopy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GcpNfsBackupScheduleList) DeepCopyInto(out *GcpNfsBackupScheduleList) {
 	*out = *in
@@ -2197,6 +2770,26 @@ func (in *GcpNfsBackupScheduleSpec) DeepCopyInto(out *GcpNfsBackupScheduleSpec)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Copies != nil {
+		in, out := &in.Copies, &out.Copies
+		*out = make([]GcpNfsBackupScheduleCopy, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(BackupVerification)
+		**out = **in
+	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RetentionPolicy)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleSpec.
@@ -2236,6 +2829,11 @@ func (in *GcpNfsBackupScheduleStatus) DeepCopyInto(out *GcpNfsBackupScheduleStat
 		*out = (*in).DeepCopy()
 	}
 	out.LastCreatedBackup = in.LastCreatedBackup
+	if in.LastCreatedCopies != nil {
+		in, out := &in.LastCreatedCopies, &out.LastCreatedCopies
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.LastDeleteRun != nil {
 		in, out := &in.LastDeleteRun, &out.LastDeleteRun
 		*out = (*in).DeepCopy()
@@ -2500,6 +3098,11 @@ func (in *GcpNfsVolumeBackupSpec) DeepCopyInto(out *GcpNfsVolumeBackupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupSpec.
@@ -2534,6 +3137,16 @@ func (in *GcpNfsVolumeBackupStatus) DeepCopyInto(out *GcpNfsVolumeBackupStatus)
 			(*out)[key] = val
 		}
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooksStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupStatus.
@@ -3457,6 +4070,21 @@ func (in *PvcSource) DeepCopy() *PvcSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisAuthSecretSpec) DeepCopyInto(out *RedisAuthSecretSpec) {
 	*out = *in
@@ -3523,6 +4151,168 @@ func (in *RedisInstanceAzureConfigs) DeepCopy() *RedisInstanceAzureConfigs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisUser) DeepCopyInto(out *RedisUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisUser.
+func (in *RedisUser) DeepCopy() *RedisUser {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisUserAzure) DeepCopyInto(out *RedisUserAzure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisUserAzure.
+func (in *RedisUserAzure) DeepCopy() *RedisUserAzure {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisUserAzure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisUserList) DeepCopyInto(out *RedisUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisUserList.
+func (in *RedisUserList) DeepCopy() *RedisUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisUserRedisRef) DeepCopyInto(out *RedisUserRedisRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisUserRedisRef.
+func (in *RedisUserRedisRef) DeepCopy() *RedisUserRedisRef {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisUserRedisRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisUserSpec) DeepCopyInto(out *RedisUserSpec) {
+	*out = *in
+	out.RedisRef = in.RedisRef
+	if in.KeyPatterns != nil {
+		in, out := &in.KeyPatterns, &out.KeyPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Commands != nil {
+		in, out := &in.Commands, &out.Commands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(RedisUserAzure)
+		**out = **in
+	}
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(RedisAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisUserSpec.
+func (in *RedisUserSpec) DeepCopy() *RedisUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisUserStatus) DeepCopyInto(out *RedisUserStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisUserStatus.
+func (in *RedisUserStatus) DeepCopy() *RedisUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SapNfsVolume) DeepCopyInto(out *SapNfsVolume) {
 	*out = *in
@@ -3607,7 +4397,7 @@ func (in *SapNfsVolumeSnapshot) DeepCopyInto(out *SapNfsVolumeSnapshot) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -3905,6 +4695,11 @@ func (in *SapNfsVolumeSnapshotScheduleSpec) DeepCopyInto(out *SapNfsVolumeSnapsh
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RetentionPolicy)
+		**out = **in
+	}
 	in.Template.DeepCopyInto(&out.Template)
 }
 
@@ -3970,6 +4765,11 @@ func (in *SapNfsVolumeSnapshotScheduleStatus) DeepCopy() *SapNfsVolumeSnapshotSc
 func (in *SapNfsVolumeSnapshotSpec) DeepCopyInto(out *SapNfsVolumeSnapshotSpec) {
 	*out = *in
 	out.SourceVolume = in.SourceVolume
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapNfsVolumeSnapshotSpec.
@@ -3992,6 +4792,11 @@ func (in *SapNfsVolumeSnapshotStatus) DeepCopyInto(out *SapNfsVolumeSnapshotStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BackupHooksStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapNfsVolumeSnapshotStatus.
@@ -4021,7 +4826,7 @@ func (in *SapNfsVolumeSnapshotTemplate) DeepCopyInto(out *SapNfsVolumeSnapshotTe
 			(*out)[key] = val
 		}
 	}
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapNfsVolumeSnapshotTemplate.
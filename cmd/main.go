@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	alicloudvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/vpcnetwork"
 	alicloudvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/vpcnetwork/client"
@@ -40,6 +41,8 @@ import (
 	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
 	alicloudiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/iprange/client"
 	alicloudnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nfsinstance/client"
+	alicloudnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke/client"
+	alicloudredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/rediscluster/client"
 	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
 	sapexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/exposedData/client"
 	sapiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/iprange/client"
@@ -67,11 +70,13 @@ import (
 	awsiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/iprange/client"
 	awsnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance/client"
 	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awssecurityclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/security/client"
 	awsvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering/client"
 	azureexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/exposedData/client"
 	azureiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/iprange/client"
 	azuremanagedredisclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/managedredis/client"
 	azurenetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/network/client"
+	azurenfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nfsinstance/client"
 	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
 	azureredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/rediscluster/client"
 	azureredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance/client"
@@ -89,6 +94,7 @@ import (
 	gcpsubnetclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/subnet/client"
 	gcpvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcpeering/client"
 	sapnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nfsinstance/client"
+	sapnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nuke/client"
 	scopeclient "github.com/kyma-project/cloud-manager/pkg/kcp/scope/client"
 	subscriptionclient "github.com/kyma-project/cloud-manager/pkg/kcp/subscription/client"
 	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
@@ -105,6 +111,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	cloudcontrolcontroller "github.com/kyma-project/cloud-manager/internal/controller/cloud-control"
 	cloudresourcescontroller "github.com/kyma-project/cloud-manager/internal/controller/cloud-resources"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -211,6 +218,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if composed.IsActionRecordingEnabled() {
+		if err := mgr.AddMetricsServerExtraHandler("/debug/composed/actions", composed.ActionChains); err != nil {
+			setupLog.Error(err, "unable to add composed action chains debug handler")
+			os.Exit(1)
+		}
+	}
+
 	ctx := ctrl.SetupSignalHandler()
 
 	ctx = feature.ContextBuilderFromCtx(ctx).
@@ -218,6 +232,19 @@ func main() {
 		Plane(featuretypes.PlaneKcp).
 		Build(ctx)
 
+	shutdownTracing, err := tracing.Init(ctx, tracing.TracingConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			rootLogger.Error(err, "Error shutting down tracing")
+		}
+	}()
+
 	skrLoop := skrruntime.NewLooper(activeSkrCollection, mgr, skrRegistry, mgr.GetLogger())
 
 	// runtime-watcher notification listener: SKR resource change -> HTTP POST ->
@@ -297,6 +324,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = cloudresourcescontroller.SetupAzureNfsVolumeReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AzureNfsVolume")
+		os.Exit(1)
+	}
+
 	if err = cloudresourcescontroller.SetupAzureRedisInstanceReconciler(skrRegistry); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AzureRedisInstance")
 		os.Exit(1)
@@ -306,6 +338,10 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AzureManagedRedis")
 		os.Exit(1)
 	}
+	if err = cloudresourcescontroller.SetupRedisUserReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RedisUser")
+		os.Exit(1)
+	}
 
 	if err = cloudresourcescontroller.SetupAwsRedisInstanceReconciler(skrRegistry); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AwsRedisInstance")
@@ -322,6 +358,21 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = cloudresourcescontroller.SetupAlicloudNfsVolumeReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlicloudNfsVolume")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAlicloudRedisInstanceReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlicloudRedisInstance")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAlicloudRedisClusterReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlicloudRedisCluster")
+		os.Exit(1)
+	}
+
 	if err = cloudresourcescontroller.SetupAwsVpcPeeringReconciler(skrRegistry); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AwsVpcPeering")
 		os.Exit(1)
@@ -372,10 +423,10 @@ func main() {
 		os.Exit(1)
 	}
 
-	//if err = cloudresourcescontroller.SetupAzureRwxBackupReconciler(skrRegistry, azurerwxvolumebackupclient.NewClientProvider()); err != nil {
-	//	setupLog.Error(err, "unable to create controller", "controller", "AzureRwxVolumeBackup")
-	//	os.Exit(1)
-	//}
+	if err = cloudresourcescontroller.SetupAzureRwxBackupReconciler(skrRegistry, azurerwxvolumebackupclient.NewClientProvider()); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AzureRwxVolumeBackup")
+		os.Exit(1)
+	}
 
 	if err = cloudresourcescontroller.SetupAzureRwxRestoreReconciler(skrRegistry, azurerwxvolumebackupclient.NewClientProvider()); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AzureRwxVolumeRestore")
@@ -424,6 +475,7 @@ func main() {
 	if err = cloudcontrolcontroller.SetupNfsInstanceReconciler(
 		mgr,
 		awsnfsinstanceclient.NewClientProvider(),
+		azurenfsinstanceclient.NewClientProvider(),
 		gcpnfsinstancev2client.NewFilestoreClientProvider(gcpClients),
 		sapnfsinstanceclient.NewClientProvider(),
 		alicloudnfsinstanceclient.NewClientProvider(),
@@ -480,6 +532,8 @@ func main() {
 		gcpnfsbackupclientv2.NewFileBackupClientProvider(gcpClients),
 		awsnukeclient.NewClientProvider(),
 		azurenukeclient.NewClientProvider(),
+		sapnukeclient.NewClientProvider(),
+		alicloudnukeclient.NewClientProvider(),
 		env,
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Nuke")
@@ -489,6 +543,7 @@ func main() {
 		mgr,
 		awsclient.NewElastiCacheClientProvider(),
 		azureredisclusterclient.NewClientProvider(),
+		alicloudredisclusterclient.NewClientProvider(),
 		env,
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RedisCluster")
@@ -501,6 +556,14 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AzureManagedRedis")
 		os.Exit(1)
 	}
+	if err = cloudcontrolcontroller.SetupRedisUserReconciler(
+		mgr,
+		awsclient.NewElastiCacheClientProvider(),
+		azuremanagedredisclient.NewClientProvider(),
+	); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RedisUser")
+		os.Exit(1)
+	}
 	if err = cloudcontrolcontroller.SetupGcpRedisClusterReconciler(
 		mgr,
 		gcpredisclusterclient.NewMemorystoreClientProvider(gcpClients),
@@ -550,6 +613,7 @@ func main() {
 	if err = cloudcontrolcontroller.SetupRuntimeReconciler(
 		ctx,
 		mgr,
+		awssecurityclient.NewClientProvider(),
 		azuresecurityclient.NewClientProvider(),
 		gcpsecurityclient.NewClientProvider(gcpClients),
 	); err != nil {
@@ -579,6 +643,61 @@ func main() {
 		os.Exit(1)
 	}
 
+	if skrruntimeconfig.SkrRuntimeConfig.ShardingEnabled {
+		// Partition the SKR fleet across replicas: each replica holds a membership lease and
+		// loops only the SKRs of its shard; notifications are forwarded to the owning replica.
+		// Membership leases are read uncached, the manager RBAC only covers its own namespace.
+		shardClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create SKR looper shard client")
+			os.Exit(1)
+		}
+		skrShard := skrruntime.NewLeaseShard(
+			composed.NewStateCluster(shardClient, shardClient, nil, mgr.GetScheme()),
+			activeSkrCollection,
+			skrruntime.ShardOptions{
+				Self:          skrruntimeconfig.SkrRuntimeConfig.ShardReplicaAddr,
+				LeaseDuration: skrruntimeconfig.SkrRuntimeConfig.SkrShardLeaseDuration,
+				RenewInterval: skrruntimeconfig.SkrRuntimeConfig.SkrShardRenewInterval,
+				// peers authorize forwarded notifications by the dedicated shard audience token
+				BearerTokenFile: skrruntimeconfig.SkrRuntimeConfig.ShardTokenFile,
+			},
+			mgr.GetLogger(),
+		)
+		activeSkrCollection.SetShard(skrShard)
+		if err = mgr.Add(skrShard); err != nil {
+			setupLog.Error(err, "error adding SKR looper shard to KCP manager")
+			os.Exit(1)
+		}
+		err = mgr.Add(skrruntime.NewShardForwardServer(
+			skrruntimeconfig.SkrRuntimeConfig.ShardForwardAddr,
+			activeSkrCollection.NotifyLocal,
+			skrruntime.NewKubeAdminAuth(mgr.GetClient(), skrruntime.ShardTokenAudience),
+			mgr.GetLogger(),
+		))
+		if err != nil {
+			setupLog.Error(err, "error adding SKR looper shard forward server to KCP manager")
+			os.Exit(1)
+		}
+	}
+
+	if skrruntimeconfig.SkrRuntimeConfig.AdminAddr != "" {
+		err = mgr.Add(skrruntime.NewAdminServer(
+			skrruntime.AdminServerOptions{
+				Addr:     skrruntimeconfig.SkrRuntimeConfig.AdminAddr,
+				CertFile: skrruntimeconfig.SkrRuntimeConfig.AdminCertFile,
+				KeyFile:  skrruntimeconfig.SkrRuntimeConfig.AdminKeyFile,
+			},
+			activeSkrCollection,
+			skrruntime.NewKubeAdminAuth(mgr.GetClient()),
+			mgr.GetLogger(),
+		))
+		if err != nil {
+			setupLog.Error(err, "error adding SKR looper admin server to KCP manager")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting manager")
 
 	if err := feature.Initialize(ctx, rootLogger.WithName("ff")); err != nil {
@@ -16,12 +16,15 @@ export default [
     { text: 'GcpNfsBackupSchedule Custom Resource', link: './resources/04-20-22-gcp-nfs-backup-schedule' },
     { text: 'GcpNfsVolumeRestore Custom Resource', link: './resources/04-20-23-gcp-nfs-volume-restore' },
     { text: 'GcpNfsBackupDiscovery Custom Resource', link: './resources/04-20-24-gcp-nfs-volume-backup-discovery' },
+    { text: 'AzureNfsVolume Custom Resource', link: './resources/04-20-30-azure-nfs-volume' },
+    { text: 'AlicloudNfsVolume Custom Resource', link: './resources/04-20-40-alicloud-nfs-volume' },
     { text: 'AwsVpcPeering Custom Resource', link: './resources/04-30-10-aws-vpc-peering' },
     { text: 'GcpVpcPeering Custom Resource', link: './resources/04-30-20-gcp-vpc-peering' },
     { text: 'AzureVpcPeering Custom Resource', link: './resources/04-30-30-azure-vpc-peering' },
     { text: 'AwsRedisInstance Custom Resource', link: './resources/04-40-10-aws-redis-instance' },   
     { text: 'GcpRedisInstance Custom Resource', link: './resources/04-40-20-gcp-redis-instance' },
     { text: 'AzureRedisInstance Custom Resource', link: './resources/04-40-30-azure-redis-instance' },
+    { text: 'RedisUser Custom Resource', link: './resources/04-40-50-redis-user' },
     { text: 'AwsRedisCluster Custom Resource', link: './resources/04-50-10-aws-redis-cluster' },   
     { text: 'GcpRedisCluster Custom Resource', link: './resources/04-50-20-gcp-redis-cluster' },
     { text: 'GcpSubnet Custom Resource', link: './resources/04-50-21-gcp-subnet' },
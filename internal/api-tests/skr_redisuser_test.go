@@ -0,0 +1,131 @@
+package api_tests
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type testRedisUserBuilder struct {
+	*cloudresourcesv1beta1.RedisUserBuilder
+}
+
+func newTestRedisUserBuilder() *testRedisUserBuilder {
+	return &testRedisUserBuilder{
+		RedisUserBuilder: cloudresourcesv1beta1.NewRedisUserBuilder().
+			WithRedisRef(cloudresourcesv1beta1.RedisUserRedisKindAwsRedisInstance, "redis"),
+	}
+}
+
+func newTestAzureRedisUserBuilder() *testRedisUserBuilder {
+	return &testRedisUserBuilder{
+		RedisUserBuilder: cloudresourcesv1beta1.NewRedisUserBuilder().
+			WithRedisRef(cloudresourcesv1beta1.RedisUserRedisKindAzureManagedRedis, "redis").
+			WithAzureObjectId("00000000-0000-0000-0000-000000000000"),
+	}
+}
+
+func (b *testRedisUserBuilder) Build() *cloudresourcesv1beta1.RedisUser {
+	return &b.RedisUser
+}
+
+func (b *testRedisUserBuilder) WithRedisRef(kind cloudresourcesv1beta1.RedisUserRedisKind, name string) *testRedisUserBuilder {
+	b.RedisUserBuilder.WithRedisRef(kind, name)
+	return b
+}
+
+func (b *testRedisUserBuilder) WithKeyPatterns(keyPatterns ...string) *testRedisUserBuilder {
+	b.RedisUserBuilder.WithKeyPatterns(keyPatterns...)
+	return b
+}
+
+func (b *testRedisUserBuilder) WithCommands(commands ...string) *testRedisUserBuilder {
+	b.RedisUserBuilder.WithCommands(commands...)
+	return b
+}
+
+var _ = Describe("Feature: SKR RedisUser", Ordered, func() {
+
+	Context("Scenario: ACL rules", func() {
+
+		canCreateSkr(
+			"RedisUser can be created for AwsRedisInstance with keyPatterns and commands",
+			newTestRedisUserBuilder().WithKeyPatterns("app1:*").WithCommands("+@read", "-flushall"),
+		)
+
+		canCreateSkr(
+			"RedisUser can be created for AzureManagedRedis without keyPatterns and commands",
+			newTestAzureRedisUserBuilder(),
+		)
+
+		canCreateSkr(
+			"RedisUser can be created for AzureManagedRedis with default keyPatterns and commands",
+			newTestAzureRedisUserBuilder().WithKeyPatterns("*").WithCommands("+@all"),
+		)
+
+		canNotCreateSkr(
+			"RedisUser can not be created for AzureManagedRedis with keyPatterns",
+			newTestAzureRedisUserBuilder().WithKeyPatterns("app1:*"),
+			"AzureManagedRedis only supports the default access policy",
+		)
+
+		canNotCreateSkr(
+			"RedisUser can not be created for AzureManagedRedis with commands",
+			newTestAzureRedisUserBuilder().WithCommands("+@read"),
+			"AzureManagedRedis only supports the default access policy",
+		)
+
+		canNotChangeSkr(
+			"RedisUser commands can not be changed for AzureManagedRedis",
+			newTestAzureRedisUserBuilder(),
+			func(b Builder[*cloudresourcesv1beta1.RedisUser]) {
+				b.(*testRedisUserBuilder).WithCommands("+@read")
+			},
+			"AzureManagedRedis only supports the default access policy",
+		)
+
+		canNotCreateSkr(
+			"RedisUser can not be created with whitespace in keyPatterns",
+			newTestRedisUserBuilder().WithKeyPatterns("app1:* allkeys"),
+			"spec.keyPatterns[0]",
+		)
+
+		canNotCreateSkr(
+			"RedisUser can not be created with whitespace in commands",
+			newTestRedisUserBuilder().WithCommands("+@read +@write"),
+			"spec.commands[0]",
+		)
+
+		canNotCreateSkr(
+			"RedisUser can not be created for AzureManagedRedis without azure",
+			newTestRedisUserBuilder().WithRedisRef(cloudresourcesv1beta1.RedisUserRedisKindAzureManagedRedis, "redis"),
+			"Azure is required for AzureManagedRedis",
+		)
+	})
+
+	Context("Scenario: reserved name", func() {
+
+		It("Scenario: RedisUser can not be created with the name default", func() {
+			obj := newTestRedisUserBuilder().Build()
+			obj.SetName("default")
+			dsl.SetDefaultNamespace(obj)
+
+			err := infra.SKR().Client().Create(infra.Ctx(), obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("The name default is reserved"))
+		})
+	})
+
+	Context("Scenario: redisRef immutability", func() {
+
+		canNotChangeSkr(
+			"RedisUser redisRef can not be changed",
+			newTestRedisUserBuilder(),
+			func(b Builder[*cloudresourcesv1beta1.RedisUser]) {
+				b.(*testRedisUserBuilder).WithRedisRef(cloudresourcesv1beta1.RedisUserRedisKindAwsRedisInstance, "other")
+			},
+			"RedisRef is immutable",
+		)
+	})
+})
@@ -5,11 +5,14 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	kcpkyma "github.com/kyma-project/cloud-manager/pkg/kcp/kyma"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
+	skrruntimeconfig "github.com/kyma-project/cloud-manager/pkg/skr/runtime/config"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -55,6 +58,11 @@ func (r *KymaReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
 		Named("kyma").
+		// The Kyma reconciler only maintains the in-memory active SKR collection, so with a
+		// sharded SKR looper it runs on every replica to give each shard the full fleet.
+		WithOptions(controller.Options{
+			NeedLeaderElection: ptr.To(!skrruntimeconfig.SkrRuntimeConfig.ShardingEnabled),
+		}).
 		Complete(r)
 }
 
@@ -128,6 +128,19 @@ var _ = Describe("Feature: KCP NfsInstance for Alicloud", func() {
 			Expect(mts[0].VSwitchId).To(Equal(iprange.Status.Subnets[0].Id))
 		})
 
+		By("When NAS file system metered size grows", func() {
+			alicloudRegion.SetNasFileSystemMeteredSize(nfsInstance.Status.Id, 5*1024*1024*1024)
+		})
+
+		By("Then KCP NfsInstance status.capacity reports the used size", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					NewObjActions(),
+					HavingFieldValue("5Gi", "status", "capacity"),
+				).
+				Should(Succeed(), "expected NfsInstance capacity to follow NAS metered size")
+		})
+
 		// DELETE ======================================================
 
 		By("When KCP NfsInstance is deleted", func() {
@@ -0,0 +1,190 @@
+package cloudcontrol
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Feature: KCP NfsInstance for Azure", func() {
+
+	It("Scenario: KCP Azure NfsInstance is created, resized and deleted", func() {
+
+		name := "5c0d1a0e-5d2a-4a5e-9bd4-0b6f4f3b2d11"
+		storageAccountName := "cm5c0d1a0e5d2a4a5e9bd40b"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAzure).
+				WithArguments(infra.Ctx(), infra, scope, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "1b1e5a3c-57b7-4b0e-8f7c-7a8f4c1b5a21"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithKcpIpRangeRemoteRef("some-remote-ref"),
+					WithKcpIpRangeNetwork("kcpNetworkCm.Name"),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		nfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+		resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(scope.Spec.Scope.Azure.VpcNetwork)
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+
+		By("When KCP NfsInstance is created", func() {
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					WithName(name),
+					WithRemoteRef("skr-nfs-example"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithNfsInstanceAzure(100),
+				).
+				Should(Succeed(), "failed creating NfsInstance")
+		})
+
+		By("Then KCP NfsInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+				).
+				Should(Succeed(), "expected NfsInstance to have Ready condition")
+		})
+
+		By("And Then Azure storage account is created as premium FileStorage", func() {
+			resp, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ptr.Deref(resp.Kind, "")).To(Equal(armstorage.KindFileStorage))
+			Expect(ptr.Deref(resp.SKU.Name, "")).To(Equal(armstorage.SKUNamePremiumLRS))
+		})
+
+		By("And Then Azure NFS file share is created", func() {
+			share, err := azureMock.GetNfsFileShare(infra.Ctx(), resourceGroupName, storageAccountName, "vol1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ptr.Deref(share.FileShareProperties.EnabledProtocols, "")).To(Equal(armstorage.EnabledProtocolsNFS))
+			Expect(ptr.Deref(share.FileShareProperties.ShareQuota, 0)).To(Equal(int32(100)))
+		})
+
+		By("And Then Private End Point is created", func() {
+			pep, err := azureMock.GetPrivateEndPoint(infra.Ctx(), resourceGroupName, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pep).NotTo(BeNil())
+		})
+
+		By("And Then Private Dns Zone Group is created", func() {
+			group, err := azureMock.GetPrivateDnsZoneGroup(infra.Ctx(), resourceGroupName, name, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).ToNot(BeNil())
+		})
+
+		By("And Then file Private Dns Zone is created", func() {
+			zone, err := azureMock.GetPrivateDnsZone(infra.Ctx(), resourceGroupName, azureutil.NewFilePrivateDnsZoneName())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zone).ToNot(BeNil())
+		})
+
+		By("And Then KCP NfsInstance has status host, path and capacity", func() {
+			Expect(nfsInstance.Status.State).To(Equal(cloudcontrolv1beta1.StateReady))
+			Expect(nfsInstance.Status.Host).To(Equal(azureutil.NewFileShareHost(storageAccountName)))
+			Expect(nfsInstance.Status.Path).To(Equal(fmt.Sprintf("/%s/vol1", storageAccountName)))
+			Expect(nfsInstance.Status.Capacity.Equal(resource.MustParse("100Gi"))).To(BeTrue())
+		})
+
+		// RESIZE
+
+		By("When KCP NfsInstance capacity is increased", func() {
+			nfsInstance.Spec.Instance.Azure.CapacityGb = 200
+			Expect(infra.KCP().Client().Update(infra.Ctx(), nfsInstance)).To(Succeed())
+		})
+
+		By("Then KCP NfsInstance has the new capacity in status", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingFieldValue(200, "status", "capacityGb"),
+				).
+				Should(Succeed(), "expected NfsInstance to be resized")
+		})
+
+		By("And Then Azure NFS file share quota is updated", func() {
+			share, err := azureMock.GetNfsFileShare(infra.Ctx(), resourceGroupName, storageAccountName, "vol1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ptr.Deref(share.FileShareProperties.ShareQuota, 0)).To(Equal(int32(200)))
+		})
+
+		// DELETE
+
+		By("When KCP NfsInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance).
+				Should(Succeed(), "failed deleting NfsInstance")
+		})
+
+		By("Then KCP NfsInstance does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance).
+				Should(Succeed())
+		})
+
+		By("And Then Private End Point is deleted", func() {
+			_, err := azureMock.GetPrivateEndPoint(infra.Ctx(), resourceGroupName, name)
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("And Then Azure NFS file share is deleted", func() {
+			_, err := azureMock.GetNfsFileShare(infra.Ctx(), resourceGroupName, storageAccountName, "vol1")
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("And Then Azure storage account is deleted", func() {
+			_, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName, nil)
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("// cleanup: delete KCP IpRange", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange).
+				Should(Succeed())
+		})
+
+		By("// cleanup: delete Scope", func() {
+			Expect(infra.KCP().Client().Delete(infra.Ctx(), scope)).To(Succeed())
+		})
+	})
+})
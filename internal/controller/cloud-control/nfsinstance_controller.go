@@ -29,7 +29,9 @@ import (
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsnfsinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance"
 	awsnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance/client"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 	azurenfsinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nfsinstance"
+	azurenfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nfsinstance/client"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpnfsinstancev2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsinstance/v2"
 	gcpnfsinstancev2client "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsinstance/v2/client"
@@ -45,6 +47,7 @@ import (
 func SetupNfsInstanceReconciler(
 	kcpManager manager.Manager,
 	awsSkrProvider awsclient.SkrClientProvider[awsnfsinstanceclient.Client],
+	azureProvider azureclient.ClientProvider[azurenfsinstanceclient.Client],
 	filestoreClientProviderV2 gcpclient.GcpClientProvider[gcpnfsinstancev2client.FilestoreClient],
 	sapProvider sapclient.SapClientProvider[sapnfsinstanceclient.Client],
 	alicloudProvider alicloudnfsinstanceclient.ClientProvider,
@@ -58,7 +61,7 @@ func SetupNfsInstanceReconciler(
 			composed.NewStateFactory(composed.NewStateClusterFromCluster(kcpManager)),
 			focal.NewStateFactory(),
 			awsnfsinstance.NewStateFactory(awsSkrProvider),
-			azurenfsinstance.NewStateFactory(),
+			azurenfsinstance.NewStateFactory(azureProvider),
 			gcpnfsinstancev2.NewStateFactory(filestoreClientProviderV2, env),
 			sapnfsinstance.NewStateFactory(sapProvider),
 			alicloudnfsinstance.NewStateFactory(alicloudProvider),
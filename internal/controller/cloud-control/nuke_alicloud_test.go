@@ -0,0 +1,151 @@
+package cloudcontrol
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	alicloudmock "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/mock"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature: KCP Nuke Alicloud", func() {
+
+	It("Scenario: KCP Nuke deletes orphan Alicloud NAS file systems and Redis instances created by cloud-manager in the Scope", func() {
+		if !feature.FFNukeBackupsAlicloud.Value(context.Background()) {
+			Skip("Nuke Backups for Alicloud is disabled")
+		}
+
+		const (
+			scopeName = "test-nuke-alicloud-scope-01"
+			region    = "ap-southeast-1"
+		)
+
+		alicloudAccount := infra.AlicloudMock().NewAccount()
+		defer alicloudAccount.Delete()
+		alicloudRegion := alicloudAccount.Region(region)
+
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given KCP Scope exists", func() {
+			kcpscope.Ignore.AddName(scopeName)
+			Eventually(CreateScopeAlicloud).
+				WithArguments(infra.Ctx(), infra, scope, alicloudAccount.Credentials().AccessKeyId, WithName(scopeName)).
+				Should(Succeed())
+		})
+
+		vpcId := scope.Spec.Scope.Alicloud.Network.VPC.Id
+		fileSystemId := "nas-nuke-" + scopeName
+		otherFileSystemId := "nas-nuke-other-" + scopeName
+		customerFileSystemId := "nas-nuke-customer-" + scopeName
+		redisId := "r-nuke-" + scopeName
+		customerRedisId := "r-nuke-customer-" + scopeName
+
+		By("And Given NAS file system created by cloud-manager mounted in the Scope VPC exists", func() {
+			alicloudRegion.AddNasFileSystem(fileSystemId, "NFS", "Performance", "ap-southeast-1a")
+			alicloudRegion.SetNasFileSystemDescription(fileSystemId, "cloud-manager-"+fileSystemId)
+			_, err := alicloudRegion.NfsInstanceClient().CreateMountTarget(infra.Ctx(), fileSystemId, vpcId, "vsw-nuke", "nuke-access-group")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		By("And Given NAS file system mounted in other VPC exists", func() {
+			alicloudRegion.AddNasFileSystem(otherFileSystemId, "NFS", "Performance", "ap-southeast-1a")
+			alicloudRegion.SetNasFileSystemDescription(otherFileSystemId, "cloud-manager-"+otherFileSystemId)
+			_, err := alicloudRegion.NfsInstanceClient().CreateMountTarget(infra.Ctx(), otherFileSystemId, "vpc-other", "vsw-nuke", "nuke-access-group")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		By("And Given NAS file system of the customer mounted in the Scope VPC exists", func() {
+			alicloudRegion.AddNasFileSystem(customerFileSystemId, "NFS", "Performance", "ap-southeast-1a")
+			alicloudRegion.SetNasFileSystemDescription(customerFileSystemId, "customer file system")
+			_, err := alicloudRegion.NfsInstanceClient().CreateMountTarget(infra.Ctx(), customerFileSystemId, vpcId, "vsw-nuke", "nuke-access-group")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		By("And Given Redis instance created by cloud-manager in the Scope VPC exists", func() {
+			alicloudRegion.AddRedisInstance(redisId, "redis.shard.small.2.ce", "7.0", "Normal")
+			alicloudRegion.SetRedisInstance(redisId, func(e *alicloudmock.RedisInstanceEntry) {
+				e.VpcId = vpcId
+				e.InstanceName = "cloud-manager-" + redisId
+			})
+		})
+
+		By("And Given Redis instance of the customer in the Scope VPC exists", func() {
+			alicloudRegion.AddRedisInstance(customerRedisId, "redis.shard.small.2.ce", "7.0", "Normal")
+			alicloudRegion.SetRedisInstance(customerRedisId, func(e *alicloudmock.RedisInstanceEntry) {
+				e.VpcId = vpcId
+				e.InstanceName = "customer-redis"
+			})
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+
+		By("When Nuke for the Scope is created", func() {
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("nuke-"+scopeName),
+				WithScope(scopeName),
+			)).To(Succeed())
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then Nuke status resources have state Deleted", func() {
+			sk := nuke.Status.GetKindNoCreate("AlicloudNasFileSystem")
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveKeyWithValue(fileSystemId, cloudcontrolv1beta1.NukeResourceStatusDeleted))
+			Expect(sk.Objects).NotTo(HaveKey(otherFileSystemId))
+			Expect(sk.Objects).NotTo(HaveKey(customerFileSystemId))
+
+			sk = nuke.Status.GetKindNoCreate("AlicloudRedis")
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveKeyWithValue(redisId, cloudcontrolv1beta1.NukeResourceStatusDeleted))
+			Expect(sk.Objects).NotTo(HaveKey(customerRedisId))
+		})
+
+		By("And Then NAS file system of the Scope does not exist", func() {
+			fs, err := alicloudRegion.NfsInstanceClient().DescribeFileSystem(infra.Ctx(), fileSystemId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs).To(BeNil())
+		})
+
+		By("And Then NAS file system of other VPC still exists", func() {
+			fs, err := alicloudRegion.NfsInstanceClient().DescribeFileSystem(infra.Ctx(), otherFileSystemId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs).NotTo(BeNil())
+		})
+
+		By("And Then NAS file system of the customer still exists", func() {
+			fs, err := alicloudRegion.NfsInstanceClient().DescribeFileSystem(infra.Ctx(), customerFileSystemId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs).NotTo(BeNil())
+		})
+
+		By("And Then Redis instance of the Scope does not exist", func() {
+			Expect(alicloudRegion.GetRedisInstance(redisId)).To(BeNil())
+		})
+
+		By("And Then Redis instance of the customer still exists", func() {
+			Expect(alicloudRegion.GetRedisInstance(customerRedisId)).NotTo(BeNil())
+		})
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
+})
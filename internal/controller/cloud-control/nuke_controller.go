@@ -6,6 +6,8 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	kcpnuke "github.com/kyma-project/cloud-manager/pkg/kcp/nuke"
+	alicloudnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke"
+	alicloudnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke/client"
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke"
 	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
@@ -15,6 +17,9 @@ import (
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpnfsbackupclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v2"
 	gcpnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke"
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	sapnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nuke"
+	sapnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nuke/client"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -28,6 +33,8 @@ func SetupNukeReconciler(
 	gcpFileBackupClientProvider gcpclient.GcpClientProvider[gcpnfsbackupclientv2.FileBackupClient],
 	awsNukeNfsClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeNfsBackupClient],
 	azureNukeRwxClientProvider azureclient.ClientProvider[azurenukeclient.NukeRwxBackupClient],
+	sapNukeClientProvider sapclient.SapClientProvider[sapnukeclient.Client],
+	alicloudNukeClientProvider alicloudnukeclient.ClientProvider,
 	env abstractions.Environment,
 ) error {
 	baseStateFactory := composed.NewStateFactory(composed.NewStateClusterFromCluster(kcpManager))
@@ -38,6 +45,8 @@ func SetupNukeReconciler(
 			gcpnuke.NewStateFactory(gcpFileBackupClientProvider),
 			awsnuke.NewStateFactory(awsNukeNfsClientProvider, env),
 			azurenuke.NewStateFactory(azureNukeRwxClientProvider, env),
+			sapnuke.NewStateFactory(sapNukeClientProvider),
+			alicloudnuke.NewStateFactory(alicloudNukeClientProvider),
 		),
 	).SetupWithManager(kcpManager)
 }
@@ -230,4 +230,111 @@ var _ = Describe("Feature: Cleanup orphan resources", func() {
 		})
 
 	})
+
+	It("Scenario: KCP Nuke in dry run reports resources without deleting them", func() {
+		const kymaName = "0f6f1a2e-4b1c-4f0e-9d8e-6a3c2b7e5d14"
+
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(kymaName)
+
+			Expect(CreateScopeAzure(infra.Ctx(), infra, scope, WithName(kymaName))).
+				To(Succeed(), "failed creating scope")
+		})
+
+		ipRangeName := "5d8b0c6e-2f7a-4b9e-8c1d-3e4f5a6b7c8d"
+		ipRange := &cloudcontrolv1beta1.IpRange{}
+
+		By("And Given IpRange exists", func() {
+			kcpiprange.Ignore.AddName(ipRangeName)
+
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), ipRange,
+					WithName(ipRangeName),
+					AddFinalizer(api.CommonFinalizerDeletionHook),
+					WithScope(kymaName),
+					WithRemoteRef("foo"),
+					WithKcpIpRangeSpecCidr(common.DefaultCloudManagerCidr),
+				).
+				Should(Succeed(), "failed creating IpRange")
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+
+		By("When Nuke for the Scope is created in dry run", func() {
+			nuke.Spec.DryRun = true
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("9a7c5e3b-1d2f-4a6b-8c0e-2f4a6c8e0b1d"),
+				WithScope(kymaName),
+			)).To(Succeed())
+		})
+
+		By("Then Nuke status state is DryRunCompleted", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState(cloudcontrolv1beta1.NukeStateDryRunCompleted),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then Nuke status resource IpRange has Discovered status", func() {
+			sk := nuke.Status.GetKindNoCreate("IpRange")
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveKeyWithValue(ipRange.Name, cloudcontrolv1beta1.NukeResourceStatusDiscovered))
+		})
+
+		By("And Then IpRange is not marked for deletion", func() {
+			Expect(LoadAndCheck(infra.Ctx(), infra.KCP().Client(), ipRange, NewObjActions())).
+				To(Succeed())
+			Expect(ipRange.GetDeletionTimestamp()).To(BeNil())
+		})
+
+		By("And Then Scope is not deleted", func() {
+			Expect(LoadAndCheck(infra.Ctx(), infra.KCP().Client(), scope, NewObjActions())).
+				To(Succeed())
+			Expect(scope.GetDeletionTimestamp()).To(BeNil())
+		})
+
+		By("When Nuke dry run is turned off", func() {
+			nuke.Spec.DryRun = false
+			Expect(infra.KCP().Client().Update(infra.Ctx(), nuke)).
+				To(Succeed())
+		})
+
+		By("Then IpRange has deletion timestamp", func() {
+			Eventually(func() error {
+				if err := LoadAndCheck(infra.Ctx(), infra.KCP().Client(), ipRange, NewObjActions()); err != nil {
+					return err
+				}
+				if ipRange.GetDeletionTimestamp() == nil {
+					return fmt.Errorf("expected IpRange to have deletion timestamp")
+				}
+				return nil
+			}).Should(Succeed())
+		})
+
+		By("When IpRange finalizer is removed", func() {
+			_, err := composed.PatchObjRemoveFinalizer(infra.Ctx(), api.CommonFinalizerDeletionHook, ipRange, infra.KCP().Client())
+			Expect(err).To(Succeed())
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).Should(Succeed())
+		})
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
 })
@@ -0,0 +1,119 @@
+package cloudcontrol
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature: KCP Nuke SapNfsVolumeSnapshot", func() {
+
+	It("Scenario: KCP Nuke deletes SAP Manila snapshots of the Scope", func() {
+		if !feature.FFNukeBackupsSap.Value(context.Background()) {
+			Skip("Nuke Backups for SAP is disabled")
+		}
+
+		scopeName := "test-nuke-sap-nfs-snapshots-scope-01"
+		scope := &cloudcontrolv1beta1.Scope{}
+		sapMock := infra.SapMock().NewProject()
+
+		By("Given KCP Scope exists", func() {
+			kcpscope.Ignore.AddName(scopeName)
+			Expect(CreateScopeOpenStack(infra.Ctx(), infra, scope, sapMock.ProviderParams(), WithName(scopeName))).
+				To(Succeed())
+		})
+
+		var snapshotIds []string
+
+		By("And Given Manila snapshots exist for the Scope share", func() {
+			share, err := sapMock.CreateShareOp(infra.Ctx(), "share-network-"+scopeName, "share-"+scopeName, 1, "", map[string]string{
+				common.TagScope: scopeName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			for i := 0; i < 2; i++ {
+				snapshot, err := sapMock.CreateSnapshot(infra.Ctx(), snapshots.CreateOpts{
+					ShareID: share.ID,
+					Name:    fmt.Sprintf("snapshot-%s-%d", scopeName, i),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				snapshotIds = append(snapshotIds, snapshot.ID)
+			}
+		})
+
+		var otherSnapshotId string
+
+		By("And Given Manila snapshot exists for the share of other Scope", func() {
+			share, err := sapMock.CreateShareOp(infra.Ctx(), "share-network-"+scopeName, "share-other-"+scopeName, 1, "", map[string]string{
+				common.TagScope: "other-" + scopeName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			snapshot, err := sapMock.CreateSnapshot(infra.Ctx(), snapshots.CreateOpts{
+				ShareID: share.ID,
+				Name:    "snapshot-other-" + scopeName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			otherSnapshotId = snapshot.ID
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+
+		By("When Nuke for the Scope is created", func() {
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("nuke-"+scopeName),
+				WithScope(scopeName),
+			)).To(Succeed())
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).
+				Should(Succeed())
+		})
+
+		kind := "SapNfsVolumeSnapshot"
+
+		By(fmt.Sprintf("And Then Nuke status resource %s has state Deleted", kind), func() {
+			sk := nuke.Status.GetKindNoCreate(kind)
+			Expect(sk).NotTo(BeNil())
+			for _, id := range snapshotIds {
+				Expect(sk.Objects[id]).To(Equal(cloudcontrolv1beta1.NukeResourceStatusDeleted))
+			}
+			Expect(sk.Objects).NotTo(HaveKey(otherSnapshotId))
+		})
+
+		By("And Then Manila snapshots of the Scope do not exist", func() {
+			for _, id := range snapshotIds {
+				snapshot, err := sapMock.GetSnapshot(infra.Ctx(), id)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot).To(BeNil())
+			}
+		})
+
+		By("And Then Manila snapshot of other Scope still exists", func() {
+			snapshot, err := sapMock.GetSnapshot(infra.Ctx(), otherSnapshotId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot).NotTo(BeNil())
+		})
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
+})
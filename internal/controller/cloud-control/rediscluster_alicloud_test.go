@@ -0,0 +1,473 @@
+package cloudcontrol
+
+import (
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	alicloudmock "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/mock"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Feature: KCP AliCloud RedisCluster", func() {
+
+	It("Scenario: KCP AliCloud RedisCluster is created and deleted", func() {
+
+		alicloudAccount := infra.AlicloudMock().NewAccount()
+		defer alicloudAccount.Delete()
+
+		name := "6f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+			Eventually(CreateScopeAlicloud).
+				WithArguments(infra.Ctx(), infra, scope, alicloudAccount.Credentials().AccessKeyId, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "7a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithKcpIpRangeStatusVpcId("vpc-alicloud-cluster-01"),
+					WithKcpIpRangeStatusSubnets(cloudcontrolv1beta1.IpRangeSubnet{
+						Id:   "vsw-alicloud-cluster-01",
+						Zone: "cn-hangzhou-a",
+					}),
+					WithConditions(KcpReadyCondition()),
+				).Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		redisCluster := &cloudcontrolv1beta1.RedisCluster{}
+		instanceClass := "redis.logic.sharding.4g.2db.0rodb.4proxy.default"
+
+		By("When RedisCluster is created", func() {
+			Eventually(CreateRedisCluster).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					WithName(name),
+					WithRemoteRef("skr-alicloud-redis-cluster-example"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisClusterAlicloud(),
+					WithKcpAlicloudRedisClusterInstanceClass(instanceClass),
+					WithKcpAlicloudRedisEngineVersion("5.0"),
+					WithKcpAlicloudRedisClusterShardCount(2),
+				).Should(Succeed(), "failed creating RedisCluster")
+		})
+
+		alicloudMock := alicloudAccount.Region(scope.Spec.Region)
+
+		By("Then AliCloud Redis cluster is created in Creating status", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+				).Should(Succeed(), "expected RedisCluster to get status.id")
+		})
+
+		By("Then RedisCluster has Ready condition", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+					HavingFieldSet("status", "discoveryEndpoint"),
+					HavingFieldSet("status", "authString"),
+				)
+			}).Should(Succeed(), "expected RedisCluster to reach Ready state")
+		})
+
+		By("And Then RedisCluster status reflects the AliCloud cluster shape", func() {
+			Expect(redisCluster.Status.NodeType).To(Equal(instanceClass))
+			Expect(redisCluster.Status.ShardCount).To(Equal(int32(2)))
+			Expect(redisCluster.Status.ReplicasPerShard).To(Equal(int32(0)))
+		})
+
+		By("And Then AliCloud cluster has SSL enabled and security IPs set", func() {
+			entry := alicloudMock.GetRedisCluster(redisCluster.Status.Id)
+			Expect(entry).NotTo(BeNil(), "expected mock cluster entry to exist")
+			Expect(entry.SslEnabled).To(BeTrue(), "expected SSL to be enabled")
+			Expect(entry.SecurityIps).To(ContainSubstring(scope.Spec.Scope.Alicloud.Network.Nodes))
+			Expect(entry.VSwitchId).To(Equal("vsw-alicloud-cluster-01"))
+		})
+
+		// DELETE
+
+		By("When RedisCluster is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster).
+				Should(Succeed(), "failed deleting RedisCluster")
+		})
+
+		By("Then RedisCluster does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster).
+				Should(Succeed(), "expected RedisCluster to be deleted")
+		})
+
+		By("And Then AliCloud cluster does not exist", func() {
+			Expect(alicloudMock.GetRedisCluster(redisCluster.Status.Id)).To(BeNil())
+		})
+	})
+
+	It("Scenario: KCP AliCloud RedisCluster proxy class shard resize is reconciled", func() {
+
+		alicloudAccount := infra.AlicloudMock().NewAccount()
+		defer alicloudAccount.Delete()
+
+		name := "8b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+			Eventually(CreateScopeAlicloud).
+				WithArguments(infra.Ctx(), infra, scope, alicloudAccount.Credentials().AccessKeyId, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "9c4d5e6f-7a8b-4c9d-8e1f-2a3b4c5d6e7f"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithKcpIpRangeStatusVpcId("vpc-alicloud-cluster-02"),
+					WithKcpIpRangeStatusSubnets(cloudcontrolv1beta1.IpRangeSubnet{
+						Id:   "vsw-alicloud-cluster-02",
+						Zone: "cn-hangzhou-a",
+					}),
+					WithConditions(KcpReadyCondition()),
+				).Should(Succeed())
+		})
+
+		redisCluster := &cloudcontrolv1beta1.RedisCluster{}
+
+		By("And Given RedisCluster is created with 2 shards", func() {
+			Eventually(CreateRedisCluster).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					WithName(name),
+					WithRemoteRef("skr-alicloud-redis-cluster-resize"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisClusterAlicloud(),
+					WithKcpAlicloudRedisClusterInstanceClass("redis.logic.sharding.4g.2db.0rodb.4proxy.default"),
+					WithKcpAlicloudRedisEngineVersion("5.0"),
+					WithKcpAlicloudRedisClusterShardCount(2),
+				).Should(Succeed())
+		})
+
+		alicloudMock := alicloudAccount.Region(scope.Spec.Region)
+
+		By("And Given RedisCluster is Ready", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				)
+			}).Should(Succeed())
+		})
+
+		By("When instanceClass and shardCount are changed to 8 shards", func() {
+			Eventually(func() error {
+				if err := infra.KCP().Client().Get(infra.Ctx(),
+					client.ObjectKeyFromObject(redisCluster), redisCluster); err != nil {
+					return err
+				}
+				redisCluster.Spec.Instance.Alicloud.InstanceClass = "redis.logic.sharding.4g.8db.0rodb.8proxy.default"
+				redisCluster.Spec.Instance.Alicloud.ShardCount = 8
+				return infra.KCP().Client().Update(infra.Ctx(), redisCluster)
+			}).Should(Succeed())
+		})
+
+		By("Then RedisCluster is Ready with the new shard count", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+					HavingFieldValue("redis.logic.sharding.4g.8db.0rodb.8proxy.default", "status", "nodeType"),
+					HavingFieldValue(int64(8), "status", "shardCount"),
+				)
+			}).Should(Succeed(), "expected RedisCluster to reach Ready with 8 shards")
+		})
+
+		// DELETE
+
+		By("When RedisCluster is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster).
+				Should(Succeed())
+		})
+
+		By("Then RedisCluster does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: KCP AliCloud RedisCluster non-proxy shard count is reconciled via sharding nodes", func() {
+
+		alicloudAccount := infra.AlicloudMock().NewAccount()
+		defer alicloudAccount.Delete()
+
+		name := "0d5e6f7a-8b9c-4d0e-9f1a-3b4c5d6e7f80"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+			Eventually(CreateScopeAlicloud).
+				WithArguments(infra.Ctx(), infra, scope, alicloudAccount.Credentials().AccessKeyId, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "1e6f7a8b-9c0d-4e1f-8a2b-4c5d6e7f8091"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithKcpIpRangeStatusVpcId("vpc-alicloud-cluster-03"),
+					WithKcpIpRangeStatusSubnets(cloudcontrolv1beta1.IpRangeSubnet{
+						Id:   "vsw-alicloud-cluster-03",
+						Zone: "cn-hangzhou-a",
+					}),
+					WithConditions(KcpReadyCondition()),
+				).Should(Succeed())
+		})
+
+		redisCluster := &cloudcontrolv1beta1.RedisCluster{}
+
+		By("And Given RedisCluster is created with a cloud-native class and 2 shards", func() {
+			Eventually(CreateRedisCluster).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					WithName(name),
+					WithRemoteRef("skr-alicloud-redis-cluster-nodes"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisClusterAlicloud(),
+					WithKcpAlicloudRedisClusterInstanceClass("redis.shard.small.2.ce"),
+					WithKcpAlicloudRedisEngineVersion("7.0"),
+					WithKcpAlicloudRedisClusterShardCount(2),
+				).Should(Succeed())
+		})
+
+		alicloudMock := alicloudAccount.Region(scope.Spec.Region)
+
+		By("And Given RedisCluster is Ready", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+					HavingFieldValue(int64(2), "status", "shardCount"),
+				)
+			}).Should(Succeed())
+		})
+
+		By("When shardCount is increased to 4", func() {
+			Eventually(func() error {
+				if err := infra.KCP().Client().Get(infra.Ctx(),
+					client.ObjectKeyFromObject(redisCluster), redisCluster); err != nil {
+					return err
+				}
+				redisCluster.Spec.Instance.Alicloud.ShardCount = 4
+				return infra.KCP().Client().Update(infra.Ctx(), redisCluster)
+			}).Should(Succeed())
+		})
+
+		By("Then RedisCluster is Ready with 4 shards and unchanged instanceClass", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+					HavingFieldValue("redis.shard.small.2.ce", "status", "nodeType"),
+					HavingFieldValue(int64(4), "status", "shardCount"),
+				)
+			}).Should(Succeed(), "expected RedisCluster to reach Ready with 4 shards")
+		})
+
+		By("When shardCount is decreased to 3", func() {
+			Eventually(func() error {
+				if err := infra.KCP().Client().Get(infra.Ctx(),
+					client.ObjectKeyFromObject(redisCluster), redisCluster); err != nil {
+					return err
+				}
+				redisCluster.Spec.Instance.Alicloud.ShardCount = 3
+				return infra.KCP().Client().Update(infra.Ctx(), redisCluster)
+			}).Should(Succeed())
+		})
+
+		By("Then RedisCluster is Ready with 3 shards", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+					HavingFieldValue(int64(3), "status", "shardCount"),
+				)
+			}).Should(Succeed(), "expected RedisCluster to reach Ready with 3 shards")
+		})
+
+		// DELETE
+
+		By("When RedisCluster is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster).
+				Should(Succeed())
+		})
+
+		By("Then RedisCluster does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisCluster).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: KCP AliCloud RedisCluster adopts the cloud-manager named cluster after a crash before the status write", func() {
+
+		alicloudAccount := infra.AlicloudMock().NewAccount()
+		defer alicloudAccount.Delete()
+
+		name := "c0d1e2f3-a4b5-4c6d-9e7f-809102132435"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+			Eventually(CreateScopeAlicloud).
+				WithArguments(infra.Ctx(), infra, scope, alicloudAccount.Credentials().AccessKeyId, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "d1e2f3a4-b5c6-4d7e-8f80-910213243546"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithKcpIpRangeStatusVpcId("vpc-alicloud-cluster-recover-01"),
+					WithKcpIpRangeStatusSubnets(cloudcontrolv1beta1.IpRangeSubnet{
+						Id:   "vsw-alicloud-cluster-recover-01",
+						Zone: "cn-hangzhou-a",
+					}),
+					WithConditions(KcpReadyCondition()),
+				).Should(Succeed())
+		})
+
+		alicloudMock := alicloudAccount.Region(scope.Spec.Region)
+		instanceId := "r-recover-" + name[:8]
+
+		By("And Given AliCloud r-kvstore instance was created but its ID was not persisted", func() {
+			alicloudMock.AddRedisCluster(instanceId, "redis.logic.sharding.4g.2db.0rodb.4proxy.default", "5.0", "Normal", 2)
+			alicloudMock.SetRedisCluster(instanceId, func(e *alicloudmock.RedisClusterEntry) {
+				e.InstanceName = "cloud-manager-" + name
+				e.VpcId = "vpc-alicloud-cluster-recover-01"
+			})
+		})
+
+		redisObj := &cloudcontrolv1beta1.RedisCluster{}
+
+		By("When RedisCluster is created", func() {
+			Eventually(CreateRedisCluster).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj,
+					WithName(name),
+					WithRemoteRef("skr-alicloud-redis-cluster-recover"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisClusterAlicloud(),
+					WithKcpAlicloudRedisClusterInstanceClass("redis.logic.sharding.4g.2db.0rodb.4proxy.default"),
+					WithKcpAlicloudRedisEngineVersion("5.0"),
+					WithKcpAlicloudRedisClusterShardCount(2),
+				).Should(Succeed())
+		})
+
+		By("Then RedisCluster adopts the existing AliCloud instance", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj,
+					NewObjActions(),
+					HavingFieldValue(instanceId, "status", "id"),
+				).Should(Succeed(), "expected RedisCluster to recover the instance ID by name")
+		})
+
+		By("And Then RedisCluster has Ready condition", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisObj,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingFieldValue(instanceId, "status", "id"),
+				)
+			}).Should(Succeed())
+		})
+
+		// DELETE
+
+		By("When RedisCluster is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj).
+				Should(Succeed())
+		})
+
+		By("Then RedisCluster does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj).
+				Should(Succeed())
+		})
+	})
+
+})
@@ -6,6 +6,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	alicloudmock "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/mock"
 	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	. "github.com/onsi/ginkgo/v2"
@@ -342,4 +343,204 @@ var _ = Describe("Feature: KCP AliCloud RedisInstance", func() {
 		})
 	})
 
+	It("Scenario: KCP AliCloud RedisInstance adopts the cloud-manager named instance after a crash before the status write", func() {
+
+		alicloudAccount := infra.AlicloudMock().NewAccount()
+		defer alicloudAccount.Delete()
+
+		name := "e6f7a8b9-c0d1-4e2f-9a3b-4c5d6e7f8091"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+			Eventually(CreateScopeAlicloud).
+				WithArguments(infra.Ctx(), infra, scope, alicloudAccount.Credentials().AccessKeyId, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "f7a8b9c0-d1e2-4f3a-8b4c-5d6e7f809102"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithKcpIpRangeStatusVpcId("vpc-alicloud-test-recover-01"),
+					WithKcpIpRangeStatusSubnets(cloudcontrolv1beta1.IpRangeSubnet{
+						Id:   "vsw-alicloud-test-recover-01",
+						Zone: "cn-hangzhou-a",
+					}),
+					WithConditions(KcpReadyCondition()),
+				).Should(Succeed())
+		})
+
+		alicloudMock := alicloudAccount.Region(scope.Spec.Region)
+		instanceId := "r-recover-" + name[:8]
+
+		By("And Given AliCloud r-kvstore instance was created but its ID was not persisted", func() {
+			alicloudMock.AddRedisInstance(instanceId, "tair.rdb.1g", "7.0", "Normal")
+			alicloudMock.SetRedisInstance(instanceId, func(e *alicloudmock.RedisInstanceEntry) {
+				e.InstanceName = "cloud-manager-" + name
+				e.VpcId = "vpc-alicloud-test-recover-01"
+			})
+		})
+
+		redisObj := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("When RedisInstance is created", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj,
+					WithName(name),
+					WithRemoteRef("skr-alicloud-redis-recover"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisInstanceAlicloud(),
+					WithKcpAlicloudRedisInstanceClass("tair.rdb.1g"),
+					WithKcpAlicloudRedisEngineVersion("7.0"),
+				).Should(Succeed())
+		})
+
+		By("Then RedisInstance adopts the existing AliCloud instance", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj,
+					NewObjActions(),
+					HavingFieldValue(instanceId, "status", "id"),
+				).Should(Succeed(), "expected RedisInstance to recover the instance ID by name")
+		})
+
+		By("And Then RedisInstance has Ready condition", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisObj,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingFieldValue(instanceId, "status", "id"),
+				)
+			}).Should(Succeed())
+		})
+
+		// DELETE
+
+		By("When RedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj).
+				Should(Succeed())
+		})
+
+		By("Then RedisInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: KCP AliCloud RedisInstance adopts the instance with the legacy bare name after a crash before the status write", func() {
+
+		alicloudAccount := infra.AlicloudMock().NewAccount()
+		defer alicloudAccount.Delete()
+
+		name := "a8b9c0d1-e2f3-4a4b-9c5d-6e7f80910213"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+			Eventually(CreateScopeAlicloud).
+				WithArguments(infra.Ctx(), infra, scope, alicloudAccount.Credentials().AccessKeyId, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "b9c0d1e2-f3a4-4b5c-8d6e-7f8091021324"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithKcpIpRangeStatusVpcId("vpc-alicloud-test-recover-02"),
+					WithKcpIpRangeStatusSubnets(cloudcontrolv1beta1.IpRangeSubnet{
+						Id:   "vsw-alicloud-test-recover-02",
+						Zone: "cn-hangzhou-a",
+					}),
+					WithConditions(KcpReadyCondition()),
+				).Should(Succeed())
+		})
+
+		alicloudMock := alicloudAccount.Region(scope.Spec.Region)
+		instanceId := "r-recover-" + name[:8]
+
+		By("And Given AliCloud r-kvstore instance was created but its ID was not persisted", func() {
+			alicloudMock.AddRedisInstance(instanceId, "tair.rdb.1g", "7.0", "Normal")
+			alicloudMock.SetRedisInstance(instanceId, func(e *alicloudmock.RedisInstanceEntry) {
+				e.InstanceName = name
+				e.VpcId = "vpc-alicloud-test-recover-02"
+			})
+		})
+
+		redisObj := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("When RedisInstance is created", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj,
+					WithName(name),
+					WithRemoteRef("skr-alicloud-redis-recover-legacy"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisInstanceAlicloud(),
+					WithKcpAlicloudRedisInstanceClass("tair.rdb.1g"),
+					WithKcpAlicloudRedisEngineVersion("7.0"),
+				).Should(Succeed())
+		})
+
+		By("Then RedisInstance adopts the existing AliCloud instance", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj,
+					NewObjActions(),
+					HavingFieldValue(instanceId, "status", "id"),
+				).Should(Succeed(), "expected RedisInstance to recover the instance ID by name")
+		})
+
+		By("And Then RedisInstance has Ready condition", func() {
+			Eventually(func() error {
+				alicloudMock.TransitionAllToNormal()
+				return LoadAndCheck(infra.Ctx(), infra.KCP().Client(), redisObj,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingFieldValue(instanceId, "status", "id"),
+				)
+			}).Should(Succeed())
+		})
+
+		// DELETE
+
+		By("When RedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj).
+				Should(Succeed())
+		})
+
+		By("Then RedisInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisObj).
+				Should(Succeed())
+		})
+	})
+
 })
@@ -0,0 +1,187 @@
+package cloudcontrol
+
+import (
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsredisuser "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/redisuser"
+	kcpredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Feature: KCP RedisUser", func() {
+
+	It("Scenario: KCP AWS RedisUser is created, modified and deleted", func() {
+
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+
+		name := "1b0c6a3e-2f4e-4f52-9d3a-6c7b4e2a9f10"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAws).
+				WithArguments(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		redisInstanceName := "5c2d9a1f-8e7b-4c3a-b6d0-2f1e9a8c7b64"
+		redisInstance := &cloudcontrolv1beta1.RedisInstance{}
+		primaryEndpoint := "redis.example.com:6379"
+
+		By("And Given KCP RedisInstance exists", func() {
+			kcpredisinstance.Ignore.AddName(redisInstanceName)
+
+			Eventually(CreateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					WithName(redisInstanceName),
+					WithRemoteRef("skr-redis-user-target-aws"),
+					WithScope(name),
+					WithRedisInstanceAws(),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP RedisInstance is Ready", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					WithRedisInstancePrimaryEndpoint(primaryEndpoint),
+					WithState("Ready"),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+		userGroupName := awsredisuser.GetAwsElastiCacheUserGroupName(redisInstanceName)
+
+		By("And Given AWS user group exists", func() {
+			awsMock.PreCreateUserGroup(userGroupName, awsmeta.ElastiCache_UserGroup_ACTIVE)
+		})
+
+		redisUserName := "9e4f7b2c-3a1d-4e6f-8b5c-0d7a2e9f1c38"
+		redisUser := &cloudcontrolv1beta1.RedisUser{}
+		userId := awsredisuser.GetAwsElastiCacheUserId(redisUserName)
+
+		By("When KCP RedisUser is created", func() {
+			Eventually(CreateKcpRedisUser).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser,
+					WithName(redisUserName),
+					WithRemoteRef("skr-redis-user-aws"),
+					WithScope(name),
+					WithKcpRedisUserRedis(cloudcontrolv1beta1.RedisUserTargetKindRedisInstance, redisInstanceName),
+					WithKcpRedisUserUserName("app1"),
+					WithKcpRedisUserAcl([]string{"app1:*"}, []string{"+@read"}),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP RedisUser has Ready state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then KCP RedisUser has status fields set", func() {
+			Expect(redisUser.Status.Id).To(Equal(userId))
+			Expect(redisUser.Status.Endpoint).To(Equal(primaryEndpoint))
+			Expect(redisUser.Status.AuthString).NotTo(BeEmpty())
+		})
+
+		By("And Then AWS user is created with the requested access string", func() {
+			user := awsMock.GetAwsElastiCacheUserById(userId)
+			Expect(user).NotTo(BeNil())
+			Expect(ptr.Deref(user.UserName, "")).To(Equal("app1"))
+			Expect(ptr.Deref(user.AccessString, "")).To(Equal("on ~app1:* +@read"))
+			Expect(awsMock.GetAwsElastiCacheUserPassword(userId)).To(Equal(redisUser.Status.AuthString))
+		})
+
+		By("And Then AWS user is added to the user group", func() {
+			Expect(awsMock.GetAwsElastiCacheUserGroupByName(userGroupName).UserIds).To(ContainElement(userId))
+		})
+
+		defaultUserId := awsredisuser.GetAwsElastiCacheDefaultUserId(redisInstanceName)
+
+		By("And Then AWS user group no longer holds the built-in default user", func() {
+			Expect(awsMock.GetAwsElastiCacheUserGroupByName(userGroupName).UserIds).NotTo(ContainElement(awsmeta.ElastiCache_DefaultUser))
+			Expect(awsMock.GetAwsElastiCacheUserGroupByName(userGroupName).UserIds).To(ContainElement(defaultUserId))
+		})
+
+		By("And Then AWS default user replacing the built-in one has access off", func() {
+			user := awsMock.GetAwsElastiCacheUserById(defaultUserId)
+			Expect(user).NotTo(BeNil())
+			Expect(ptr.Deref(user.UserName, "")).To(Equal(awsmeta.ElastiCache_DefaultUser))
+			Expect(ptr.Deref(user.AccessString, "")).To(Equal("off"))
+		})
+
+		By("When KCP RedisUser commands are modified", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser,
+					WithKcpRedisUserAcl([]string{"app1:*"}, []string{"+@read", "+@write"}),
+				).
+				Should(Succeed())
+		})
+
+		By("Then AWS user access string is modified", func() {
+			Eventually(func() string {
+				return ptr.Deref(awsMock.GetAwsElastiCacheUserById(userId).AccessString, "")
+			}).Should(Equal("on ~app1:* +@read +@write"))
+		})
+
+		// DELETE
+
+		By("When KCP RedisUser is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser).
+				Should(Succeed())
+		})
+
+		By("Then AWS user is removed from the user group", func() {
+			Eventually(func() []string {
+				return awsMock.GetAwsElastiCacheUserGroupByName(userGroupName).UserIds
+			}).ShouldNot(ContainElement(userId))
+		})
+
+		By("And Then AWS user group holds the built-in default user again", func() {
+			Eventually(func() []string {
+				return awsMock.GetAwsElastiCacheUserGroupByName(userGroupName).UserIds
+			}).Should(ConsistOf(awsmeta.ElastiCache_DefaultUser))
+		})
+
+		By("And Then AWS default user replacing the built-in one is deleted", func() {
+			Eventually(func() string {
+				return ptr.Deref(awsMock.GetAwsElastiCacheUserById(defaultUserId).Status, "")
+			}).Should(Equal(string(awsmeta.ElastiCache_User_DELETING)))
+		})
+
+		By("And When AWS user is deleted", func() {
+			Eventually(func() string {
+				return ptr.Deref(awsMock.GetAwsElastiCacheUserById(userId).Status, "")
+			}).Should(Equal(string(awsmeta.ElastiCache_User_DELETING)))
+			awsMock.DeleteAwsElastiCacheUserById(userId)
+		})
+
+		By("Then KCP RedisUser does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser).
+				Should(Succeed())
+		})
+
+		By("// cleanup: delete KCP RedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,276 @@
+package cloudcontrol
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redisenterprise/armredisenterprise/v3"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	kcpazuremanagedredis "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/managedredis"
+	azureredisuser "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisuser"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	kcpsubscription "github.com/kyma-project/cloud-manager/pkg/kcp/subscription"
+	kcpvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/vpcnetwork"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Feature: KCP RedisUser Azure", func() {
+
+	It("Scenario: KCP Azure RedisUser is created and deleted", func() {
+
+		name := "3f7a2c9e-1b4d-4e8a-9c6f-2d5b8e1a7c40"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAzure).
+				WithArguments(infra.Ctx(), infra, scope, WithName(name)).
+				Should(Succeed())
+		})
+
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+		resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(scope.Spec.Scope.Azure.VpcNetwork)
+
+		subscription := &cloudcontrolv1beta1.Subscription{}
+
+		By("And Given Azure Subscription exists", func() {
+			kcpsubscription.Ignore.AddName(name)
+			Expect(
+				CreateSubscription(infra.Ctx(), infra, subscription,
+					WithName(name),
+					WithSubscriptionSpecGarden("binding-name")),
+			).To(Succeed())
+
+			Expect(
+				SubscriptionPatchStatusReadyAzure(infra.Ctx(), infra, subscription,
+					scope.Spec.Scope.Azure.TenantId, scope.Spec.Scope.Azure.SubscriptionId),
+			).To(Succeed())
+		})
+
+		vpcNetwork := &cloudcontrolv1beta1.VpcNetwork{}
+
+		By("And Given KCP VpcNetwork exists in Ready state", func() {
+			vpcNetworkName := scope.Spec.Scope.Azure.VpcNetwork
+			vpcNetwork = cloudcontrolv1beta1.NewVpcNetworkBuilder().
+				WithName(name).
+				WithVpcNetworkName(&vpcNetworkName).
+				WithRegion(scope.Spec.Region).
+				WithSubscription(name).
+				WithCidrBlocks("10.250.0.0/22").
+				Build()
+
+			kcpvpcnetwork.Ignore.AddName(name)
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), vpcNetwork).
+				Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), vpcNetwork,
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP VpcNetwork to become ready")
+		})
+
+		kcpIpRangeName := "8d1e4b7a-6c2f-4a9e-b3d5-7f0c2e9a4b61"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		By("And Given KCP IpRange exists in Ready state", func() {
+			kcpiprange.Ignore.AddName(kcpIpRangeName)
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithKcpIpRangeRemoteRef("amr-redis-user-iprange"),
+					WithKcpIpRangeNetwork(name),
+					WithScope(name),
+				).
+				Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		azureManagedRedis := &cloudcontrolv1beta1.AzureManagedRedis{}
+
+		By("And Given KCP AzureManagedRedis exists", func() {
+			Eventually(CreateKcpAzureManagedRedis).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), azureManagedRedis,
+					WithName(name),
+					WithRemoteRef("skr-redis-user-target-amr"),
+					WithKcpAzureManagedRedisVpcNetwork(name),
+					WithIpRange(kcpIpRangeName),
+					WithKcpAzureManagedRedisSKU(armredisenterprise.SKUNameBalancedB5),
+					WithKcpAzureManagedRedisClusteringPolicy(armredisenterprise.ClusteringPolicyEnterpriseCluster),
+					WithKcpAzureManagedRedisHighAvailability(false),
+					WithScope(name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP AzureManagedRedis is Ready", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), azureManagedRedis,
+					NewObjActions(),
+					HavingState(string(cloudcontrolv1beta1.StateReady)),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+				).
+				Should(Succeed())
+		})
+
+		redisUserName := "c5e8a1d3-9f2b-4c7e-a6d0-4b1f8e3c2a95"
+		redisUser := &cloudcontrolv1beta1.RedisUser{}
+		assignmentName := strings.ReplaceAll(redisUserName, "-", "")
+		objectId := "7a3c9e1f-2b4d-4f6a-8c0e-1d3f5b7a9c2e"
+
+		By("When KCP RedisUser is created", func() {
+			Eventually(CreateKcpRedisUser).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser,
+					WithName(redisUserName),
+					WithRemoteRef("skr-redis-user-azure"),
+					WithScope(name),
+					WithKcpRedisUserRedis(cloudcontrolv1beta1.RedisUserTargetKindAzureManagedRedis, name),
+					WithKcpRedisUserAzureObjectId(objectId),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP RedisUser has Ready state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then Azure access policy assignment is created for the principal", func() {
+			assignment, err := azureMock.GetManagedRedisAccessPolicyAssignment(infra.Ctx(), resourceGroupName, name, azureredisuser.DefaultDatabaseName, assignmentName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(assignment.Properties).NotTo(BeNil())
+			Expect(ptr.Deref(assignment.Properties.AccessPolicyName, "")).To(Equal(azureredisuser.DefaultAccessPolicyName))
+			Expect(ptr.Deref(assignment.Properties.User.ObjectID, "")).To(Equal(objectId))
+		})
+
+		By("And Then KCP RedisUser has status fields set", func() {
+			Expect(redisUser.Status.Id).To(HaveSuffix("/accessPolicyAssignments/" + assignmentName))
+			Expect(redisUser.Status.Endpoint).To(Equal(azureManagedRedis.Status.PrimaryEndpoint))
+		})
+
+		// DELETE
+
+		By("When KCP RedisUser is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser).
+				Should(Succeed())
+		})
+
+		By("Then KCP RedisUser does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser).
+				Should(Succeed())
+		})
+
+		By("And Then Azure access policy assignment does not exist", func() {
+			_, err := azureMock.GetManagedRedisAccessPolicyAssignment(infra.Ctx(), resourceGroupName, name, azureredisuser.DefaultDatabaseName, assignmentName)
+			Expect(err).To(HaveOccurred())
+		})
+
+		By("// cleanup: delete KCP AzureManagedRedis", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), azureManagedRedis).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), azureManagedRedis).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: KCP Azure RedisUser with custom ACL is rejected", func() {
+
+		name := "e2b6d9f1-4a7c-4e3b-8d1a-9c5f2b7e0d38"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAzure).
+				WithArguments(infra.Ctx(), infra, scope, WithName(name)).
+				Should(Succeed())
+		})
+
+		azureManagedRedisName := "0a4c7e2d-5b9f-4d1e-b8a3-6f2c9d4e1b70"
+		azureManagedRedis := &cloudcontrolv1beta1.AzureManagedRedis{}
+
+		By("And Given KCP AzureManagedRedis exists in Ready state", func() {
+			kcpazuremanagedredis.Ignore.AddName(azureManagedRedisName)
+
+			Eventually(CreateKcpAzureManagedRedis).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), azureManagedRedis,
+					WithName(azureManagedRedisName),
+					WithRemoteRef("skr-redis-user-target-amr-acl"),
+					WithKcpAzureManagedRedisVpcNetwork(name),
+					WithIpRange(name),
+					WithKcpAzureManagedRedisSKU(armredisenterprise.SKUNameBalancedB5),
+					WithKcpAzureManagedRedisClusteringPolicy(armredisenterprise.ClusteringPolicyEnterpriseCluster),
+					WithScope(name),
+				).
+				Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), azureManagedRedis,
+					WithState("Ready"),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		redisUser := &cloudcontrolv1beta1.RedisUser{}
+
+		By("When KCP RedisUser is created with keyPatterns and commands", func() {
+			Eventually(CreateKcpRedisUser).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser,
+					WithName("6d9b2e4f-8c1a-4b7d-9e3f-5a0c7d2b8e14"),
+					WithRemoteRef("skr-redis-user-azure-acl"),
+					WithScope(name),
+					WithKcpRedisUserRedis(cloudcontrolv1beta1.RedisUserTargetKindAzureManagedRedis, azureManagedRedisName),
+					WithKcpRedisUserAzureObjectId("9b2d4f6a-1c3e-4a5b-8d7f-0e2c4a6b8d1f"),
+					WithKcpRedisUserAcl([]string{"app1:*"}, []string{"+@read"}),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP RedisUser has Error condition with invalid spec reason", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser,
+					NewObjActions(),
+					HavingConditionReasonTrue(cloudcontrolv1beta1.ConditionTypeError, cloudcontrolv1beta1.ReasonInvalidSpec),
+					HavingState("Error"),
+				).
+				Should(Succeed())
+		})
+
+		By("// cleanup: delete KCP RedisUser and AzureManagedRedis", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisUser).
+				Should(Succeed())
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), azureManagedRedis).
+				Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudcontrol
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsredisuser "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/redisuser"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azuremanagedredisclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/managedredis/client"
+	azureredisuser "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisuser"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/redisuser"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+func SetupRedisUserReconciler(
+	kcpManager manager.Manager,
+	awsElastiCacheClientProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient],
+	azureManagedRedisClientProvider azureclient.ClientProvider[azuremanagedredisclient.Client],
+) error {
+	return NewRedisUserReconciler(
+		redisuser.NewRedisUserReconciler(
+			composed.NewStateFactory(composed.NewStateClusterFromCluster(kcpManager)),
+			focal.NewStateFactory(),
+			awsredisuser.NewStateFactory(awsElastiCacheClientProvider),
+			azureredisuser.NewStateFactory(azureManagedRedisClientProvider),
+		),
+	).SetupWithManager(kcpManager)
+}
+
+func NewRedisUserReconciler(
+	reconciler redisuser.RedisUserReconciler,
+) *RedisUserReconciler {
+	return &RedisUserReconciler{
+		Reconciler: reconciler,
+	}
+}
+
+type RedisUserReconciler struct {
+	Reconciler redisuser.RedisUserReconciler
+}
+
+// +kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=redisusers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=redisusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=redisusers/finalizers,verbs=update
+
+func (r *RedisUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.Reconciler.Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RedisUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cloudcontrolv1beta1.RedisUser{}, builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Complete(r)
+}
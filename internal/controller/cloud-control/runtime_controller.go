@@ -7,7 +7,9 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/external/infrastructuremanagerv1"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awssecurity "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/security"
+	awssecurityclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/security/client"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 	azuresecurity "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/security"
 	azuresecurityclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/security/client"
@@ -23,13 +25,14 @@ import (
 func SetupRuntimeReconciler(
 	ctx context.Context,
 	mgr ctrl.Manager,
+	awsSecurityClientProvider awsclient.SkrClientProvider[awssecurityclient.Client],
 	azureClientProvider azureclient.ClientProvider[azuresecurityclient.Client],
 	gcpSecurityClientProvider gcpclient.GcpClientProvider[gcpsecurityclient.Client],
 ) error {
 	return NewRuntimeController(
 		kcpruntime.NewRuntimeReconciler(
 			composed.NewStateFactory(composed.NewStateClusterFromCluster(mgr)),
-			awssecurity.NewStateFactory(),
+			awssecurity.NewStateFactory(awsSecurityClientProvider),
 			azuresecurity.NewStateFactory(azureClientProvider),
 			gcpsecurity.NewStateFactory(gcpSecurityClientProvider),
 		),
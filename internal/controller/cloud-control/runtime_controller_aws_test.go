@@ -1,11 +1,16 @@
 package cloudcontrol
 
 import (
+	"fmt"
+
 	"github.com/kyma-project/cloud-manager/api"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/external/infrastructuremanagerv1"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	awssecurity "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/security"
+	kcpruntime "github.com/kyma-project/cloud-manager/pkg/kcp/runtime"
 	kcpsubscription "github.com/kyma-project/cloud-manager/pkg/kcp/subscription"
 	kcpvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/vpcnetwork"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
@@ -128,12 +133,66 @@ var _ = Describe("Feature: Runtime", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		// uncomment once implemented
-		//By("Then Runtime is annotated as security handled", func() {
-		//	Eventually(LoadAndCheck).
-		//		WithArguments(infra.Ctx(), infra.KCP().Client(), runtime, NewObjActions(), HavingAnnotation(cloudcontrolv1beta1.RuntimeSecurityStatusAnnotation, "Ready")).
-		//		Should(Succeed())
-		//})
+		By("Then Runtime is successfully reconciled", func() {
+			// Runtime is not owned by CloudManager, and it does not write its conditions
+			// so there's no way to observe the reconciliation progress other than with Tracker
+			Eventually(kcpruntime.Tracker.IsReconciledWith).
+				WithArguments(runtime.Name, composed.ReconciliationLabelSuccess).
+				Should(Succeed())
+		})
+
+		awsRegionMock := awsMock.Region(runtime.Spec.Shoot.Region)
+
+		if feature.RuntimeSecurityAws.Value(infra.Ctx()) {
+
+			By("Then Runtime is annotated as security handled", func() {
+				Eventually(LoadAndCheck).
+					WithArguments(infra.Ctx(), infra.KCP().Client(), runtime, NewObjActions(), HavingAnnotation(cloudcontrolv1beta1.RuntimeSecurityStatusAnnotation, "Ready")).
+					Should(Succeed())
+			})
+
+			By("When Runtime security is enabled", func() {
+				Expect(LoadAndCheck(infra.Ctx(), infra.KCP().Client(), runtime, NewObjActions())).
+					To(Succeed())
+
+				// reset the tracker so next reconciliation can be tracked
+				kcpruntime.Tracker.Clear(runtime.Name)
+
+				_, err := composed.PatchObjMergeLabel(infra.Ctx(), runtime, infra.KCP().Client(), common.TmpRuntimeSecurityEnabledLabel, "true")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			By("Then Runtime is successfully reconciled", func() {
+				Eventually(kcpruntime.Tracker.IsReconciledWith).
+					WithArguments(runtime.Name, composed.ReconciliationLabelSuccess).
+					Should(Succeed())
+			})
+
+			By("Then AWS VPC flow log is created", func() {
+				Eventually(func() error {
+					flowLogs := awsRegionMock.GetFlowLogs(awsVpcNetworkId)
+					if len(flowLogs) != 1 {
+						return fmt.Errorf("expected one flow log for vpc %s, but found %d", awsVpcNetworkId, len(flowLogs))
+					}
+					if ptr.Deref(flowLogs[0].LogGroupName, "") != awssecurity.FlowLogGroupName(shootName) {
+						return fmt.Errorf("expected flow log group %s, but got %s", awssecurity.FlowLogGroupName(shootName), ptr.Deref(flowLogs[0].LogGroupName, ""))
+					}
+					return nil
+				}).
+					Should(Succeed())
+			})
+
+			By("Then AWS GuardDuty detector is enabled", func() {
+				Eventually(func() error {
+					if !awsRegionMock.GetGuardDutyDetector().IsEnabled() {
+						return fmt.Errorf("guardduty detector is not enabled")
+					}
+					return nil
+				}).
+					Should(Succeed())
+			})
+
+		} // if security feature enabled for AWS
 
 		// DELETE ===============================================================
 
@@ -141,6 +200,30 @@ var _ = Describe("Feature: Runtime", func() {
 			Expect(Delete(infra.Ctx(), infra.KCP().Client(), runtime)).To(Succeed())
 		})
 
+		if feature.RuntimeSecurityAws.Value(infra.Ctx()) {
+
+			By("Then AWS VPC flow log does not exist", func() {
+				Eventually(func() error {
+					if flowLogs := awsRegionMock.GetFlowLogs(awsVpcNetworkId); len(flowLogs) > 0 {
+						return fmt.Errorf("vpc %s still has %d flow logs", awsVpcNetworkId, len(flowLogs))
+					}
+					return nil
+				}).
+					Should(Succeed())
+			})
+
+			By("Then AWS GuardDuty detector is disabled", func() {
+				Eventually(func() error {
+					if awsRegionMock.GetGuardDutyDetector().IsEnabled() {
+						return fmt.Errorf("guardduty detector is still enabled")
+					}
+					return nil
+				}).
+					Should(Succeed())
+			})
+
+		} // if security feature enabled for AWS
+
 		By("Then VpcNetwork is deleted", func() {
 			Eventually(IsDeleted).
 				WithArguments(infra.Ctx(), infra.KCP().Client(), vpcNetwork).
@@ -252,12 +335,13 @@ var _ = Describe("Feature: Runtime", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		// uncomment once implemented
-		//By("Then Runtime is annotated as security handled", func() {
-		//	Eventually(LoadAndCheck).
-		//		WithArguments(infra.Ctx(), infra.KCP().Client(), runtime, NewObjActions(), HavingAnnotation(cloudcontrolv1beta1.RuntimeSecurityStatusAnnotation, "Ready")).
-		//		Should(Succeed())
-		//})
+		if feature.RuntimeSecurityAws.Value(infra.Ctx()) {
+			By("Then Runtime is annotated as security handled", func() {
+				Eventually(LoadAndCheck).
+					WithArguments(infra.Ctx(), infra.KCP().Client(), runtime, NewObjActions(), HavingAnnotation(cloudcontrolv1beta1.RuntimeSecurityStatusAnnotation, "Ready")).
+					Should(Succeed())
+			})
+		}
 
 	})
 })
@@ -125,6 +125,7 @@ var _ = BeforeSuite(func() {
 	Expect(SetupNfsInstanceReconciler(
 		infra.KcpManager(),
 		infra.AwsMock().NfsInstanceSkrProvider(),
+		infra.AzureMock().NfsInstanceProvider(),
 		infra.GcpMock2().NfsInstanceV2Provider(),
 		infra.SapMock().NfsInstanceProvider(),
 		infra.AlicloudMock().NfsInstanceClientProvider(),
@@ -152,6 +153,7 @@ var _ = BeforeSuite(func() {
 		infra.KcpManager(),
 		infra.AwsMock().ElastiCacheProviderFake(),
 		infra.AzureMock().RedisClusterClientProvider(),
+		infra.AlicloudMock().RedisClusterClientProvider(),
 		env,
 	)).NotTo(HaveOccurred())
 	Expect(SetupGcpRedisClusterReconciler(
@@ -164,6 +166,12 @@ var _ = BeforeSuite(func() {
 		infra.KcpManager(),
 		infra.AzureMock().ManagedRedisClientProvider(),
 	)).NotTo(HaveOccurred())
+	// RedisUser
+	Expect(SetupRedisUserReconciler(
+		infra.KcpManager(),
+		infra.AwsMock().ElastiCacheProviderFake(),
+		infra.AzureMock().ManagedRedisClientProvider(),
+	)).NotTo(HaveOccurred())
 	// Network
 	Expect(SetupNetworkReconciler(
 		infra.Ctx(),
@@ -177,6 +185,8 @@ var _ = BeforeSuite(func() {
 		infra.GcpMock2().NfsBackupV2Provider(),
 		awsnukeclient.Mock(),
 		azurenukeclient.NukeProvider(infra.AzureMock().StorageProvider()),
+		infra.SapMock().NukeProvider(),
+		infra.AlicloudMock().NukeClientProvider(),
 		env,
 	)).To(Succeed())
 	// GcpSubnet
@@ -212,6 +222,7 @@ var _ = BeforeSuite(func() {
 	Expect(SetupRuntimeReconciler(
 		infra.Ctx(),
 		infra.KcpManager(),
+		infra.AwsMock().SecurityProvider(),
 		infra.AzureMock().SecurityProvider(),
 		infra.GcpMock2().SecurityProvider(),
 	)).To(Succeed())
@@ -0,0 +1,402 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Feature: SKR AlicloudNfsVolume", func() {
+
+	It("Scenario: SKR AlicloudNfsVolume is created and deleted", func() {
+
+		skrIpRangeName := "alicloud-nfs-iprange-1"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "0c1f8e2a-7d3b-4f6e-9a51-2b8c4d7e9f01"
+
+		By("Given SKR IpRange exists", func() {
+			// tell skriprange reconciler to ignore this SKR IpRange
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		alicloudNfsVolumeName := "alicloud-nfs-volume-1"
+		alicloudNfsVolume := &cloudresourcesv1beta1.AlicloudNfsVolume{}
+
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: alicloudNfsVolumeName}))
+
+		By("When AlicloudNfsVolume is created", func() {
+			Eventually(CreateAlicloudNfsVolume).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume,
+					WithName(alicloudNfsVolumeName),
+					WithIpRange(skrIpRange.Name),
+					WithAlicloudNfsVolumeCapacityGb(50),
+					WithAlicloudNfsVolumeStorageType(cloudresourcesv1beta1.AlicloudNfsStorageTypeCapacity),
+				).
+				Should(Succeed())
+		})
+
+		kcpNfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+
+		By("Then KCP NfsInstance is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudNfsVolume,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR AlicloudNfsVolume to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					NewObjActions(
+						WithName(alicloudNfsVolume.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP NfsInstance")
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpNfsInstance.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.ipRange.name equal to SKR IpRange.status.id")
+			Expect(kcpNfsInstance.Spec.IpRange.Name).To(Equal(skrIpRange.Status.Id))
+
+			By("And has spec.instance.alicloud equal to SKR AlicloudNfsVolume.spec values")
+			Expect(kcpNfsInstance.Spec.Instance.Alicloud).NotTo(BeNil())
+			Expect(kcpNfsInstance.Spec.Instance.Alicloud.StorageType).To(Equal(cloudcontrolv1beta1.AlicloudStorageTypeCapacity))
+			Expect(kcpNfsInstance.Spec.Instance.Alicloud.ProtocolType).To(Equal(cloudcontrolv1beta1.AlicloudProtocolTypeNFS))
+		})
+
+		host := "0a1b2c3d4e-abc12.ap-southeast-1.nas.aliyuncs.com"
+		path := "/"
+
+		By("When KCP NfsInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					WithNfsInstanceStatusHost(host),
+					WithNfsInstanceStatusPath(path),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AlicloudNfsVolume has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudNfsVolume,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+
+			Expect(alicloudNfsVolume.Status.Server).To(Equal(host))
+			Expect(alicloudNfsVolume.Status.Path).To(Equal(path))
+		})
+
+		pv := &corev1.PersistentVolume{}
+		By("And Then SKR PersistentVolume is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					pv,
+					NewObjActions(
+						WithName(alicloudNfsVolume.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And it points to the NAS mount target")
+			Expect(pv.Spec.NFS).NotTo(BeNil())
+			Expect(pv.Spec.NFS.Server).To(Equal(host))
+			Expect(pv.Spec.NFS.Path).To(Equal(path))
+			Expect(pv.Spec.Capacity["storage"]).To(Equal(resource.MustParse("50Gi")))
+		})
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		By("And Then SKR PersistentVolumeClaim is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					pvc,
+					NewObjActions(
+						WithName(alicloudNfsVolume.Name),
+						WithNamespace(alicloudNfsVolume.Namespace),
+					),
+				).
+				Should(Succeed())
+
+			Expect(pvc.Spec.VolumeName).To(Equal(pv.Name))
+		})
+
+		By("When KCP NfsInstance reports used capacity", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					WithNfsInstanceStatusCapacity(resource.MustParse("3Gi")),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AlicloudNfsVolume status.capacity is updated", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudNfsVolume,
+					NewObjActions(),
+					HavingFieldValue("3Gi", "status", "capacity"),
+				).
+				Should(Succeed())
+		})
+
+		By("When AlicloudNfsVolume is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume).
+				Should(Succeed())
+		})
+
+		By("Then KCP NfsInstance is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					NewObjActions(),
+					HavingDeletionTimestamp(),
+				).
+				Should(Succeed())
+		})
+
+		By("When KCP NfsInstance finalizer is removed", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed())
+		})
+
+		By("Then SKR AlicloudNfsVolume does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume).
+				Should(Succeed())
+		})
+
+		By("And Then SKR PersistentVolume does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), pv).
+				Should(Succeed())
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+			Should(Succeed())
+	})
+
+	It("Scenario: SKR AlicloudNfsVolume deletion is blocked while its PersistentVolume is bound", func() {
+
+		skrIpRangeName := "alicloud-nfs-iprange-2"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "6e2d9b4c-1a8f-4c3e-b7d5-9f0a3c6e2b14"
+
+		By("Given SKR IpRange exists", func() {
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		alicloudNfsVolume := &cloudresourcesv1beta1.AlicloudNfsVolume{}
+
+		By("And Given AlicloudNfsVolume is created", func() {
+			Eventually(CreateAlicloudNfsVolume).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume,
+					WithName("alicloud-nfs-volume-2"),
+					WithIpRange(skrIpRange.Name),
+				).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+				).
+				Should(Succeed())
+		})
+
+		kcpNfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+
+		By("And Given KCP NfsInstance is Ready", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					NewObjActions(WithName(alicloudNfsVolume.Status.Id)),
+				).
+				Should(Succeed())
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					WithNfsInstanceStatusHost("1b2c3d4e5f-def34.ap-southeast-1.nas.aliyuncs.com"),
+					WithNfsInstanceStatusPath("/"),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		pv := &corev1.PersistentVolume{}
+
+		By("And Given SKR PersistentVolume is bound", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), pv,
+					NewObjActions(WithName(alicloudNfsVolume.Status.Id)),
+				).
+				Should(Succeed())
+
+			Eventually(func() error {
+				if err := infra.SKR().Client().Get(infra.Ctx(), client.ObjectKeyFromObject(pv), pv); err != nil {
+					return err
+				}
+				pv.Status.Phase = corev1.VolumeBound
+				return infra.SKR().Client().Status().Update(infra.Ctx(), pv)
+			}).Should(Succeed())
+		})
+
+		By("When AlicloudNfsVolume is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume).
+				Should(Succeed())
+		})
+
+		By("Then AlicloudNfsVolume has Error condition for the bound PersistentVolume", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeError),
+					HavingFieldValue(cloudresourcesv1beta1.StateError, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then KCP NfsInstance is not marked for deletion", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					NewObjActions(),
+					HavingFieldSet("metadata", "name"),
+				).
+				Should(Succeed())
+			Expect(kcpNfsInstance.DeletionTimestamp.IsZero()).To(BeTrue())
+		})
+
+		By("When SKR PersistentVolume is released", func() {
+			Eventually(func() error {
+				if err := infra.SKR().Client().Get(infra.Ctx(), client.ObjectKeyFromObject(pv), pv); err != nil {
+					return err
+				}
+				pv.Status.Phase = corev1.VolumeReleased
+				return infra.SKR().Client().Status().Update(infra.Ctx(), pv)
+			}).Should(Succeed())
+		})
+
+		By("Then KCP NfsInstance is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					NewObjActions(),
+					HavingDeletionTimestamp(),
+				).
+				Should(Succeed())
+		})
+
+		By("When KCP NfsInstance finalizer is removed", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed())
+		})
+
+		By("Then SKR AlicloudNfsVolume does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudNfsVolume).
+				Should(Succeed())
+		})
+
+		By("And Then SKR PersistentVolume does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), pv).
+				Should(Succeed())
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+			Should(Succeed())
+	})
+})
@@ -0,0 +1,378 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skralicloudrediscluster "github.com/kyma-project/cloud-manager/pkg/skr/alicloudrediscluster"
+	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR AlicloudRedisCluster", func() {
+
+	It("Scenario: SKR AlicloudRedisCluster is created", func() {
+
+		alicloudRedisClusterName := "alicloud-custom-redis-cluster"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: alicloudRedisClusterName}))
+		skrIpRangeId := "1c4e7a2b-8d3f-4a6e-b5c9-2d7f0e1a3b52"
+		alicloudRedisCluster := &cloudresourcesv1beta1.AlicloudRedisCluster{}
+		engineVersion := "7.0"
+		tier := cloudresourcesv1beta1.AlicloudRedisClusterTierC4
+		shardCount := int32(2)
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+
+		skriprange.Ignore.AddName("default")
+
+		const (
+			authSecretName = "alicloud-cluster-auth-secretname"
+		)
+		authSecretLabels := map[string]string{
+			"foo": "1",
+		}
+		authSecretAnnotations := map[string]string{
+			"bar": "2",
+		}
+		extraData := map[string]string{
+			"foo":    "bar",
+			"parsed": "{{.host}}:{{.port}}",
+		}
+
+		By("Given default SKR IpRange does not exist", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				ShouldNot(Succeed())
+		})
+
+		By("When AlicloudRedisCluster is created", func() {
+			Eventually(CreateAlicloudRedisCluster).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudRedisCluster,
+					WithName(alicloudRedisClusterName),
+					WithAlicloudRedisClusterEngineVersion(engineVersion),
+					WithAlicloudRedisClusterRedisTier(tier),
+					WithAlicloudRedisClusterShardCount(shardCount),
+					WithAlicloudRedisClusterAuthSecretName(authSecretName),
+					WithAlicloudRedisClusterAuthSecretLabels(authSecretLabels),
+					WithAlicloudRedisClusterAuthSecretAnnotations(authSecretAnnotations),
+					WithAlicloudRedisClusterAuthSecretExtraData(extraData),
+				).
+				Should(Succeed())
+		})
+
+		By("Then default SKR IpRange is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				Should(Succeed())
+		})
+
+		By("When default SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisCluster := &cloudcontrolv1beta1.RedisCluster{}
+
+		By("Then KCP RedisCluster is created", func() {
+			// load SKR AlicloudRedisCluster to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisCluster,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisCluster,
+					NewObjActions(
+						WithName(alicloudRedisCluster.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And has annotaton cloud-manager.kyma-project.io/kymaName")
+			Expect(kcpRedisCluster.Annotations[cloudcontrolv1beta1.LabelKymaName]).To(Equal(skrKymaRef.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteName")
+			Expect(kcpRedisCluster.Annotations[cloudcontrolv1beta1.LabelRemoteName]).To(Equal(alicloudRedisCluster.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteNamespace")
+			Expect(kcpRedisCluster.Annotations[cloudcontrolv1beta1.LabelRemoteNamespace]).To(Equal(alicloudRedisCluster.Namespace))
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpRedisCluster.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.remoteRef matching to to SKR AlicloudRedisCluster")
+			Expect(kcpRedisCluster.Spec.RemoteRef.Namespace).To(Equal(alicloudRedisCluster.Namespace))
+			Expect(kcpRedisCluster.Spec.RemoteRef.Name).To(Equal(alicloudRedisCluster.Name))
+
+			By("And has spec.instance.alicloud equal to SKR AlicloudRedisCluster.spec values")
+			instanceClass, _ := skralicloudrediscluster.RedisTierToInstanceClassConverter(alicloudRedisCluster.Spec.RedisTier, alicloudRedisCluster.Spec.ShardCount)
+			Expect(kcpRedisCluster.Spec.Instance.Alicloud.InstanceClass).To(Equal(instanceClass))
+			Expect(kcpRedisCluster.Spec.Instance.Alicloud.ShardCount).To(Equal(shardCount))
+			Expect(kcpRedisCluster.Spec.Instance.Alicloud.ReplicasPerShard).To(Equal(alicloudRedisCluster.Spec.ReplicasPerShard))
+			Expect(kcpRedisCluster.Spec.Instance.Alicloud.EngineVersion).To(Equal(alicloudRedisCluster.Spec.EngineVersion))
+		})
+
+		kcpRedisClusterDiscoveryEndpoint := "192.168.0.1:6379"
+		kcpRedisClusterAuthString := "3f1d6f0e-7d2b-4f7a-9c8a-1b2e3d4c5f60"
+
+		By("When KCP RedisCluster has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisCluster,
+					WithRedisInstanceDiscoveryEndpoint(kcpRedisClusterDiscoveryEndpoint),
+					WithRedisInstanceAuthString(kcpRedisClusterAuthString),
+
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AlicloudRedisCluster has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Then SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(authSecretName),
+						WithNamespace(alicloudRedisCluster.Namespace),
+					),
+					HavingLabelKeys(
+						util.WellKnownK8sLabelComponent,
+						util.WellKnownK8sLabelPartOf,
+						util.WellKnownK8sLabelManagedBy,
+					),
+					HavingLabel(cloudresourcesv1beta1.LabelRedisClusterStatusId, alicloudRedisCluster.Status.Id),
+					HavingLabels(authSecretLabels),
+					HavingAnnotations(authSecretAnnotations),
+				).
+				Should(Succeed())
+			Expect(authSecret.Data).To(HaveKeyWithValue("parsed", []byte(kcpRedisClusterDiscoveryEndpoint)), "expected auth secret data to have parsed=host:port")
+			Expect(authSecret.Data).To(HaveKeyWithValue("authString", []byte(kcpRedisClusterAuthString)))
+
+			By("And it has defined cloud-manager finalizer")
+			Expect(authSecret.Finalizers).To(ContainElement(api.CommonFinalizerDeletionHook))
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudRedisCluster).
+			Should(Succeed())
+
+		By("// cleanup: delete default SKR IpRange", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: SKR AlicloudRedisCluster is deleted", func() {
+
+		alicloudRedisClusterName := "another-alicloud-redis-cluster"
+		skrIpRangeId := "9e8d7c6b-5a4f-4e3d-a2c1-b0f9e8d7c6b5"
+		alicloudRedisCluster := &cloudresourcesv1beta1.AlicloudRedisCluster{}
+		tier := cloudresourcesv1beta1.AlicloudRedisClusterTierC3
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+
+		skriprange.Ignore.AddName("default")
+
+		By("Given default SKR IpRange does not exist", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				ShouldNot(Succeed())
+		})
+
+		By("Given AlicloudRedisCluster is created", func() {
+			Eventually(CreateAlicloudRedisCluster).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudRedisCluster,
+					WithName(alicloudRedisClusterName),
+					WithAlicloudRedisClusterRedisTier(tier),
+					WithAlicloudRedisClusterShardCount(2),
+					WithAlicloudRedisClusterEngineVersion("7.0"),
+				).
+				Should(Succeed())
+		})
+
+		By("Then default SKR IpRange is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				Should(Succeed())
+		})
+
+		By("When default SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisCluster := &cloudcontrolv1beta1.RedisCluster{}
+
+		By("And Given KCP RedisCluster is created", func() {
+			// load SKR AlicloudRedisCluster to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisCluster,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR AlicloudRedisCluster to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisCluster,
+					NewObjActions(
+						WithName(alicloudRedisCluster.Status.Id),
+					),
+				).
+				Should(Succeed(), "expected KCP RedisCluster to be created, but it was not")
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisCluster, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP RedisCluster")
+		})
+
+		By("And Given KCP RedisCluster has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisCluster,
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "failed setting KCP RedisCluster Ready condition")
+		})
+
+		By("And Given SKR AlicloudRedisCluster has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed(), "expected AlicloudRedisCluster to exist and have Ready condition")
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Given SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(alicloudRedisCluster.Name),
+						WithNamespace(alicloudRedisCluster.Namespace),
+					),
+				).
+				Should(Succeed(), "failed creating auth Secret")
+		})
+
+		By("When AlicloudRedisCluster is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudRedisCluster).
+				Should(Succeed(), "failed deleting AlicloudRedisCluster")
+		})
+
+		By("Then SKR AlicloudRedisCluster has Deleting state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisCluster,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.StateDeleting),
+					HavingFieldValue(cloudresourcesv1beta1.StateDeleting, "status", "state"),
+				).
+				Should(Succeed(), "expected AlicloudRedisCluster to have Deleting state")
+		})
+
+		By("And Then SKR auth Secret is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), authSecret).
+				Should(Succeed(), "expected authSecret not to exist")
+		})
+
+		By("And Then KCP RedisCluster is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisCluster, NewObjActions(), HavingDeletionTimestamp()).
+				Should(Succeed(), "expected KCP RedisCluster to be marked for deletion")
+		})
+
+		By("When KCP RedisCluster finalizer is removed and it is deleted", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisCluster, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed removing finalizer on KCP RedisCluster")
+		})
+
+		By("Then SKR AlicloudRedisCluster is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudRedisCluster).
+				Should(Succeed(), "expected AlicloudRedisCluster not to exist")
+		})
+
+		By("// cleanup: delete default SKR IpRange", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,374 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skralicloudredisinstance "github.com/kyma-project/cloud-manager/pkg/skr/alicloudredisinstance"
+	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR AlicloudRedisInstance", func() {
+
+	It("Scenario: SKR AlicloudRedisInstance is created", func() {
+
+		alicloudRedisInstanceName := "alicloud-custom-redis-instance"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: alicloudRedisInstanceName}))
+		skrIpRangeId := "0a9c4a8e-5c1b-4d57-9b3e-5e1d2f8d7a11"
+		alicloudRedisInstance := &cloudresourcesv1beta1.AlicloudRedisInstance{}
+		engineVersion := "7.0"
+		tier := cloudresourcesv1beta1.AlicloudRedisTierP2
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+
+		skriprange.Ignore.AddName("default")
+
+		const (
+			authSecretName = "alicloud-custom-auth-secretname"
+		)
+		authSecretLabels := map[string]string{
+			"foo": "1",
+		}
+		authSecretAnnotations := map[string]string{
+			"bar": "2",
+		}
+		extraData := map[string]string{
+			"foo":    "bar",
+			"parsed": "{{.host}}:{{.port}}",
+		}
+
+		By("Given default SKR IpRange does not exist", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				ShouldNot(Succeed())
+		})
+
+		By("When AlicloudRedisInstance is created", func() {
+			Eventually(CreateAlicloudRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudRedisInstance,
+					WithName(alicloudRedisInstanceName),
+					WithAlicloudRedisInstanceEngineVersion(engineVersion),
+					WithAlicloudRedisInstanceRedisTier(tier),
+					WithAlicloudRedisInstanceAuthSecretName(authSecretName),
+					WithAlicloudRedisInstanceAuthSecretLabels(authSecretLabels),
+					WithAlicloudRedisInstanceAuthSecretAnnotations(authSecretAnnotations),
+					WithAlicloudRedisInstanceAuthSecretExtraData(extraData),
+				).
+				Should(Succeed())
+		})
+
+		By("Then default SKR IpRange is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				Should(Succeed())
+		})
+
+		By("When default SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("Then KCP RedisInstance is created", func() {
+			// load SKR AlicloudRedisInstance to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					NewObjActions(
+						WithName(alicloudRedisInstance.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And has annotaton cloud-manager.kyma-project.io/kymaName")
+			Expect(kcpRedisInstance.Annotations[cloudcontrolv1beta1.LabelKymaName]).To(Equal(skrKymaRef.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteName")
+			Expect(kcpRedisInstance.Annotations[cloudcontrolv1beta1.LabelRemoteName]).To(Equal(alicloudRedisInstance.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteNamespace")
+			Expect(kcpRedisInstance.Annotations[cloudcontrolv1beta1.LabelRemoteNamespace]).To(Equal(alicloudRedisInstance.Namespace))
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpRedisInstance.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.remoteRef matching to to SKR AlicloudRedisInstance")
+			Expect(kcpRedisInstance.Spec.RemoteRef.Namespace).To(Equal(alicloudRedisInstance.Namespace))
+			Expect(kcpRedisInstance.Spec.RemoteRef.Name).To(Equal(alicloudRedisInstance.Name))
+
+			By("And has spec.instance.alicloud equal to SKR AlicloudRedisInstance.spec values")
+			instanceClass, readOnlyCount, _ := skralicloudredisinstance.RedisTierToInstanceClassConverter(alicloudRedisInstance.Spec.RedisTier)
+			Expect(kcpRedisInstance.Spec.Instance.Alicloud.InstanceClass).To(Equal(instanceClass))
+			Expect(kcpRedisInstance.Spec.Instance.Alicloud.ReadOnlyCount).To(Equal(readOnlyCount))
+			Expect(kcpRedisInstance.Spec.Instance.Alicloud.EngineVersion).To(Equal(alicloudRedisInstance.Spec.EngineVersion))
+		})
+
+		kcpRedisInstancePrimaryEndpoint := "192.168.0.1:6379"
+		kcpRedisInstanceAuthString := "3f1d6f0e-7d2b-4f7a-9c8a-1b2e3d4c5f60"
+
+		By("When KCP RedisInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					WithRedisInstancePrimaryEndpoint(kcpRedisInstancePrimaryEndpoint),
+					WithRedisInstanceAuthString(kcpRedisInstanceAuthString),
+
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AlicloudRedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Then SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(authSecretName),
+						WithNamespace(alicloudRedisInstance.Namespace),
+					),
+					HavingLabelKeys(
+						util.WellKnownK8sLabelComponent,
+						util.WellKnownK8sLabelPartOf,
+						util.WellKnownK8sLabelManagedBy,
+					),
+					HavingLabel(cloudresourcesv1beta1.LabelRedisInstanceStatusId, alicloudRedisInstance.Status.Id),
+					HavingLabels(authSecretLabels),
+					HavingAnnotations(authSecretAnnotations),
+				).
+				Should(Succeed())
+			Expect(authSecret.Data).To(HaveKeyWithValue("parsed", []byte(kcpRedisInstancePrimaryEndpoint)), "expected auth secret data to have parsed=host:port")
+			Expect(authSecret.Data).To(HaveKeyWithValue("authString", []byte(kcpRedisInstanceAuthString)))
+
+			By("And it has defined cloud-manager finalizer")
+			Expect(authSecret.Finalizers).To(ContainElement(api.CommonFinalizerDeletionHook))
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudRedisInstance).
+			Should(Succeed())
+
+		By("// cleanup: delete default SKR IpRange", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: SKR AlicloudRedisInstance is deleted", func() {
+
+		alicloudRedisInstanceName := "another-alicloud-redis-instance"
+		skrIpRangeId := "7b2e1c3d-4f5a-4b6c-8d7e-9f0a1b2c3d4e"
+		alicloudRedisInstance := &cloudresourcesv1beta1.AlicloudRedisInstance{}
+		tier := cloudresourcesv1beta1.AlicloudRedisTierS2
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+
+		skriprange.Ignore.AddName("default")
+
+		By("Given default SKR IpRange does not exist", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				ShouldNot(Succeed())
+		})
+
+		By("Given AlicloudRedisInstance is created", func() {
+			Eventually(CreateAlicloudRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), alicloudRedisInstance,
+					WithName(alicloudRedisInstanceName),
+					WithAlicloudRedisInstanceRedisTier(tier),
+					WithAlicloudRedisInstanceEngineVersion("7.0"),
+				).
+				Should(Succeed())
+		})
+
+		By("Then default SKR IpRange is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				Should(Succeed())
+		})
+
+		By("When default SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("And Given KCP RedisInstance is created", func() {
+			// load SKR AlicloudRedisInstance to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR AlicloudRedisInstance to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					NewObjActions(
+						WithName(alicloudRedisInstance.Status.Id),
+					),
+				).
+				Should(Succeed(), "expected KCP RedisInstance to be created, but it was not")
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP RedisInstance")
+		})
+
+		By("And Given KCP RedisInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "failed setting KCP RedisInstance Ready condition")
+		})
+
+		By("And Given SKR AlicloudRedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed(), "expected AlicloudRedisInstance to exist and have Ready condition")
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Given SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(alicloudRedisInstance.Name),
+						WithNamespace(alicloudRedisInstance.Namespace),
+					),
+				).
+				Should(Succeed(), "failed creating auth Secret")
+		})
+
+		By("When AlicloudRedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudRedisInstance).
+				Should(Succeed(), "failed deleting AlicloudRedisInstance")
+		})
+
+		By("Then SKR AlicloudRedisInstance has Deleting state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					alicloudRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.StateDeleting),
+					HavingFieldValue(cloudresourcesv1beta1.StateDeleting, "status", "state"),
+				).
+				Should(Succeed(), "expected AlicloudRedisInstance to have Deleting state")
+		})
+
+		By("And Then SKR auth Secret is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), authSecret).
+				Should(Succeed(), "expected authSecret not to exist")
+		})
+
+		By("And Then KCP RedisInstance is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance, NewObjActions(), HavingDeletionTimestamp()).
+				Should(Succeed(), "expected KCP RedisInstance to be marked for deletion")
+		})
+
+		By("When KCP RedisInstance finalizer is removed and it is deleted", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed removing finalizer on KCP RedisInstance")
+		})
+
+		By("Then SKR AlicloudRedisInstance is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), alicloudRedisInstance).
+				Should(Succeed(), "expected AlicloudRedisInstance not to exist")
+		})
+
+		By("// cleanup: delete default SKR IpRange", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+		})
+	})
+})
@@ -21,8 +21,10 @@ import (
 	"os"
 	"testing"
 
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	cloudresourcescontroller "github.com/kyma-project/cloud-manager/internal/controller/cloud-resources"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
 	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
 	awsnfsvolumerestoreclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumerestore/client"
 	"github.com/kyma-project/cloud-manager/pkg/testinfra"
@@ -69,6 +71,9 @@ var _ = BeforeSuite(func() {
 	Expect(infra.Garden().GivenNamespaceExists(infra.Garden().Namespace())).
 		NotTo(HaveOccurred(), "failed creating namespace %s in Garden", infra.Garden().Namespace())
 
+	// Quota override
+	quota.SkrQuota.Override(&cloudresourcesv1beta1.AwsNfsBackupSchedule{}, infra.SKR().Scheme(), "", 1000)
+
 	// Setup controllers
 	// Test Only PV Controller
 	Expect(testinfra.SetupPvController(infra.Registry())).
@@ -0,0 +1,135 @@
+package azure
+
+import (
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR AzureRwxVolumeBackup", func() {
+
+	It("Scenario: SKR AzureRwxVolumeBackup is created and deleted", func() {
+
+		backupName := "azure-rwx-backup-1"
+		pvcName := "azure-rwx-backup-pvc-1"
+		pvName := "azure-rwx-backup-pv-1"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: backupName}))
+		scope := &cloudcontrolv1beta1.Scope{}
+		pv := &corev1.PersistentVolume{}
+		pvc := &corev1.PersistentVolumeClaim{}
+		backup := &cloudresourcesv1beta1.AzureRwxVolumeBackup{}
+
+		// the fileshare is listed as protectable by the storage mock
+		volumeHandle := fmt.Sprintf("rg-%s#sa%s#kh-file-share###%s", skrKymaRef.Name, "backup1", infra.SKR().Namespace())
+
+		By("Given KCP Scope exists", func() {
+			Eventually(GivenScopeAzureExists).
+				WithArguments(infra.Ctx(), infra, scope, WithName(skrKymaRef.Name)).
+				Should(Succeed())
+		})
+
+		By("And Given SKR PersistentVolume exists in Bound state", func() {
+			pv.Name = pvName
+			pv.Spec = corev1.PersistentVolumeSpec{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{
+						Driver:       "file.csi.azure.com",
+						VolumeHandle: volumeHandle,
+					},
+				},
+			}
+			Expect(infra.SKR().Client().Create(infra.Ctx(), pv)).To(Succeed())
+			pv.Status.Phase = corev1.VolumeBound
+			Expect(infra.SKR().Client().Status().Update(infra.Ctx(), pv)).To(Succeed())
+		})
+
+		By("And Given SKR PersistentVolumeClaim exists in Bound state", func() {
+			pvc.Name = pvcName
+			pvc.Namespace = infra.SKR().Namespace()
+			pvc.Annotations = map[string]string{
+				"volume.kubernetes.io/storage-provisioner": "file.csi.azure.com",
+			}
+			pvc.Spec = corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+				VolumeName: pvName,
+			}
+			Expect(infra.SKR().Client().Create(infra.Ctx(), pvc)).To(Succeed())
+			pvc.Status.Phase = corev1.ClaimBound
+			Expect(infra.SKR().Client().Status().Update(infra.Ctx(), pvc)).To(Succeed())
+		})
+
+		By("When AzureRwxVolumeBackup is created", func() {
+			backup.Name = backupName
+			backup.Namespace = infra.SKR().Namespace()
+			backup.Spec = cloudresourcesv1beta1.AzureRwxVolumeBackupSpec{
+				Source: cloudresourcesv1beta1.PvcSource{
+					Pvc: cloudresourcesv1beta1.PvcRef{Name: pvcName},
+				},
+				Location: scope.Spec.Region,
+			}
+			Expect(infra.SKR().Client().Create(infra.Ctx(), backup)).To(Succeed())
+		})
+
+		By("Then AzureRwxVolumeBackup is in Done state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					NewObjActions(),
+					HavingState(string(cloudresourcesv1beta1.AzureRwxBackupDone)),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then AzureRwxVolumeBackup has the recovery point in status", func() {
+			Expect(backup.Status.OpIdentifier).NotTo(BeEmpty())
+			Expect(backup.Status.StartTime).NotTo(BeNil())
+			_, _, _, _, _, recoveryPointId, err := azurerwxvolumebackupclient.ParseRecoveryPointId(backup.Status.RecoveryPointId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recoveryPointId).NotTo(BeEmpty())
+			Expect(backup.Status.Capacity.Equal(resource.MustParse("1Gi"))).To(BeTrue())
+			Expect(backup.Status.StorageAccountPath).To(Equal(
+				azurerwxvolumebackupclient.GetStorageAccountPath(scope.Spec.Scope.Azure.SubscriptionId, "rg-"+skrKymaRef.Name, "sabackup1")))
+		})
+
+		By("When AzureRwxVolumeBackup is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("Then AzureRwxVolumeBackup does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("// cleanup: delete PersistentVolumeClaim and PersistentVolume", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), pvc).
+				Should(Succeed())
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), pv).
+				Should(Succeed())
+		})
+	})
+
+})
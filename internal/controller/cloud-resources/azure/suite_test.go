@@ -23,7 +23,9 @@ import (
 
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	cloudresourcescontroller "github.com/kyma-project/cloud-manager/internal/controller/cloud-resources"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
 	"github.com/kyma-project/cloud-manager/pkg/testinfra"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -68,6 +70,9 @@ var _ = BeforeSuite(func() {
 	Expect(infra.Garden().GivenNamespaceExists(infra.Garden().Namespace())).
 		NotTo(HaveOccurred(), "failed creating namespace %s in Garden", infra.Garden().Namespace())
 
+	// Quota override
+	quota.SkrQuota.Override(&cloudresourcesv1beta1.AzureRwxBackupSchedule{}, infra.SKR().Scheme(), "", 1000)
+
 	// Setup controllers
 	// Test Only PV Controller
 	Expect(testinfra.SetupPvController(infra.Registry())).
@@ -85,8 +90,8 @@ var _ = BeforeSuite(func() {
 		infra.Registry(), infra.AzureMock().StorageProvider())).NotTo(HaveOccurred())
 
 	// AzureRwxVolumeBackup
-	// TODO: confirm if .NotTo(HaveOccurred()) is necessary
-	Expect(cloudresourcescontroller.SetupAzureRwxBackupReconciler(infra.Registry(), infra.AzureMock().StorageProvider()))
+	Expect(cloudresourcescontroller.SetupAzureRwxBackupReconciler(
+		infra.Registry(), infra.AzureMock().StorageProvider())).NotTo(HaveOccurred())
 
 	// AzureRwxPV
 	Expect(cloudresourcescontroller.SetupAzureRwxPvReconciler(
@@ -0,0 +1,258 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR AzureNfsVolume", func() {
+
+	It("Scenario: SKR AzureNfsVolume is created, resized and deleted", func() {
+
+		skrIpRangeName := "azure-nfs-iprange-1"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "5a4d3ff0-1c4e-4c1b-9a36-0f5a2d6f3b11"
+
+		By("Given SKR IpRange exists", func() {
+			// tell skriprange reconciler to ignore this SKR IpRange
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		azureNfsVolumeName := "azure-nfs-volume-1"
+		azureNfsVolume := &cloudresourcesv1beta1.AzureNfsVolume{}
+
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: azureNfsVolumeName}))
+
+		By("When AzureNfsVolume is created", func() {
+			Eventually(CreateAzureNfsVolume).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), azureNfsVolume,
+					WithName(azureNfsVolumeName),
+					WithIpRange(skrIpRange.Name),
+					WithAzureNfsVolumeCapacityGb(100),
+					WithAzureNfsVolumeTier(cloudresourcesv1beta1.AzureNfsTierPremiumZRS),
+				).
+				Should(Succeed())
+		})
+
+		kcpNfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+
+		By("Then KCP NfsInstance is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					azureNfsVolume,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR AzureNfsVolume to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					NewObjActions(
+						WithName(azureNfsVolume.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP NfsInstance")
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpNfsInstance.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.ipRange.name equal to SKR IpRange.status.id")
+			Expect(kcpNfsInstance.Spec.IpRange.Name).To(Equal(skrIpRange.Status.Id))
+
+			By("And has spec.instance.azure equal to SKR AzureNfsVolume.spec values")
+			Expect(kcpNfsInstance.Spec.Instance.Azure).NotTo(BeNil())
+			Expect(kcpNfsInstance.Spec.Instance.Azure.CapacityGb).To(Equal(100))
+			Expect(kcpNfsInstance.Spec.Instance.Azure.Sku).To(Equal(cloudcontrolv1beta1.AzureNfsSkuPremiumZRS))
+		})
+
+		host := "cmazurenfsvolume.file.core.windows.net"
+		path := "/cmazurenfsvolume/vol1"
+
+		By("When KCP NfsInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					WithNfsInstanceStatusHost(host),
+					WithNfsInstanceStatusPath(path),
+					WithNfsInstanceStatusCapacity(resource.MustParse("100Gi")),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AzureNfsVolume has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					azureNfsVolume,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+
+			Expect(azureNfsVolume.Status.Server).To(Equal(host))
+			Expect(azureNfsVolume.Status.Path).To(Equal(path))
+		})
+
+		pv := &corev1.PersistentVolume{}
+		By("And Then SKR PersistentVolume is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					pv,
+					NewObjActions(
+						WithName(azureNfsVolume.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And it points to the file share")
+			Expect(pv.Spec.NFS).NotTo(BeNil())
+			Expect(pv.Spec.NFS.Server).To(Equal(host))
+			Expect(pv.Spec.NFS.Path).To(Equal(path))
+			Expect(pv.Spec.Capacity["storage"]).To(Equal(resource.MustParse("100Gi")))
+		})
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		By("And Then SKR PersistentVolumeClaim is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					pvc,
+					NewObjActions(
+						WithName(azureNfsVolume.Name),
+						WithNamespace(azureNfsVolume.Namespace),
+					),
+				).
+				Should(Succeed())
+
+			Expect(pvc.Spec.VolumeName).To(Equal(pv.Name))
+		})
+
+		By("When AzureNfsVolume capacity is increased", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), azureNfsVolume, WithAzureNfsVolumeCapacityGb(200)).
+				Should(Succeed())
+		})
+
+		By("Then KCP NfsInstance capacity is updated", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					NewObjActions(),
+					HavingFieldValue(200, "spec", "instance", "azure", "capacityGb"),
+				).
+				Should(Succeed())
+		})
+
+		By("When KCP NfsInstance is resized", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					WithNfsInstanceStatusCapacity(resource.MustParse("200Gi")),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR PersistentVolume capacity is updated", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					pv,
+					NewObjActions(),
+					HavingFieldValue("200Gi", "spec", "capacity", "storage"),
+				).
+				Should(Succeed())
+		})
+
+		By("When AzureNfsVolume is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), azureNfsVolume).
+				Should(Succeed())
+		})
+
+		By("Then KCP NfsInstance is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					NewObjActions(),
+					HavingDeletionTimestamp(),
+				).
+				Should(Succeed())
+		})
+
+		By("When KCP NfsInstance finalizer is removed", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed())
+		})
+
+		By("Then SKR AzureNfsVolume does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), azureNfsVolume).
+				Should(Succeed())
+		})
+
+		By("And Then SKR PersistentVolume does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), pv).
+				Should(Succeed())
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+			Should(Succeed())
+	})
+})
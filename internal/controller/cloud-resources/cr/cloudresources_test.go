@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-project/cloud-manager/api"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	. "github.com/onsi/ginkgo/v2"
@@ -96,4 +97,75 @@ var _ = Describe("Feature: CloudResources module CR", func() {
 		})
 	})
 
+	It("Scenario: CloudResources publishes quota usage", func() {
+
+		const (
+			crName      = "5b0c2ed4-3f3e-4a4e-9d0d-8f6f0c8b7f55"
+			ipRangeName = "3a1f0b57-1c52-4a0b-8a39-5d0f8e1c9d21"
+		)
+		cr := &cloudresourcesv1beta1.CloudResources{}
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+
+		By("Given SKR IpRange exists", func() {
+			Eventually(CreateSkrIpRange).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(ipRangeName),
+					WithSkrIpRangeSpecCidr("10.240.0.0/24"),
+				).
+				Should(Succeed(), "failed creating SKR IpRange")
+		})
+
+		By("When CloudResources is created", func() {
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), cr,
+					WithName(crName),
+				).
+				Should(Succeed(), "failed creating CloudResources CR")
+		})
+
+		By("Then CloudResources has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), cr, NewObjActions(), HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady)).
+				Should(Succeed(), "expected CloudResources to have Ready condition, but it does not")
+		})
+
+		By("And Then CloudResources has IpRange quota usage in status", func() {
+			Expect(cr.Status.Quotas).To(ContainElement(cloudresourcesv1beta1.QuotaUsage{
+				Kind:  "IpRange",
+				Name:  quota.QuotaTotalCount,
+				Limit: 1,
+				Used:  1,
+			}))
+		})
+
+		By("And Then CloudResources has AwsNfsVolume quota usage in status", func() {
+			Expect(cr.Status.Quotas).To(ContainElement(cloudresourcesv1beta1.QuotaUsage{
+				Kind:  "AwsNfsVolume",
+				Name:  quota.QuotaTotalCount,
+				Limit: 5,
+				Used:  0,
+			}))
+		})
+
+		// CLEANUP ========================
+
+		By("When SKR IpRange is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed(), "failed deleting SKR IpRange")
+		})
+
+		By("And When CloudResources is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), cr).
+				Should(Succeed(), "failed deleting CloudResources")
+		})
+
+		By("Then CloudResources should not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), cr).
+				Should(Succeed(), "expected CloudResources not to exist, but it still exists")
+		})
+	})
+
 })
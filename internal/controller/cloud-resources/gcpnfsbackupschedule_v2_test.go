@@ -308,6 +308,121 @@ var _ = Describe("Feature: SKR GcpNfsBackupSchedule V2", func() {
 			Should(Succeed())
 	})
 
+	It("Scenario: Schedule with cross-region Copies", func() {
+		const (
+			skrNfsVolumeName = "gcp-nfs-v2-bs-copy-1"
+			skrIpRangeName   = "gcp-iprange-v2-bs-copy-1"
+			scheduleName     = "gcp-nfs-bs-v2-copies-1"
+			copyLocation     = "us-east1"
+		)
+		schedule := &cloudresourcesv1beta1.GcpNfsBackupSchedule{}
+		skrNfsVolume := &cloudresourcesv1beta1.GcpNfsVolume{}
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: scheduleName}))
+
+		By("Given KCP Scope exists", func() {
+			Expect(infra.GivenScopeGcpExists(skrKymaRef.Name)).NotTo(HaveOccurred())
+			Eventually(func() (bool, error) {
+				err := infra.KCP().Client().Get(infra.Ctx(), infra.KCP().ObjKey(skrKymaRef.Name), scope)
+				return err == nil, client.IgnoreNotFound(err)
+			}).Should(BeTrue(), "expected Scope to get created")
+		})
+
+		By("And Given SKR GcpNfsVolume in Ready state", func() {
+			skrgcpnfsvol.Ignore.AddName(skrNfsVolumeName)
+			Eventually(CreateGcpNfsVolume).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrNfsVolume,
+					WithName(skrNfsVolumeName),
+					WithGcpNfsVolumeIpRange(skrIpRangeName),
+				).Should(Succeed())
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrNfsVolume,
+					WithConditions(SkrReadyCondition()),
+				).Should(Succeed())
+		})
+
+		By("When GcpNfsBackupSchedule is created with a copy location", func() {
+			Eventually(CreateBackupSchedule).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), schedule,
+					WithName(scheduleName),
+					WithSchedule("* * * * *"),
+					WithGcpLocation("us-west1"),
+					WithNfsVolumeRef(skrNfsVolumeName),
+					WithRetentionDays(0),
+					WithGcpNfsBackupScheduleCopies(cloudresourcesv1beta1.GcpNfsBackupScheduleCopy{
+						Location:         copyLocation,
+						MaxRetentionDays: 30,
+					}),
+				).Should(Succeed())
+		})
+
+		By("Then GcpNfsBackupSchedule becomes Active", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), schedule,
+					NewObjActions(),
+					HavingFieldValue(cloudresourcesv1beta1.JobStateActive, "status", "state"),
+				).Should(Succeed())
+			Expect(len(schedule.Status.NextRunTimes)).To(BeNumerically(">", 0))
+		})
+
+		var nfsBackupName, nfsBackupCopyName string
+		By("And When fake clock advances past the first scheduled run", func() {
+			expected, err := time.Parse(time.RFC3339, schedule.Status.NextRunTimes[0])
+			Expect(err).NotTo(HaveOccurred())
+			nfsBackupName = fmt.Sprintf("%s-%d-%s", scheduleName, 1, expected.Format("20060102-150405"))
+			nfsBackupCopyName = fmt.Sprintf("%s-%s", nfsBackupName, copyLocation)
+			skrgcpnfsvolbackupv2.Ignore.AddName(nfsBackupName)
+			skrgcpnfsvolbackupv2.Ignore.AddName(nfsBackupCopyName)
+			testFakeClock.Step(2 * time.Minute)
+		})
+
+		nfsBackup := &cloudresourcesv1beta1.GcpNfsVolumeBackup{}
+		By("Then a GcpNfsVolumeBackup is created in the schedule location", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), nfsBackup,
+					NewObjActions(WithName(nfsBackupName)),
+				).Should(Succeed())
+			Expect(nfsBackup.Spec.Location).To(Equal("us-west1"))
+			Expect(nfsBackup.Labels).NotTo(HaveKey(cloudresourcesv1beta1.LabelScheduleCopyLocation))
+		})
+
+		nfsBackupCopy := &cloudresourcesv1beta1.GcpNfsVolumeBackup{}
+		By("And Then a GcpNfsVolumeBackup copy is created in the copy location", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), nfsBackupCopy,
+					NewObjActions(WithName(nfsBackupCopyName)),
+				).Should(Succeed())
+			Expect(nfsBackupCopy.Spec.Location).To(Equal(copyLocation))
+			Expect(nfsBackupCopy.Spec.Source.Volume.Name).To(Equal(skrNfsVolumeName))
+			Expect(nfsBackupCopy.Labels[cloudresourcesv1beta1.LabelScheduleName]).To(Equal(scheduleName))
+			Expect(nfsBackupCopy.Labels[cloudresourcesv1beta1.LabelScheduleCopyLocation]).To(Equal(copyLocation))
+		})
+
+		By("And Then schedule status references the copy", func() {
+			Eventually(func() (int, error) {
+				if err := LoadAndCheck(infra.Ctx(), infra.SKR().Client(), schedule, NewObjActions()); err != nil {
+					return 0, err
+				}
+				return schedule.Status.BackupIndex, nil
+			}).Should(Equal(1))
+			Expect(schedule.Status.LastCreatedBackup.Name).To(Equal(nfsBackupName))
+			Expect(schedule.Status.LastCreatedCopies).To(HaveLen(1))
+			Expect(schedule.Status.LastCreatedCopies[0].Name).To(Equal(nfsBackupCopyName))
+		})
+
+		// Cleanup
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), schedule).
+			Should(Succeed())
+	})
+
 	It("Scenario: Suspension", func() {
 		const (
 			skrNfsVolumeName = "gcp-nfs-v2-bs-suspend-1"
@@ -81,6 +81,26 @@ func SetupIpRangeReconciler(reg skrruntime.SkrRegistry) error {
 		}
 		return []string{nfsVol.Spec.IpRange.Name}
 	})
+	reg.IndexField(&cloudresourcesv1beta1.AzureNfsVolume{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		nfsVol, ok := object.(*cloudresourcesv1beta1.AzureNfsVolume)
+		if !ok {
+			return []string{}
+		}
+		if nfsVol.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{nfsVol.Spec.IpRange.Name}
+	})
+	reg.IndexField(&cloudresourcesv1beta1.AlicloudNfsVolume{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		nfsVol, ok := object.(*cloudresourcesv1beta1.AlicloudNfsVolume)
+		if !ok {
+			return []string{}
+		}
+		if nfsVol.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{nfsVol.Spec.IpRange.Name}
+	})
 	reg.IndexField(&cloudresourcesv1beta1.GcpRedisInstance{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
 		redisInstance, ok := object.(*cloudresourcesv1beta1.GcpRedisInstance)
 		if !ok {
@@ -128,6 +148,26 @@ func SetupIpRangeReconciler(reg skrruntime.SkrRegistry) error {
 		}
 		return []string{redisCluster.Spec.IpRange.Name}
 	})
+	reg.IndexField(&cloudresourcesv1beta1.AlicloudRedisInstance{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		redisInstance, ok := object.(*cloudresourcesv1beta1.AlicloudRedisInstance)
+		if !ok {
+			return []string{}
+		}
+		if redisInstance.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{redisInstance.Spec.IpRange.Name}
+	})
+	reg.IndexField(&cloudresourcesv1beta1.AlicloudRedisCluster{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		redisCluster, ok := object.(*cloudresourcesv1beta1.AlicloudRedisCluster)
+		if !ok {
+			return []string{}
+		}
+		if redisCluster.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{redisCluster.Spec.IpRange.Name}
+	})
 
 	return reg.Register().
 		WithFactory(&IpRangeReconcilerFactory{}).
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresources
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/skr/redisuser"
+	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
+	skrreconciler "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
+)
+
+type RedisUserReconcilerFactory struct{}
+
+func (f *RedisUserReconcilerFactory) New(args skrreconciler.ReconcilerArguments) reconcile.Reconciler {
+	return &RedisUserReconciler{
+		reconciler: redisuser.NewReconcilerFactory().New(args),
+	}
+}
+
+// RedisUserReconciler reconciles a RedisUser object
+type RedisUserReconciler struct {
+	reconciler reconcile.Reconciler
+}
+
+// +kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=redisusers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=redisusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=redisusers/finalizers,verbs=update
+
+func (r *RedisUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconciler.Reconcile(ctx, req)
+}
+
+func SetupRedisUserReconciler(reg skrruntime.SkrRegistry) error {
+	return reg.Register().
+		WithFactory(&RedisUserReconcilerFactory{}).
+		For(&cloudresourcesv1beta1.RedisUser{}).
+		Complete()
+}
@@ -0,0 +1,220 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skrawsredisinstance "github.com/kyma-project/cloud-manager/pkg/skr/awsredisinstance"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR RedisUser", func() {
+
+	It("Scenario: SKR RedisUser is created and deleted", func() {
+
+		awsRedisInstanceName := "4e8b1c7d-2a9f-4d3e-b6c5-8f1a0d2e7b39"
+		awsRedisInstanceId := "b7d2e9a4-1c6f-4e8b-9a3d-5f0c2b7e4d61"
+		awsRedisInstance := &cloudresourcesv1beta1.AwsRedisInstance{}
+
+		By("Given SKR AwsRedisInstance exists in Ready state", func() {
+			skrawsredisinstance.Ignore.AddName(awsRedisInstanceName)
+
+			Eventually(CreateAwsRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsRedisInstance,
+					WithName(awsRedisInstanceName),
+					WithAwsRedisInstanceDefautSpecs(),
+				).
+				Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsRedisInstance,
+					WithAwsRedisInstanceStatusId(awsRedisInstanceId),
+					WithState(cloudresourcesv1beta1.StateReady),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		redisUserName := "app1"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: redisUserName}))
+		redisUser := &cloudresourcesv1beta1.RedisUser{}
+		authSecretName := "c2a7e4b9-3d1f-4c8a-9e6b-0f5d2a8c1e73"
+
+		By("When SKR RedisUser is created", func() {
+			Eventually(CreateSkrRedisUser).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), redisUser,
+					WithName(redisUserName),
+					WithSkrRedisUserRedisRef(cloudresourcesv1beta1.RedisUserRedisKindAwsRedisInstance, awsRedisInstanceName),
+					WithSkrRedisUserAcl([]string{"app1:*"}, []string{"+@read"}),
+					WithSkrRedisUserAuthSecretName(authSecretName),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisUser := &cloudcontrolv1beta1.RedisUser{}
+
+		By("Then KCP RedisUser is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), redisUser,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR RedisUser to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisUser,
+					NewObjActions(
+						WithName(redisUser.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And has annotation cloud-manager.kyma-project.io/kymaName")
+			Expect(kcpRedisUser.Annotations[cloudcontrolv1beta1.LabelKymaName]).To(Equal(skrKymaRef.Name))
+
+			By("And has annotation cloud-manager.kyma-project.io/remoteName")
+			Expect(kcpRedisUser.Annotations[cloudcontrolv1beta1.LabelRemoteName]).To(Equal(redisUser.Name))
+
+			By("And has annotation cloud-manager.kyma-project.io/remoteNamespace")
+			Expect(kcpRedisUser.Annotations[cloudcontrolv1beta1.LabelRemoteNamespace]).To(Equal(redisUser.Namespace))
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpRedisUser.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.redis referring to KCP RedisInstance of the SKR AwsRedisInstance")
+			Expect(kcpRedisUser.Spec.Redis.Kind).To(Equal(cloudcontrolv1beta1.RedisUserTargetKindRedisInstance))
+			Expect(kcpRedisUser.Spec.Redis.Name).To(Equal(awsRedisInstanceId))
+
+			By("And has spec.userName, spec.keyPatterns and spec.commands equal to SKR RedisUser")
+			Expect(kcpRedisUser.Spec.UserName).To(Equal(redisUserName))
+			Expect(kcpRedisUser.Spec.KeyPatterns).To(Equal([]string{"app1:*"}))
+			Expect(kcpRedisUser.Spec.Commands).To(Equal([]string{"+@read"}))
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisUser, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP RedisUser")
+		})
+
+		kcpRedisUserEndpoint := "192.168.0.1:6379"
+		kcpRedisUserAuthString := "5f9e2c7a-4b1d-4a8e-b3c6-9d0f1e2a7b48"
+
+		By("When KCP RedisUser has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisUser,
+					WithKcpRedisUserStatusEndpoint(kcpRedisUserEndpoint),
+					WithKcpRedisUserStatusAuthString(kcpRedisUserAuthString),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR RedisUser has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), redisUser,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		authSecret := &corev1.Secret{}
+
+		By("And Then SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), authSecret,
+					NewObjActions(
+						WithName(authSecretName),
+						WithNamespace(redisUser.Namespace),
+					),
+					HavingLabel(cloudresourcesv1beta1.LabelRedisUserStatusId, redisUser.Status.Id),
+				).
+				Should(Succeed())
+
+			By("And it has username, endpoint and authString")
+			Expect(authSecret.Data).To(HaveKeyWithValue("username", []byte(redisUserName)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("endpoint", []byte(kcpRedisUserEndpoint)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("host", []byte("192.168.0.1")))
+			Expect(authSecret.Data).To(HaveKeyWithValue("port", []byte("6379")))
+			Expect(authSecret.Data).To(HaveKeyWithValue("authString", []byte(kcpRedisUserAuthString)))
+
+			By("And it has defined cloud-manager finalizer")
+			Expect(authSecret.Finalizers).To(ContainElement(api.CommonFinalizerDeletionHook))
+		})
+
+		By("When SKR RedisUser commands are modified", func() {
+			Eventually(Update).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), redisUser,
+					WithSkrRedisUserAcl([]string{"app1:*"}, []string{"+@read", "+@write"}),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP RedisUser commands are modified", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisUser,
+					NewObjActions(),
+					HavingFieldValue([]interface{}{"+@read", "+@write"}, "spec", "commands"),
+				).
+				Should(Succeed())
+		})
+
+		// DELETE
+
+		By("When SKR RedisUser is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), redisUser).
+				Should(Succeed())
+		})
+
+		By("Then SKR auth Secret does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), authSecret).
+				Should(Succeed())
+		})
+
+		By("And Then KCP RedisUser is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisUser,
+					NewObjActions(),
+					HavingDeletionTimestamp(),
+				).
+				Should(Succeed())
+		})
+
+		By("When KCP RedisUser finalizer is removed", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisUser, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed())
+		})
+
+		By("Then SKR RedisUser does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), redisUser).
+				Should(Succeed())
+		})
+
+		By("// cleanup: delete SKR AwsRedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), awsRedisInstance).
+				Should(Succeed())
+		})
+	})
+})
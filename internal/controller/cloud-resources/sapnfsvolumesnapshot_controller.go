@@ -22,6 +22,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/backuphooks"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	reconcile2 "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
 	"github.com/kyma-project/cloud-manager/pkg/skr/sapnfsvolumesnapshot"
@@ -45,6 +46,7 @@ func (f *SapNfsVolumeSnapshotReconcilerFactory) New(args reconcile2.ReconcilerAr
 		skrCluster,
 		f.snapshotClientProvider,
 		f.clock,
+		backuphooks.NewExecutor(args.SkrCluster.GetConfig()),
 	)
 	return &SapNfsVolumeSnapshotReconciler{reconciler: r}
 }
@@ -35,6 +35,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/testinfra"
 	clocktesting "k8s.io/utils/clock/testing"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	//+kubebuilder:scaffold:imports
@@ -80,7 +81,31 @@ var _ = BeforeSuite(func() {
 		NotTo(HaveOccurred(), "failed creating namespace %s in Garden", infra.Garden().Namespace())
 
 	// Quota override
-	quota.SkrQuota.Override(&cloudresourcesv1beta1.IpRange{}, infra.SKR().Scheme(), "", 1000)
+	for _, obj := range []client.Object{
+		&cloudresourcesv1beta1.IpRange{},
+		&cloudresourcesv1beta1.AwsNfsVolume{},
+		&cloudresourcesv1beta1.GcpNfsVolume{},
+		&cloudresourcesv1beta1.SapNfsVolume{},
+		&cloudresourcesv1beta1.AwsRedisInstance{},
+		&cloudresourcesv1beta1.AwsRedisCluster{},
+		&cloudresourcesv1beta1.AzureRedisInstance{},
+		&cloudresourcesv1beta1.AzureRedisCluster{},
+		&cloudresourcesv1beta1.AzureManagedRedis{},
+		&cloudresourcesv1beta1.AlicloudRedisInstance{},
+		&cloudresourcesv1beta1.AlicloudRedisCluster{},
+		&cloudresourcesv1beta1.GcpRedisInstance{},
+		&cloudresourcesv1beta1.GcpRedisCluster{},
+		&cloudresourcesv1beta1.RedisUser{},
+		&cloudresourcesv1beta1.AwsVpcPeering{},
+		&cloudresourcesv1beta1.AzureVpcPeering{},
+		&cloudresourcesv1beta1.GcpVpcPeering{},
+		&cloudresourcesv1beta1.AwsNfsBackupSchedule{},
+		&cloudresourcesv1beta1.AzureRwxBackupSchedule{},
+		&cloudresourcesv1beta1.GcpNfsBackupSchedule{},
+		&cloudresourcesv1beta1.SapNfsVolumeSnapshotSchedule{},
+	} {
+		quota.SkrQuota.Override(obj, infra.SKR().Scheme(), "", 1000)
+	}
 
 	// Setup environment variables
 	env := abstractions.NewMockedEnvironment(map[string]string{})
@@ -128,6 +153,9 @@ var _ = BeforeSuite(func() {
 	// AwsRedisCluster
 	Expect(SetupAwsRedisClusterReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
+	// AzureNfsVolume
+	Expect(SetupAzureNfsVolumeReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
 	// AzureRedisInstance
 	Expect(SetupAzureRedisInstanceReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
@@ -137,6 +165,18 @@ var _ = BeforeSuite(func() {
 	// AzureManagedRedis
 	Expect(SetupAzureManagedRedisReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
+	// RedisUser
+	Expect(SetupRedisUserReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// AlicloudNfsVolume
+	Expect(SetupAlicloudNfsVolumeReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// AlicloudRedisInstance
+	Expect(SetupAlicloudRedisInstanceReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// AlicloudRedisCluster
+	Expect(SetupAlicloudRedisClusterReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
 	// NfsBackupSchedule
 	Expect(SetupGcpNfsBackupScheduleReconciler(infra.Registry(), env, testFakeClock)).NotTo(HaveOccurred())
 
@@ -13,6 +13,7 @@ import (
 	vpcpeeringconfig "github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering/config"
 	"github.com/kyma-project/cloud-manager/pkg/quota"
 	skrruntimeconfig "github.com/kyma-project/cloud-manager/pkg/skr/runtime/config"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 )
 
 func CreateNewConfigAndLoad() config.Config {
@@ -40,6 +41,7 @@ func LoadConfigInstance(cfg config.Config) {
 	gcpconfig.InitConfig(cfg)
 	vpcpeeringconfig.InitConfig(cfg)
 	vpcnetworkconfig.InitConfig(cfg)
+	tracing.InitConfig(cfg)
 
 	cfg.Read()
 }
@@ -3,6 +3,7 @@ package leases
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kyma-project/cloud-manager/api"
@@ -12,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
@@ -59,9 +61,7 @@ func Acquire(ctx context.Context, cluster composed.StateCluster, leaseName, leas
 		}
 		return RenewedLease, nil
 	}
-	leaseDurationSeconds := ptr.Deref(lease.Spec.LeaseDurationSeconds, leaseDurationSec)
-	if lease.Spec.RenewTime == nil || lease.Spec.RenewTime.Time.Add(time.Second*time.Duration(leaseDurationSeconds)).Before(time.Now()) {
-		//lease expired
+	if isExpired(lease, leaseDurationSec, time.Now()) {
 		lease.Spec.RenewTime = &metav1.MicroTime{Time: time.Now()}
 		lease.Spec.HolderIdentity = &holderName
 		err := cluster.K8sClient().Update(ctx, lease)
@@ -100,3 +100,38 @@ func Release(ctx context.Context, cluster composed.StateCluster, leaseName, leas
 	}
 	return nil
 }
+
+type Holder struct {
+	LeaseName      string
+	HolderIdentity string
+	Expired        bool
+}
+
+// ListHolders returns the holders of all leases in leaseNamespace whose name starts with
+// namePrefix. Leases without LeaseDurationSeconds are evaluated with leaseDurationSec.
+func ListHolders(ctx context.Context, cluster composed.StateCluster, leaseNamespace, namePrefix string, leaseDurationSec int32) ([]Holder, error) {
+	list := &coordinationv1.LeaseList{}
+	err := cluster.ApiReader().List(ctx, list, client.InNamespace(leaseNamespace))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var result []Holder
+	for i := range list.Items {
+		lease := &list.Items[i]
+		if !strings.HasPrefix(lease.Name, namePrefix) {
+			continue
+		}
+		result = append(result, Holder{
+			LeaseName:      lease.Name,
+			HolderIdentity: ptr.Deref(lease.Spec.HolderIdentity, ""),
+			Expired:        isExpired(lease, leaseDurationSec, now),
+		})
+	}
+	return result, nil
+}
+
+func isExpired(lease *coordinationv1.Lease, leaseDurationSec int32, now time.Time) bool {
+	leaseDurationSeconds := ptr.Deref(lease.Spec.LeaseDurationSeconds, leaseDurationSec)
+	return lease.Spec.RenewTime == nil || lease.Spec.RenewTime.Time.Add(time.Second*time.Duration(leaseDurationSeconds)).Before(now)
+}
@@ -3,6 +3,7 @@ package leases
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -98,6 +100,33 @@ func (s *leaseSuite) TestAcquireAndRelease() {
 	s.NoError(err)
 }
 
+func (s *leaseSuite) TestListHolders() {
+	fakeClient := fake.NewClientBuilder().Build()
+	client := composed.NewStateCluster(fakeClient, fakeClient, nil, commonscheme.SkrScheme)
+	leaseNamespace := "test-namespace"
+
+	_, err := Acquire(s.ctx, client, "member-a", leaseNamespace, "a", 600)
+	s.NoError(err)
+	_, err = Acquire(s.ctx, client, "member-b", leaseNamespace, "b", 600)
+	s.NoError(err)
+	_, err = Acquire(s.ctx, client, "other", leaseNamespace, "c", 600)
+	s.NoError(err)
+
+	// expire member-b
+	lease := &coordinationv1.Lease{}
+	err = fakeClient.Get(s.ctx, types.NamespacedName{Name: "member-b", Namespace: leaseNamespace}, lease)
+	s.NoError(err)
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: time.Now().Add(-time.Hour)}
+	s.NoError(fakeClient.Update(s.ctx, lease))
+
+	holders, err := ListHolders(s.ctx, client, leaseNamespace, "member-", 600)
+	s.NoError(err)
+	s.ElementsMatch([]Holder{
+		{LeaseName: "member-a", HolderIdentity: "a", Expired: false},
+		{LeaseName: "member-b", HolderIdentity: "b", Expired: true},
+	}, holders)
+}
+
 func TestLeaseSuite(t *testing.T) {
 	suite.Run(t, new(leaseSuite))
 }
@@ -3,6 +3,10 @@ package composed
 import (
 	"context"
 	"errors"
+
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Action func(ctx context.Context, state State) (error, context.Context)
@@ -17,37 +21,80 @@ func ComposeActionsNoName(actions ...Action) Action {
 	return ComposeActions("", actions...)
 }
 
-func ComposeActions(_ string, actions ...Action) Action {
+// ComposeActions runs the actions in sequence until one of them returns an error. If the
+// name is not empty, a tracing span with that name covers the run of the actions.
+func ComposeActions(name string, actions ...Action) Action {
 	return func(ctx context.Context, state State) (error, context.Context) {
-		var lastError error
-		currentCtx := ctx
-	loop:
-		for _, a := range actions {
-			select {
-			case <-currentCtx.Done():
-				lastError = currentCtx.Err()
-				break loop
-			default:
-				err, nextCtx := a(currentCtx, state)
-				lastError = err
-				if nextCtx != nil {
-					currentCtx = nextCtx
-				}
-				if err != nil {
-					break loop
-				}
-			}
+		if name != "" {
+			return tracedComposeActions(ctx, state, name, actions)
 		}
+		return composeActions(ctx, state, name, actions)
+	}
+}
 
-		if lastError == nil {
-			return nil, currentCtx
-		} else if fce, ok := errors.AsType[FlowControlError](lastError); ok {
-			if !fce.ShouldReturnError() {
-				lastError = nil
+func tracedComposeActions(ctx context.Context, state State, name string, actions []Action) (error, context.Context) {
+	parent := trace.SpanFromContext(ctx)
+	spanCtx, span := tracing.Tracer().Start(ctx, name)
+	err, currentCtx := composeActions(spanCtx, state, name, actions)
+	if err != nil && !IsFlowControl(err) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	// the actions following this one are siblings, not children of the ended span
+	return err, trace.ContextWithSpan(currentCtx, parent)
+}
+
+func composeActions(ctx context.Context, state State, name string, actions []Action) (error, context.Context) {
+	ctx = withReconcileErrorCause(ctx)
+
+	var recorder *actionRecorder
+	if IsActionRecordingEnabled() {
+		ctx, recorder = withActionRecorder(ctx)
+		if name != "" {
+			recorder.nameRunningStep(name)
+		}
+		recorder.enter()
+		defer recorder.exit()
+	}
+
+	var lastError error
+	currentCtx := ctx
+loop:
+	for _, a := range actions {
+		select {
+		case <-currentCtx.Done():
+			lastError = currentCtx.Err()
+			break loop
+		default:
+			var err error
+			var nextCtx context.Context
+			if recorder != nil {
+				err, nextCtx = recorder.run(currentCtx, state, a)
+			} else {
+				err, nextCtx = a(currentCtx, state)
+			}
+			lastError = err
+			if nextCtx != nil {
+				currentCtx = nextCtx
+			}
+			if err != nil {
+				break loop
 			}
-			return lastError, currentCtx
 		}
+	}
 
+	if lastError == nil {
+		return nil, currentCtx
+	} else if fce, ok := errors.AsType[FlowControlError](lastError); ok {
+		if state != nil {
+			setReconcileErrorCauseFromObj(ctx, state.Obj())
+		}
+		if !fce.ShouldReturnError() {
+			lastError = nil
+		}
 		return lastError, currentCtx
 	}
+
+	return lastError, currentCtx
 }
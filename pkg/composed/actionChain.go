@@ -0,0 +1,273 @@
+package composed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/kyma-project/cloud-manager/pkg/util/debugged"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Action chain recording keeps the list of actions executed by ComposeActions during one
+// reconciliation, with their duration and the error they returned, so it can be seen which
+// action stopped the flow. It is enabled in the debugged build, since resolving the action
+// names and keeping the history is not free.
+
+const (
+	actionChainHistoryObjects = 1024
+	actionChainHistoryDepth   = 10
+)
+
+var actionRecordingEnabled atomic.Bool
+
+func init() {
+	actionRecordingEnabled.Store(debugged.Debugged)
+}
+
+// SetActionRecording enables or disables action chain recording, regardless of the build tags.
+func SetActionRecording(enabled bool) {
+	actionRecordingEnabled.Store(enabled)
+}
+
+func IsActionRecordingEnabled() bool {
+	return actionRecordingEnabled.Load()
+}
+
+// ActionStep is one action executed in the reconciliation.
+type ActionStep struct {
+	Name     string        `json:"name"`
+	Depth    int           `json:"depth"`
+	Duration time.Duration `json:"duration"`
+	Result   string        `json:"result,omitempty"`
+
+	done bool
+}
+
+func (s ActionStep) String() string {
+	res := s.Result
+	if res == "" {
+		res = "ok"
+	}
+	return fmt.Sprintf("%s%s %s %s", strings.Repeat(">", s.Depth), s.Name, s.Duration, res)
+}
+
+// ActionChain is the list of actions executed in one reconciliation.
+type ActionChain struct {
+	Started  time.Time     `json:"started"`
+	Duration time.Duration `json:"duration"`
+	Result   string        `json:"result"`
+	Steps    []ActionStep  `json:"steps"`
+}
+
+// StoppedBy returns the deepest last action that returned a flow control or other error,
+// the one that actually stopped the reconciliation, or nil if none did.
+func (c *ActionChain) StoppedBy() *ActionStep {
+	var found *ActionStep
+	for i := range c.Steps {
+		if c.Steps[i].Result != "" {
+			found = &c.Steps[i]
+		}
+	}
+	return found
+}
+
+type actionRecorder struct {
+	m       sync.Mutex
+	started time.Time
+	depth   int
+	steps   []ActionStep
+}
+
+type actionRecorderKeyType struct{}
+
+var actionRecorderKey = actionRecorderKeyType{}
+
+func actionRecorderFromCtx(ctx context.Context) *actionRecorder {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(actionRecorderKey).(*actionRecorder)
+	return r
+}
+
+// withActionRecorder returns the ctx with the recorder, creating it if the ctx has none yet,
+// so the outermost ComposeActions starts the recording for the whole reconciliation.
+func withActionRecorder(ctx context.Context) (context.Context, *actionRecorder) {
+	if r := actionRecorderFromCtx(ctx); r != nil {
+		return ctx, r
+	}
+	r := &actionRecorder{started: time.Now()}
+	return context.WithValue(ctx, actionRecorderKey, r), r
+}
+
+func (r *actionRecorder) enter() {
+	r.m.Lock()
+	r.depth++
+	r.m.Unlock()
+}
+
+// nameRunningStep names the step currently running at the current depth, since the actions
+// returned by ComposeActions and similar are closures without a meaningful function name.
+func (r *actionRecorder) nameRunningStep(name string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if len(r.steps) == 0 {
+		return
+	}
+	last := &r.steps[len(r.steps)-1]
+	if !last.done && last.Depth == r.depth {
+		last.Name = name
+	}
+}
+
+func (r *actionRecorder) exit() {
+	r.m.Lock()
+	r.depth--
+	r.m.Unlock()
+}
+
+// run executes the action and records it as a step. The step is added before the action
+// runs, so the actions of the nested ComposeActions follow their parent step.
+func (r *actionRecorder) run(ctx context.Context, state State, a Action) (error, context.Context) {
+	r.m.Lock()
+	idx := len(r.steps)
+	r.steps = append(r.steps, ActionStep{
+		Name:  actionName(a),
+		Depth: r.depth,
+	})
+	r.m.Unlock()
+
+	start := time.Now()
+	err, nextCtx := a(ctx, state)
+
+	r.m.Lock()
+	r.steps[idx].Duration = time.Since(start)
+	r.steps[idx].Result = describeActionResult(err)
+	r.steps[idx].done = true
+	r.m.Unlock()
+
+	return err, nextCtx
+}
+
+func (r *actionRecorder) chain(result string) ActionChain {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return ActionChain{
+		Started:  r.started,
+		Duration: time.Since(r.started),
+		Result:   result,
+		Steps:    append([]ActionStep(nil), r.steps...),
+	}
+}
+
+var actionNames sync.Map
+
+func actionName(a Action) string {
+	pc := reflect.ValueOf(a).Pointer()
+	if name, ok := actionNames.Load(pc); ok {
+		return name.(string)
+	}
+	name := "unknown"
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		name = fn.Name()
+		// github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcpeering.loadRemoteVpcPeering
+		// is shortened to vpcpeering.loadRemoteVpcPeering
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+	}
+	actionNames.Store(pc, name)
+	return name
+}
+
+func describeActionResult(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case IsStopAndForget(err):
+		return "StopAndForget"
+	case IsStopWithRequeue(err):
+		return "StopWithRequeue"
+	case IsStopWithRequeueDelay(err):
+		var ed *stopWithRequeueDelay
+		errors.As(err, &ed)
+		return fmt.Sprintf("StopWithRequeueDelay(%s)", ed.Delay())
+	case IsBreak(err):
+		return "Break"
+	case errors.Is(err, reconcile.TerminalError(nil)):
+		return fmt.Sprintf("TerminalError(%s)", err)
+	}
+	return fmt.Sprintf("%T(%s)", err, err)
+}
+
+// ActionChainHistory keeps the last reconciliation action chains per object.
+type ActionChainHistory struct {
+	cache *lru.Cache[string, []ActionChain]
+	m     sync.Mutex
+}
+
+func NewActionChainHistory(objects int) *ActionChainHistory {
+	cache, _ := lru.New[string, []ActionChain](objects)
+	return &ActionChainHistory{cache: cache}
+}
+
+// ActionChains is the history of the action chains recorded by the Handler.
+var ActionChains = NewActionChainHistory(actionChainHistoryObjects)
+
+func (h *ActionChainHistory) Record(key string, chain ActionChain) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	chains, _ := h.cache.Get(key)
+	chains = append(chains, chain)
+	if len(chains) > actionChainHistoryDepth {
+		chains = chains[len(chains)-actionChainHistoryDepth:]
+	}
+	h.cache.Add(key, chains)
+}
+
+// Get returns the recorded action chains of the object, the last one last.
+func (h *ActionChainHistory) Get(key string) []ActionChain {
+	h.m.Lock()
+	defer h.m.Unlock()
+	chains, _ := h.cache.Get(key)
+	return append([]ActionChain(nil), chains...)
+}
+
+func (h *ActionChainHistory) Keys() []string {
+	return h.cache.Keys()
+}
+
+// ServeHTTP lists the keys of the recorded objects, or with the key query parameter,
+// returns the recorded action chains of that object, for example
+// /debug/composed/actions?key=vpcpeering/kcp-system/my-peering
+func (h *ActionChainHistory) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body any
+	if key := r.URL.Query().Get("key"); key != "" {
+		chains := h.Get(key)
+		if len(chains) == 0 {
+			http.Error(w, fmt.Sprintf("no action chains recorded for %s", key), http.StatusNotFound)
+			return
+		}
+		body = chains
+	} else {
+		body = h.Keys()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(body)
+}
+
+func actionChainKey(controller, name string) string {
+	return controller + "/" + name
+}
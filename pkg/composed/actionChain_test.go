@@ -0,0 +1,108 @@
+package composed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loadSomething(ctx context.Context, _ State) (error, context.Context) {
+	return nil, ctx
+}
+
+func waitSomething(ctx context.Context, _ State) (error, context.Context) {
+	return StopWithRequeueDelay(time.Second), ctx
+}
+
+func TestActionChainRecording(t *testing.T) {
+	SetActionRecording(true)
+	defer SetActionRecording(false)
+
+	action := ComposeActions(
+		"main",
+		loadSomething,
+		ComposeActions(
+			"create",
+			loadSomething,
+			waitSomething,
+			loadSomething,
+		),
+		loadSomething,
+	)
+
+	_, _ = Handling().
+		WithMetrics("actionchaintest", "ns/obj").
+		WithNoLog().
+		Handle(action(context.Background(), nil))
+
+	chains := ActionChains.Get("actionchaintest/ns/obj")
+	assert.Len(t, chains, 1)
+	chain := chains[0]
+	assert.Equal(t, ReconcileResultRequeueAfter, chain.Result)
+
+	var names []string
+	for _, step := range chain.Steps {
+		names = append(names, step.Name)
+	}
+	assert.Equal(t, []string{"composed.loadSomething", "create", "composed.loadSomething", "composed.waitSomething"}, names)
+	assert.Equal(t, 1, chain.Steps[1].Depth)
+	assert.Equal(t, 2, chain.Steps[2].Depth)
+
+	stoppedBy := chain.StoppedBy()
+	if assert.NotNil(t, stoppedBy) {
+		assert.Equal(t, "composed.waitSomething", stoppedBy.Name)
+		assert.Equal(t, "StopWithRequeueDelay(1s)", stoppedBy.Result)
+	}
+
+	t.Run("history is bounded", func(t *testing.T) {
+		for i := 0; i < actionChainHistoryDepth+5; i++ {
+			_, _ = Handling().
+				WithMetrics("actionchaintest", "ns/bounded").
+				WithNoLog().
+				Handle(action(context.Background(), nil))
+		}
+		assert.Len(t, ActionChains.Get("actionchaintest/ns/bounded"), actionChainHistoryDepth)
+	})
+
+	t.Run("debug endpoint", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		ActionChains.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/composed/actions", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var keys []string
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &keys))
+		assert.Contains(t, keys, "actionchaintest/ns/obj")
+
+		rec = httptest.NewRecorder()
+		ActionChains.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/composed/actions?key=actionchaintest/ns/obj", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var loaded []ActionChain
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loaded))
+		assert.Len(t, loaded, 1)
+
+		rec = httptest.NewRecorder()
+		ActionChains.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/composed/actions?key=unknown", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestActionChainNotRecordedWhenDisabled(t *testing.T) {
+	SetActionRecording(false)
+
+	err, ctx := ComposeActions("main", loadSomething)(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, actionRecorderFromCtx(ctx))
+}
+
+func TestDescribeActionResult(t *testing.T) {
+	assert.Equal(t, "", describeActionResult(nil))
+	assert.Equal(t, "StopAndForget", describeActionResult(StopAndForget))
+	assert.Equal(t, "StopWithRequeue", describeActionResult(StopWithRequeue))
+	assert.Equal(t, "Break", describeActionResult(Break))
+	assert.Equal(t, "*errors.errorString(some error)", describeActionResult(errors.New("some error")))
+}
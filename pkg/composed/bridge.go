@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -18,11 +19,27 @@ const (
 	ReconciliationLabelDeadline     = "deadline"
 )
 
+// Values of the result label in the ReconcileDuration and ReconcileResult metrics.
+const (
+	ReconcileResultSuccess       = "success"
+	ReconcileResultStopAndForget = "stop_and_forget"
+	ReconcileResultRequeue       = "requeue"
+	ReconcileResultRequeueAfter  = "requeue_after"
+	ReconcileResultTerminal      = "terminal"
+	ReconcileResultError         = "error"
+	ReconcileResultCanceled      = "canceled"
+	ReconcileResultDeadline      = "deadline"
+)
+
+// Handling returns the Handler for the reconciliation result. It should be called before
+// the action is run, as in `Handling().Handle(action(ctx, state))`, since the reconcile
+// duration is measured from the moment the Handler is created.
 func Handling() *Handler {
-	return &Handler{}
+	return &Handler{started: time.Now()}
 }
 
 type Handler struct {
+	started    time.Time
 	controller string
 	name       string
 	noLog      bool
@@ -60,11 +77,23 @@ func (h *Handler) Handle(err error, ctx context.Context) (ctrl.Result, error) {
 
 	// used in defer func() to report the cloud_manager_reconcile metric
 	result := ReconciliationLabelSuccess
+	// used in defer func() to report the ReconcileDuration and ReconcileResult metrics
+	outcome := ReconcileResultSuccess
+	if h.controller != "" {
+		defer func() {
+			h.observe(outcome, reconcileErrorClass(outcome, err, ctx))
+		}()
+	}
 	if h.controller != "" && h.name != "" {
 		defer func() {
 			Reconcile.WithLabelValues(h.controller, h.name, result).Inc()
 		}()
 	}
+	if recorder := actionRecorderFromCtx(ctx); recorder != nil && h.name != "" {
+		defer func() {
+			h.recordActionChain(ctx, recorder.chain(outcome))
+		}()
+	}
 	if h.tracker != nil && h.name != "" {
 		defer func() {
 			h.tracker.Record(h.name, result)
@@ -74,26 +103,31 @@ func (h *Handler) Handle(err error, ctx context.Context) (ctrl.Result, error) {
 	if errors.Is(err, context.DeadlineExceeded) {
 		//logger.Info("Reconciliation finished with context deadline exceeded")
 		result = ReconciliationLabelDeadline
+		outcome = ReconcileResultDeadline
 		return ctrl.Result{}, nil
 	}
 	if errors.Is(err, context.Canceled) {
 		//logger.Info("Reconciliation finished with context canceled")
 		result = ReconciliationLabelCanceled
+		outcome = ReconcileResultCanceled
 		return ctrl.Result{}, nil
 	}
 	if IsTerminal(err) {
 		//logger.WithValues("err", err.Error()).Info("Reconciliation finished with terminal error")
 		result = ReconciliationLabelError
+		outcome = ReconcileResultTerminal
 		return ctrl.Result{}, err
 	}
 	if IsStopAndForget(err) {
 		//logger.Info("Reconciliation finished with stop and forget")
 		result = ReconciliationLabelSuccess
+		outcome = ReconcileResultStopAndForget
 		return ctrl.Result{}, nil
 	}
 	if IsStopWithRequeue(err) {
 		//logger.Info("Reconciliation finished with requeue")
 		result = ReconciliationLabelRequeue
+		outcome = ReconcileResultRequeue
 		return ctrl.Result{Requeue: true}, nil
 	}
 	if IsStopWithRequeueDelay(err) {
@@ -105,13 +139,64 @@ func (h *Handler) Handle(err error, ctx context.Context) (ctrl.Result, error) {
 			logger.Info("Reconciliation requeue delayed set to 1h since it was zero")
 		}
 		result = ReconciliationLabelRequeueAfter
+		outcome = ReconcileResultRequeueAfter
 		return ctrl.Result{RequeueAfter: ed.Delay()}, nil
 	}
 	//logger.Info("Reconciliation finished without control error - doing stop and forget")
 	result = ReconciliationLabelSuccess
+	if err != nil {
+		outcome = ReconcileResultError
+	}
 	return ctrl.Result{}, err
 }
 
+func (h *Handler) recordActionChain(ctx context.Context, chain ActionChain) {
+	ActionChains.Record(actionChainKey(h.controller, h.name), chain)
+
+	steps := make([]string, 0, len(chain.Steps))
+	for _, step := range chain.Steps {
+		steps = append(steps, step.String())
+	}
+	logger := LoggerFromCtx(ctx).WithValues(
+		"reconcileResult", chain.Result,
+		"reconcileDuration", chain.Duration.String(),
+		"actions", steps,
+	)
+	if stoppedBy := chain.StoppedBy(); stoppedBy != nil {
+		logger = logger.WithValues("stoppedBy", stoppedBy.Name, "stoppedWith", stoppedBy.Result)
+	}
+	logger.Info("Reconciliation action chain")
+}
+
+func (h *Handler) observe(outcome, errorClass string) {
+	if !h.started.IsZero() {
+		ReconcileDuration.WithLabelValues(h.controller, outcome).Observe(time.Since(h.started).Seconds())
+	}
+	ReconcileResult.WithLabelValues(h.controller, outcome, errorClass).Inc()
+}
+
+// reconcileErrorClass classifies the error the reconciliation ended with. Flow control
+// errors carry no cause, so for them the error logged with LogErrorAndReturn is used,
+// or the Error condition of the reconciled object if no error was logged.
+func reconcileErrorClass(outcome string, err error, ctx context.Context) string {
+	switch outcome {
+	case ReconcileResultSuccess, ReconcileResultStopAndForget:
+		return util.ErrorClassNone
+	case ReconcileResultCanceled:
+		return util.ErrorClassCanceled
+	case ReconcileResultDeadline:
+		return util.ErrorClassDeadline
+	}
+	if err != nil && !IsFlowControl(err) {
+		return util.ClassifyError(err)
+	}
+	cause := reconcileErrorCauseFromCtx(ctx)
+	if class := classifyErrorConditionCause(cause); class != "" {
+		return class
+	}
+	return util.ClassifyError(cause)
+}
+
 func HandleWithoutLogging(err error, ctx context.Context) (ctrl.Result, error) {
 	return Handling().WithNoLog().Handle(err, ctx)
 }
@@ -0,0 +1,154 @@
+package composed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestHandlerReconcileMetrics(t *testing.T) {
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "test"}, "a")
+
+	withErrorCondition := func(reason string) State {
+		obj := &cloudcontrolv1beta1.RedisInstance{
+			Status: cloudcontrolv1beta1.RedisInstanceStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    cloudcontrolv1beta1.ConditionTypeError,
+						Status:  metav1.ConditionTrue,
+						Reason:  reason,
+						Message: "test",
+					},
+				},
+			},
+		}
+		return NewStateFactory(nil).NewState(types.NamespacedName{Name: "a"}, obj)
+	}
+
+	testData := []struct {
+		title      string
+		state      State
+		action     Action
+		result     string
+		errorClass string
+	}{
+		{
+			"success",
+			nil,
+			Noop,
+			ReconcileResultSuccess,
+			util.ErrorClassNone,
+		},
+		{
+			"stop and forget",
+			nil,
+			StopAndForgetAction,
+			ReconcileResultStopAndForget,
+			util.ErrorClassNone,
+		},
+		{
+			"requeue without cause",
+			nil,
+			StopWithRequeueAction,
+			ReconcileResultRequeue,
+			util.ErrorClassNone,
+		},
+		{
+			"requeue after logged error",
+			nil,
+			func(ctx context.Context, _ State) (error, context.Context) {
+				return LogErrorAndReturn(notFound, "test", StopWithRequeueDelay(time.Second), ctx)
+			},
+			ReconcileResultRequeueAfter,
+			util.ErrorClassNotFound,
+		},
+		{
+			"terminal",
+			nil,
+			func(ctx context.Context, _ State) (error, context.Context) {
+				return reconcile.TerminalError(notFound), ctx
+			},
+			ReconcileResultTerminal,
+			util.ErrorClassNotFound,
+		},
+		{
+			"error",
+			nil,
+			func(ctx context.Context, _ State) (error, context.Context) {
+				return errors.New("some error"), ctx
+			},
+			ReconcileResultError,
+			util.ErrorClassOther,
+		},
+		{
+			"canceled",
+			nil,
+			func(ctx context.Context, _ State) (error, context.Context) {
+				return context.Canceled, ctx
+			},
+			ReconcileResultCanceled,
+			util.ErrorClassCanceled,
+		},
+		{
+			"requeue with error condition",
+			withErrorCondition(cloudcontrolv1beta1.ReasonCloudProviderError),
+			func(ctx context.Context, _ State) (error, context.Context) {
+				return StopWithRequeueDelay(time.Second), nil
+			},
+			ReconcileResultRequeueAfter,
+			util.ErrorClassOther,
+		},
+		{
+			"requeue with invalid spec error condition",
+			withErrorCondition(cloudcontrolv1beta1.ReasonInvalidSpec),
+			StopWithRequeueAction,
+			ReconcileResultRequeue,
+			util.ErrorClassInvalid,
+		},
+		{
+			"logged error takes precedence over error condition",
+			withErrorCondition(cloudcontrolv1beta1.ReasonInvalidSpec),
+			func(ctx context.Context, _ State) (error, context.Context) {
+				return LogErrorAndReturn(notFound, "test", StopWithRequeueDelay(time.Second), ctx)
+			},
+			ReconcileResultRequeueAfter,
+			util.ErrorClassNotFound,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.title, func(t *testing.T) {
+			controller := "test-" + tt.title
+			action := ComposeActions("test", tt.action)
+
+			_, _ = Handling().
+				WithMetrics(controller, "ns/name").
+				WithNoLog().
+				Handle(action(context.Background(), tt.state))
+
+			assert.Equal(t, float64(1), testutil.ToFloat64(ReconcileResult.WithLabelValues(controller, tt.result, tt.errorClass)))
+			m := &dto.Metric{}
+			assert.NoError(t, ReconcileDuration.WithLabelValues(controller, tt.result).(prometheus.Histogram).Write(m))
+			assert.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+		})
+	}
+
+	t.Run("no metrics without controller", func(t *testing.T) {
+		before := testutil.CollectAndCount(ReconcileResult)
+		_, _ = HandleWithoutLogging(nil, context.Background())
+		assert.Equal(t, before, testutil.CollectAndCount(ReconcileResult))
+	})
+}
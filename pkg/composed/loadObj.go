@@ -2,6 +2,8 @@ package composed
 
 import (
 	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
@@ -14,7 +16,7 @@ func LoadObj(ctx context.Context, state State) (error, context.Context) {
 		return LogErrorAndReturn(err, "Error loading object", StopWithRequeue, ctx)
 	}
 
-	return nil, nil
+	return nil, continueTrace(ctx, state)
 }
 
 func LoadObjNoStopIfNotFound(ctx context.Context, state State) (error, context.Context) {
@@ -26,7 +28,20 @@ func LoadObjNoStopIfNotFound(ctx context.Context, state State) (error, context.C
 		return LogErrorAndReturn(err, "Error loading object", StopWithRequeue, ctx)
 	}
 
-	return nil, nil
+	return nil, continueTrace(ctx, state)
+}
+
+// continueTrace makes the spans of the following actions part of the trace the object
+// was annotated with by the flow that created it, so one trace covers the flow across
+// SKR and KCP. It returns nil, meaning unchanged ctx, if the object has no annotation.
+func continueTrace(ctx context.Context, state State) context.Context {
+	if state.Obj() == nil {
+		return nil
+	}
+	if _, ok := state.Obj().GetAnnotations()[tracing.TraceParentAnnotation]; !ok {
+		return nil
+	}
+	return tracing.ExtractFromObj(ctx, state.Obj())
 }
 
 func IsObjLoaded(_ context.Context, state State) bool {
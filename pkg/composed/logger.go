@@ -3,7 +3,16 @@ package composed
 import (
 	"context"
 	"errors"
+	"fmt"
+
 	"github.com/go-logr/logr"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -23,5 +32,102 @@ func LogErrorAndReturn(err error, msg string, result error, ctx context.Context)
 		logger.Error(errors.New("the ctx is not supplied to LogErrorAndReturn"), "Logical error")
 	}
 	logger.Error(err, msg)
+	if ctx != nil {
+		span := trace.SpanFromContext(ctx)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, msg)
+		setReconcileErrorCause(ctx, err)
+	}
 	return result, ctx
 }
+
+type reconcileErrorCauseKeyType struct{}
+
+var reconcileErrorCauseKey = reconcileErrorCauseKeyType{}
+
+type reconcileErrorCause struct {
+	err error
+}
+
+// withReconcileErrorCause makes room for the error logged with LogErrorAndReturn, so the
+// Handler can classify it once the flow is stopped with a flow control error that does
+// not carry the cause. The returned context of an action stays the one it was given.
+func withReconcileErrorCause(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(reconcileErrorCauseKey).(*reconcileErrorCause); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, reconcileErrorCauseKey, &reconcileErrorCause{})
+}
+
+func setReconcileErrorCause(ctx context.Context, err error) {
+	if cause, ok := ctx.Value(reconcileErrorCauseKey).(*reconcileErrorCause); ok {
+		cause.err = err
+	}
+}
+
+// setReconcileErrorCauseFromObj records the Error condition of the reconciled object as the
+// cause, if no error was logged with LogErrorAndReturn. Provider actions often only log the
+// error and set the Error condition before stopping the flow with a requeue.
+func setReconcileErrorCauseFromObj(ctx context.Context, obj any) {
+	cause, ok := ctx.Value(reconcileErrorCauseKey).(*reconcileErrorCause)
+	if !ok || cause.err != nil {
+		return
+	}
+	x, ok := obj.(ObjWithConditions)
+	if !ok || x.Conditions() == nil {
+		return
+	}
+	cond := meta.FindStatusCondition(*x.Conditions(), cloudcontrolv1beta1.ConditionTypeError)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return
+	}
+	cause.err = &errorConditionCause{reason: cond.Reason, message: cond.Message}
+}
+
+// errorConditionCause is the cause taken from the Error condition of the reconciled object.
+type errorConditionCause struct {
+	reason  string
+	message string
+}
+
+func (e *errorConditionCause) Error() string {
+	return fmt.Sprintf("error condition %s: %s", e.reason, e.message)
+}
+
+// classifyErrorConditionCause maps the well known Error condition reasons to the error class.
+func classifyErrorConditionCause(err error) string {
+	e, ok := errors.AsType[*errorConditionCause](err)
+	if !ok {
+		return ""
+	}
+	switch e.reason {
+	case cloudcontrolv1beta1.ReasonInvalidSpec,
+		cloudcontrolv1beta1.ReasonValidationFailed,
+		cloudcontrolv1beta1.ReasonInvalidCidr,
+		cloudcontrolv1beta1.ReasonInvalidDependency,
+		cloudcontrolv1beta1.ReasonInvalidBinding:
+		return util.ErrorClassInvalid
+	case cloudcontrolv1beta1.ReasonNotFound,
+		cloudcontrolv1beta1.ReasonScopeNotFound:
+		return util.ErrorClassNotFound
+	case cloudcontrolv1beta1.ReasonUnauthorized,
+		cloudcontrolv1beta1.ReasonUnauthenticated:
+		return util.ErrorClassForbidden
+	case cloudcontrolv1beta1.ReasonConflict:
+		return util.ErrorClassConflict
+	case cloudresourcesv1beta1.ConditionTypeQuotaExceeded:
+		return util.ErrorClassQuota
+	}
+	return util.ErrorClassOther
+}
+
+func reconcileErrorCauseFromCtx(ctx context.Context) error {
+	if ctx == nil {
+		return nil
+	}
+	cause, ok := ctx.Value(reconcileErrorCauseKey).(*reconcileErrorCause)
+	if !ok {
+		return nil
+	}
+	return cause.err
+}
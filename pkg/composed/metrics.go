@@ -10,10 +10,26 @@ var (
 		Name: "cloud_manager_reconcile",
 		Help: "Total number of SKR reconciliation connections per kyma name",
 	}, []string{"controller", "name", "result"})
+
+	// ReconcileDuration and ReconcileResult have no per-object labels, so they are safe to
+	// alert on. The result label is one of the ReconcileResult constants and the error_class
+	// label is one of the util.ErrorClass constants.
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cloud_manager_reconcile_duration_seconds",
+		Help:    "Duration of composed reconciliations per controller and result",
+		Buckets: []float64{0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"controller", "result"})
+
+	ReconcileResult = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloud_manager_reconcile_result_total",
+		Help: "Total number of composed reconciliations per controller, result and error class",
+	}, []string{"controller", "result", "error_class"})
 )
 
 func init() {
 	metrics.Registry.MustRegister(
 		Reconcile,
+		ReconcileDuration,
+		ReconcileResult,
 	)
 }
@@ -0,0 +1,47 @@
+package composed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestComposeActionsTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	prev := otel.GetTracerProvider()
+	tracing.Install(tracing.NewTracerProvider(&tracing.ConfigStruct{SampleRatio: 1}, sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(prev)
+
+	action := ComposeActions(
+		"main",
+		ComposeActions("first", Noop),
+		ComposeActionsNoName(Noop),
+		ComposeActions("second", func(ctx context.Context, _ State) (error, context.Context) {
+			return LogErrorAndReturn(errors.New("some error"), "Error in second", StopWithRequeue, ctx)
+		}),
+	)
+
+	err, _ := action(context.Background(), nil)
+	assert.ErrorIs(t, err, StopWithRequeue)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 3, "unnamed compose should not create a span")
+	byName := map[string]tracetest.SpanStub{}
+	for _, s := range spans {
+		byName[s.Name] = s
+	}
+
+	main := byName["main"]
+	assert.False(t, main.Parent.IsValid())
+	assert.Equal(t, main.SpanContext.SpanID(), byName["first"].Parent.SpanID())
+	assert.Equal(t, main.SpanContext.SpanID(), byName["second"].Parent.SpanID(), "sibling should not be child of the ended first span")
+	assert.Equal(t, codes.Error, byName["second"].Status.Code)
+	assert.Equal(t, codes.Unset, byName["first"].Status.Code)
+}
@@ -18,6 +18,7 @@ func TestEmbeddedConfig(t *testing.T) {
 	assert.True(t, FFNukeBackupsAws.Value(ctx), "nukeBackupsAws should be true")
 	assert.True(t, FFNukeBackupsGcp.Value(ctx), "nukeBackupsGcp should be true")
 	assert.False(t, Nfs41Gcp.Value(ctx), "nfs41Gcp should be false")
+	assert.False(t, FFNukeDryRun.Value(ctx), "nukeDryRun should be false")
 
 	assert.True(t, ApiDisabled.Value(ContextBuilderFromCtx(ctx).Feature(types.FeatureNfsBackup).Build(ctx)),
 		"apiDisabled targeting feature nfsBackup should be true")
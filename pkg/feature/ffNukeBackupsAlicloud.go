@@ -0,0 +1,23 @@
+package feature
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+const nukeBackupsAlicloudFlagName = "nukeBackupsAlicloud"
+
+var FFNukeBackupsAlicloud = &nukeBackupsAlicloudInfo{}
+
+type nukeBackupsAlicloudInfo struct{}
+
+func (k *nukeBackupsAlicloudInfo) Value(ctx context.Context) bool {
+	return provider.BoolVariation(ctx, nukeBackupsAlicloudFlagName, false)
+}
+
+func (k *nukeBackupsAlicloudInfo) Predicate() composed.Predicate {
+	return func(ctx context.Context, _ composed.State) bool {
+		return k.Value(ctx)
+	}
+}
@@ -0,0 +1,23 @@
+package feature
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+const nukeBackupsSapFlagName = "nukeBackupsSap"
+
+var FFNukeBackupsSap = &nukeBackupsSapInfo{}
+
+type nukeBackupsSapInfo struct{}
+
+func (k *nukeBackupsSapInfo) Value(ctx context.Context) bool {
+	return provider.BoolVariation(ctx, nukeBackupsSapFlagName, false)
+}
+
+func (k *nukeBackupsSapInfo) Predicate() composed.Predicate {
+	return func(ctx context.Context, _ composed.State) bool {
+		return k.Value(ctx)
+	}
+}
@@ -0,0 +1,19 @@
+package feature
+
+import (
+	"context"
+)
+
+const nukeDryRunFlagName = "nukeDryRun"
+
+// FFNukeDryRun makes the Scope reconciler create the automatic Nuke with dry run enabled,
+// so the orphaned resources of a removed Kyma are only reported. An operator can review the
+// Nuke status and set its spec.dryRun to false to continue with the deletion.
+// Evaluated with the provider of the Scope, so it can be enabled per provider.
+var FFNukeDryRun = &nukeDryRunInfo{}
+
+type nukeDryRunInfo struct{}
+
+func (k *nukeDryRunInfo) Value(ctx context.Context) bool {
+	return provider.BoolVariation(ctx, nukeDryRunFlagName, false)
+}
@@ -24,6 +24,10 @@ func loadResources(ctx context.Context, st composed.State) (error, context.Conte
 			Kind: "VpcPeering",
 			List: &cloudcontrolv1beta1.VpcPeeringList{},
 		},
+		{
+			Kind: "RedisUser",
+			List: &cloudcontrolv1beta1.RedisUserList{},
+		},
 		{
 			Kind: "RedisInstance",
 			List: &cloudcontrolv1beta1.RedisInstanceList{},
@@ -8,9 +8,12 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common/statewithscope"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	alicloudnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke"
 	awsnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke"
 	azurenuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke"
 	gcpnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke"
+	sapnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nuke"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -27,6 +30,8 @@ func New(
 	gcpStateFactory gcpnuke.StateFactory,
 	awsStateFactory awsnuke.StateFactory,
 	azureStateFactory azurenuke.StateFactory,
+	sapStateFactory sapnuke.StateFactory,
+	alicloudStateFactory alicloudnuke.StateFactory,
 ) NukeReconciler {
 	return &nukeReconciler{
 		stateFactory: NewStateFactory(
@@ -34,17 +39,21 @@ func New(
 			focal.NewStateFactory(),
 			activeSkrCollection,
 		),
-		gcpStateFactory:   gcpStateFactory,
-		awsStateFactory:   awsStateFactory,
-		azureStateFactory: azureStateFactory,
+		gcpStateFactory:      gcpStateFactory,
+		awsStateFactory:      awsStateFactory,
+		azureStateFactory:    azureStateFactory,
+		sapStateFactory:      sapStateFactory,
+		alicloudStateFactory: alicloudStateFactory,
 	}
 }
 
 type nukeReconciler struct {
-	stateFactory      StateFactory
-	gcpStateFactory   gcpnuke.StateFactory
-	awsStateFactory   awsnuke.StateFactory
-	azureStateFactory azurenuke.StateFactory
+	stateFactory         StateFactory
+	gcpStateFactory      gcpnuke.StateFactory
+	awsStateFactory      awsnuke.StateFactory
+	azureStateFactory    azurenuke.StateFactory
+	sapStateFactory      sapnuke.StateFactory
+	alicloudStateFactory alicloudnuke.StateFactory
 }
 
 func (r *nukeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -68,9 +77,12 @@ func (r *nukeReconciler) newAction() composed.Action {
 			shortCircuitCompleted,
 			loadResources,
 			resourceStatusDiscovered,
-			deleteResources,
-			resourceStatusDeleting,
-			resourceStatusDeleted,
+			composed.If(
+				composed.Not(nuketypes.DryRunPredicate),
+				deleteResources,
+				resourceStatusDeleting,
+				resourceStatusDeleted,
+			),
 			composed.If(
 				composed.All(
 					feature.FFNukeBackupsGcp.Predicate(),
@@ -92,9 +104,29 @@ func (r *nukeReconciler) newAction() composed.Action {
 				),
 				azurenuke.New(r.azureStateFactory),
 			),
-			checkIfAllDeleted,
-			scopeDelete,
-			statusCompleted,
+			composed.If(
+				composed.All(
+					feature.FFNukeBackupsSap.Predicate(),
+					statewithscope.OpenStackProviderPredicate,
+				),
+				sapnuke.New(r.sapStateFactory),
+			),
+			composed.If(
+				composed.All(
+					feature.FFNukeBackupsAlicloud.Predicate(),
+					statewithscope.AlicloudProviderPredicate,
+				),
+				alicloudnuke.New(r.alicloudStateFactory),
+			),
+			composed.IfElse(
+				nuketypes.DryRunPredicate,
+				statusDryRunCompleted,
+				composed.ComposeActionsNoName(
+					checkIfAllDeleted,
+					scopeDelete,
+					statusCompleted,
+				),
+			),
 		),
 	)
 }
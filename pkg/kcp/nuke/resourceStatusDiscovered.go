@@ -13,7 +13,8 @@ func resourceStatusDiscovered(ctx context.Context, st composed.State) (error, co
 
 	changed := false
 
-	if state.ObjAsNuke().Status.State == "" {
+	if state.ObjAsNuke().Status.State == "" ||
+		(state.ObjAsNuke().Status.State == cloudcontrolv1beta1.NukeStateDryRunCompleted && !state.ObjAsNuke().Spec.DryRun) {
 		changed = true
 		state.ObjAsNuke().Status.State = "Processing"
 	}
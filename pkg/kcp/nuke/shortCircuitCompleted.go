@@ -3,6 +3,8 @@ package nuke
 import (
 	"context"
 
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 )
 
@@ -13,5 +15,10 @@ func shortCircuitCompleted(ctx context.Context, st composed.State) (error, conte
 		return composed.StopAndForget, nil
 	}
 
+	// a dry run Nuke is reconciled again only once its dryRun is turned off
+	if state.ObjAsNuke().Status.State == cloudcontrolv1beta1.NukeStateDryRunCompleted && state.ObjAsNuke().Spec.DryRun {
+		return composed.StopAndForget, nil
+	}
+
 	return nil, ctx
 }
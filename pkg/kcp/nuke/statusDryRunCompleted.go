@@ -0,0 +1,62 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// statusDryRunCompleted reports the resources that the Nuke would delete as an event, and stops
+// the reconciliation. The resources themselves are already listed in the status by the
+// main and provider discovery actions.
+func statusDryRunCompleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	summary := dryRunSummary(state.ObjAsNuke().Status.Resources)
+
+	logger.Info("Nuke dry run completed", "summary", summary)
+
+	state.Cluster().EventRecorder().Eventf(
+		state.ObjAsNuke(), nil,
+		corev1.EventTypeNormal, cloudcontrolv1beta1.NukeStateDryRunCompleted, "DryRun",
+		"%s", summary,
+	)
+
+	state.ObjAsNuke().Status.State = cloudcontrolv1beta1.NukeStateDryRunCompleted
+
+	return composed.PatchStatus(state.ObjAsNuke()).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.NukeStateDryRunCompleted,
+			Message: summary,
+		}).
+		ErrorLogMessage("Error patching KCP Nuke status with dry run completed").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
+
+// dryRunSummary returns a human readable count of discovered resources per kind, in the
+// order of the status resources, for example
+// `Dry run discovered 3 orphaned resources: NfsInstance(KCP)=1, SapNfsVolumeSnapshot(Provider)=2`
+func dryRunSummary(resources []*cloudcontrolv1beta1.NukeStatusKind) string {
+	total := 0
+	var kinds []string
+	for _, sk := range resources {
+		if len(sk.Objects) == 0 {
+			continue
+		}
+		total += len(sk.Objects)
+		kinds = append(kinds, fmt.Sprintf("%s(%s)=%d", sk.Kind, sk.GetResourceType(), len(sk.Objects)))
+	}
+	if total == 0 {
+		return "Dry run discovered no orphaned resources"
+	}
+	return fmt.Sprintf("Dry run discovered %d orphaned resources: %s", total, strings.Join(kinds, ", "))
+}
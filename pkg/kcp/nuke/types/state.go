@@ -1,8 +1,11 @@
 package types
 
 import (
+	"context"
+
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,3 +30,9 @@ type State interface {
 	focal.State
 	ObjAsNuke() *cloudcontrolv1beta1.Nuke
 }
+
+// DryRunPredicate is true for a Nuke that only reports the orphaned resources, so the
+// deleting actions of the main and provider chains are skipped.
+func DryRunPredicate(ctx context.Context, st composed.State) bool {
+	return st.Obj().(*cloudcontrolv1beta1.Nuke).Spec.DryRun
+}
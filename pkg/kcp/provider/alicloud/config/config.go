@@ -1,12 +1,15 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kyma-project/cloud-manager/pkg/config"
 )
 
 type AlicloudConfigStruct struct {
-	AccessKeyId     string `json:"accessKeyId,omitempty" yaml:"accessKeyId,omitempty"`
-	AccessKeySecret string `json:"accessKeySecret,omitempty" yaml:"accessKeySecret,omitempty"`
+	AccessKeyId              string        `json:"accessKeyId,omitempty" yaml:"accessKeyId,omitempty"`
+	AccessKeySecret          string        `json:"accessKeySecret,omitempty" yaml:"accessKeySecret,omitempty"`
+	NasCapacityCheckInterval time.Duration `json:"nasCapacityCheckInterval" yaml:"nasCapacityCheckInterval"`
 }
 
 var AlicloudConfig = &AlicloudConfigStruct{}
@@ -27,5 +30,10 @@ func InitConfig(cfg config.Config) {
 			config.SourceEnv("ALICLOUD_SECRET_KEY"),
 			config.SourceFile("ALICLOUD_SECRET_KEY"),
 		),
+		config.Path(
+			"nasCapacityCheckInterval",
+			config.DefaultScalar(1*time.Hour),
+			config.SourceEnv("ALICLOUD_NAS_CAPACITY_CHECK_INTERVAL"),
+		),
 	)
 }
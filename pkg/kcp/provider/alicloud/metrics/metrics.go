@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/dara"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
+)
+
+// NewMetricsHttpClient returns the dara.HttpClient to set in the openapi.Config of
+// every AliCloud SDK client, so API calls are counted in the CloudProviderCallCount
+// metric and their latency recorded in the CloudProviderCallDuration metric. The
+// AliCloud SDK does not expose the region on the request, so it is given here.
+func NewMetricsHttpClient(region string) dara.HttpClient {
+	return &metricsHttpClient{
+		region:     region,
+		httpClient: &http.Client{},
+	}
+}
+
+type metricsHttpClient struct {
+	sync.Mutex
+	region     string
+	httpClient *http.Client
+}
+
+// Call mimics the SDK default client: the transport built by the SDK from the
+// runtime options is set once on the first call, so connections are reused
+// across calls instead of dialing with a fresh transport every time.
+func (c *metricsHttpClient) Call(request *http.Request, transport *http.Transport) (*http.Response, error) {
+	c.Lock()
+	if c.httpClient.Transport == nil {
+		c.httpClient.Transport = transport
+	}
+	c.Unlock()
+
+	start := time.Now()
+	done := metrics.CloudProviderCallStarted(metrics.CloudProviderAlicloud)
+	resp, err := c.httpClient.Do(request)
+	done()
+	latency := time.Since(start)
+
+	responseCode := 0
+	if resp != nil {
+		responseCode = resp.StatusCode
+	}
+
+	metrics.ReportCloudProviderCall(
+		metrics.CloudProviderAlicloud,
+		operationName(request),
+		fmt.Sprintf("%d", responseCode),
+		c.region,
+		"",
+		latency,
+	)
+	tracing.RecordCloudProviderCall(request.Context(), metrics.CloudProviderAlicloud, operationName(request), fmt.Sprintf("%d", responseCode), start, err)
+
+	return resp, err
+}
+
+// operationName returns the {product}/{action} of the AliCloud API call, where
+// product is the first label of the endpoint host (nas, vpc, r-kvstore) and
+// action is set by the SDK in the x-acs-action header.
+func operationName(request *http.Request) string {
+	host := request.Host
+	if host == "" && request.URL != nil {
+		host = request.URL.Host
+	}
+	product, _, _ := strings.Cut(host, ".")
+	if product == "" {
+		product = "?"
+	}
+	action := request.Header.Get("x-acs-action")
+	if action == "" {
+		action = "?"
+	}
+	return fmt.Sprintf("%s/%s", product, action)
+}
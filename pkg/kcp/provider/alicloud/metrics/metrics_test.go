@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func durationSampleCount(t *testing.T, method, responseCode, region string) uint64 {
+	m := &dto.Metric{}
+	h := metrics.CloudProviderCallDuration.WithLabelValues(metrics.CloudProviderAlicloud, method, responseCode, region)
+	assert.NoError(t, h.(prometheus.Histogram).Write(m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestOperationName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		host     string
+		action   string
+		expected string
+	}{
+		{"nas call", "nas.eu-central-1.aliyuncs.com", "CreateFileSystem", "nas/CreateFileSystem"},
+		{"redis call", "r-kvstore.eu-central-1.aliyuncs.com", "DescribeInstances", "r-kvstore/DescribeInstances"},
+		{"missing action", "vpc.eu-central-1.aliyuncs.com", "", "vpc/?"},
+		{"missing host", "", "DescribeVpcs", "?/DescribeVpcs"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "http://localhost/", nil)
+			assert.NoError(t, err)
+			req.Host = tc.host
+			req.URL.Host = ""
+			if tc.action != "" {
+				req.Header.Set("x-acs-action", tc.action)
+			}
+			assert.Equal(t, tc.expected, operationName(req))
+		})
+	}
+}
+
+func TestMetricsHttpClientReportsCall(t *testing.T) {
+	metrics.CloudProviderCallCount.Reset()
+	metrics.CloudProviderCallDuration.Reset()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, nil)
+	assert.NoError(t, err)
+	req.Host = "nas.eu-central-1.aliyuncs.com"
+	req.Header.Set("x-acs-action", "DescribeFileSystems")
+
+	c := NewMetricsHttpClient("eu-central-1")
+	resp, err := c.Call(req, &http.Transport{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.CloudProviderCallCount.WithLabelValues(
+		metrics.CloudProviderAlicloud, "nas/DescribeFileSystems", "404", "eu-central-1", "")))
+	assert.Equal(t, uint64(1), durationSampleCount(t, "nas/DescribeFileSystems", "404", "eu-central-1"))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.CloudProviderCallInFlight.WithLabelValues(metrics.CloudProviderAlicloud)))
+}
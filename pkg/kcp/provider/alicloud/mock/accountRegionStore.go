@@ -3,6 +3,7 @@ package mock
 import (
 	alicloudiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/iprange/client"
 	alicloudnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nfsinstance/client"
+	alicloudnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke/client"
 	alicloudredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/rediscluster/client"
 	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
 	alicloudvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/vpcnetwork/client"
@@ -47,3 +48,7 @@ func (s *accountRegionStore) RedisInstanceClient() alicloudredisinstanceclient.C
 func (s *accountRegionStore) RedisClusterClient() alicloudredisclusterclient.Client {
 	return &redisClusterClientView{redisStore: s.redisStore}
 }
+
+func (s *accountRegionStore) NukeClient() alicloudnukeclient.Client {
+	return &nukeClientView{nas: s.nasStore, redis: s.redisStore}
+}
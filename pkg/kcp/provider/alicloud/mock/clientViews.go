@@ -5,6 +5,8 @@ import (
 
 	alicloudiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/iprange/client"
 	alicloudnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nfsinstance/client"
+	alicloudnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke/client"
+	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
 	alicloudvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/vpcnetwork/client"
 )
 
@@ -63,3 +65,39 @@ func (c *vpcnetworkClientView) DescribeVpcs(ctx context.Context, name string) ([
 type nfsInstanceClientView struct{ *nasStore }
 
 var _ alicloudnfsinstanceclient.Client = (*nfsInstanceClientView)(nil)
+
+// nukeClientView adapts nasStore and redisStore to alicloudnukeclient.Client.
+type nukeClientView struct {
+	nas   *nasStore
+	redis *redisStore
+}
+
+var _ alicloudnukeclient.Client = (*nukeClientView)(nil)
+
+func (c *nukeClientView) DescribeFileSystemsByVpcId(ctx context.Context, vpcId string) ([]alicloudnfsinstanceclient.FileSystemInfo, error) {
+	return c.nas.describeFileSystemsByVpcId(ctx, vpcId)
+}
+
+func (c *nukeClientView) DescribeFileSystem(ctx context.Context, fileSystemId string) (*alicloudnfsinstanceclient.FileSystemInfo, error) {
+	return c.nas.DescribeFileSystem(ctx, fileSystemId)
+}
+
+func (c *nukeClientView) DescribeMountTargets(ctx context.Context, fileSystemId string) ([]alicloudnfsinstanceclient.MountTargetInfo, error) {
+	return c.nas.DescribeMountTargets(ctx, fileSystemId)
+}
+
+func (c *nukeClientView) DeleteMountTarget(ctx context.Context, fileSystemId, mountTargetDomain string) error {
+	return c.nas.DeleteMountTarget(ctx, fileSystemId, mountTargetDomain)
+}
+
+func (c *nukeClientView) DeleteFileSystem(ctx context.Context, fileSystemId string) error {
+	return c.nas.DeleteFileSystem(ctx, fileSystemId)
+}
+
+func (c *nukeClientView) DescribeInstancesByVpcId(ctx context.Context, vpcId string) ([]alicloudredisinstanceclient.InstanceInfo, error) {
+	return c.redis.describeInstancesByVpcId(ctx, vpcId)
+}
+
+func (c *nukeClientView) DeleteInstance(ctx context.Context, instanceId string) error {
+	return c.redis.deleteInstance(ctx, instanceId)
+}
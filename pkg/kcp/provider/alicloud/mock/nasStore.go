@@ -18,6 +18,7 @@ type NasFileSystemEntry struct {
 	StorageType    string
 	Status         string
 	ZoneId         string
+	Description    string
 	MeteredSize    int64
 	Capacity       int64
 }
@@ -77,6 +78,26 @@ func (s *nasStore) AddNasFileSystem(id, protocolType, storageType, zoneId string
 	return entry
 }
 
+func (s *nasStore) SetNasFileSystemMeteredSize(fileSystemId string, meteredSize int64) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, f := range s.fileSystems {
+		if f.FileSystemId == fileSystemId {
+			f.MeteredSize = meteredSize
+		}
+	}
+}
+
+func (s *nasStore) SetNasFileSystemDescription(fileSystemId string, description string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, f := range s.fileSystems {
+		if f.FileSystemId == fileSystemId {
+			f.Description = description
+		}
+	}
+}
+
 func (s *nasStore) SetNasFileSystemError(fileSystemId string, err error) {
 	s.m.Lock()
 	defer s.m.Unlock()
@@ -110,16 +131,18 @@ func (s *nasStore) DescribeFileSystem(ctx context.Context, fileSystemId string)
 		StorageType:    f.StorageType,
 		Status:         f.Status,
 		ZoneId:         f.ZoneId,
+		Description:    f.Description,
 		MeteredSize:    f.MeteredSize,
 		Capacity:       f.Capacity,
 	}, nil
 }
 
-func (s *nasStore) CreateFileSystem(ctx context.Context, protocolType, storageType, zoneId string) (string, error) {
+func (s *nasStore) CreateFileSystem(ctx context.Context, protocolType, storageType, zoneId, description string) (string, error) {
 	if isContextCanceled(ctx) {
 		return "", context.Canceled
 	}
 	entry := s.AddNasFileSystem("", protocolType, storageType, zoneId)
+	s.SetNasFileSystemDescription(entry.FileSystemId, description)
 	return entry.FileSystemId, nil
 }
 
@@ -145,6 +168,36 @@ func (s *nasStore) DeleteFileSystem(ctx context.Context, fileSystemId string) er
 	return nil
 }
 
+// describeFileSystemsByVpcId returns the file systems with at least one mount target in the VPC.
+func (s *nasStore) describeFileSystemsByVpcId(ctx context.Context, vpcId string) ([]alicloudnfsinstanceclient.FileSystemInfo, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	var out []alicloudnfsinstanceclient.FileSystemInfo
+	for _, f := range s.fileSystems {
+		inVpc := pie.Any(s.mountTargets, func(mt *NasMountTargetEntry) bool {
+			return mt.FileSystemId == f.FileSystemId && mt.VpcId == vpcId
+		})
+		if !inVpc {
+			continue
+		}
+		out = append(out, alicloudnfsinstanceclient.FileSystemInfo{
+			FileSystemId:   f.FileSystemId,
+			FileSystemType: f.FileSystemType,
+			ProtocolType:   f.ProtocolType,
+			StorageType:    f.StorageType,
+			Status:         f.Status,
+			ZoneId:         f.ZoneId,
+			Description:    f.Description,
+			MeteredSize:    f.MeteredSize,
+			Capacity:       f.Capacity,
+		})
+	}
+	return out, nil
+}
+
 // === nfsinstance.Client: mount targets ======================================
 
 func (s *nasStore) DescribeMountTargets(ctx context.Context, fileSystemId string) ([]alicloudnfsinstanceclient.MountTargetInfo, error) {
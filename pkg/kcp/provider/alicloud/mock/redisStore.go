@@ -3,6 +3,8 @@ package mock
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
 	"sync"
 
 	"github.com/google/uuid"
@@ -191,8 +193,13 @@ func (s *redisStore) createInstance(ctx context.Context, opts redisinstance.Crea
 	s.m.Lock()
 	defer s.m.Unlock()
 
-	// If ShardCount>0 the caller wants a cluster; route to cluster store.
-	if opts.ShardCount > 0 {
+	// Cluster classes route to the cluster store: non-proxy classes carry an
+	// explicit ShardCount, proxy-based classes encode it in the class name.
+	if opts.ShardCount > 0 || redisinstance.IsProxyClusterClass(opts.InstanceClass) {
+		shardCount := opts.ShardCount
+		if shardCount == 0 {
+			shardCount = shardCountFromInstanceClass(opts.InstanceClass)
+		}
 		id := "r-" + uuid.NewString()[:8]
 		s.clusters[id] = &RedisClusterEntry{
 			InstanceId:       id,
@@ -206,7 +213,7 @@ func (s *redisStore) createInstance(ctx context.Context, opts redisinstance.Crea
 			ChargeType:       redisinstance.ChargeType,
 			Port:             pickPort(opts.Port),
 			ConnectionDomain: fmt.Sprintf("%s.redis.rds.aliyuncs.com", id),
-			ShardCount:       opts.ShardCount,
+			ShardCount:       shardCount,
 			ReplicasPerShard: opts.ReadOnlyCount,
 			Password:         opts.Password,
 		}
@@ -232,6 +239,23 @@ func (s *redisStore) createInstance(ctx context.Context, opts redisinstance.Crea
 	return id, nil
 }
 
+var proxyShardCountRegexp = regexp.MustCompile(`\.(\d+)db\.`)
+
+// shardCountFromInstanceClass extracts the shard count from a proxy-based
+// sharding class such as redis.logic.sharding.4g.8db.0rodb.8proxy.default.
+// Returns 0 when the class does not encode a shard count.
+func shardCountFromInstanceClass(instanceClass string) int32 {
+	m := proxyShardCountRegexp.FindStringSubmatch(instanceClass)
+	if m == nil {
+		return 0
+	}
+	n, err := strconv.ParseInt(m[1], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(n)
+}
+
 func pickPort(p int64) int64 {
 	if p > 0 {
 		return p
@@ -339,6 +363,52 @@ func (s *redisStore) describeInstance(ctx context.Context, instanceId string) (*
 	return nil, nil
 }
 
+// describeInstancesByVpcId returns both standard instances and clusters in the VPC,
+// as the r-kvstore DescribeInstances does.
+func (s *redisStore) describeInstancesByVpcId(ctx context.Context, vpcId string) ([]redisinstance.InstanceInfo, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	var out []redisinstance.InstanceInfo
+	for _, e := range s.instances {
+		if e.VpcId != vpcId {
+			continue
+		}
+		out = append(out, redisinstance.InstanceInfo{
+			InstanceId:       e.InstanceId,
+			InstanceName:     e.InstanceName,
+			InstanceStatus:   e.InstanceStatus,
+			InstanceClass:    e.InstanceClass,
+			ArchitectureType: "standard",
+			NetworkType:      e.NetworkType,
+			VpcId:            e.VpcId,
+			VSwitchId:        e.VSwitchId,
+			EngineVersion:    e.EngineVersion,
+			ChargeType:       e.ChargeType,
+		})
+	}
+	for _, e := range s.clusters {
+		if e.VpcId != vpcId {
+			continue
+		}
+		out = append(out, redisinstance.InstanceInfo{
+			InstanceId:       e.InstanceId,
+			InstanceName:     e.InstanceName,
+			InstanceStatus:   e.InstanceStatus,
+			InstanceClass:    e.InstanceClass,
+			ArchitectureType: "cluster",
+			NetworkType:      e.NetworkType,
+			VpcId:            e.VpcId,
+			VSwitchId:        e.VSwitchId,
+			EngineVersion:    e.EngineVersion,
+			ChargeType:       e.ChargeType,
+		})
+	}
+	return out, nil
+}
+
 func (s *redisStore) modifyInstanceSpec(ctx context.Context, instanceId string, opts redisinstance.ModifyInstanceSpecOptions) error {
 	if isContextCanceled(ctx) {
 		return context.Canceled
@@ -364,6 +434,9 @@ func (s *redisStore) modifyInstanceSpec(ctx context.Context, instanceId string,
 	if e := s.clusters[instanceId]; e != nil {
 		if opts.InstanceClass != "" {
 			e.InstanceClass = opts.InstanceClass
+			if n := shardCountFromInstanceClass(opts.InstanceClass); n > 0 {
+				e.ShardCount = n
+			}
 		}
 		if opts.ShardCount > 0 {
 			e.ShardCount = opts.ShardCount
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	alicloudiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/iprange/client"
 	alicloudnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nfsinstance/client"
+	alicloudnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke/client"
 	alicloudredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/rediscluster/client"
 	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
 	alicloudvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/vpcnetwork/client"
@@ -134,6 +135,19 @@ func (s *server) RedisClusterClientProvider() alicloudredisclusterclient.ClientP
 	}
 }
 
+func (s *server) NukeClientProvider() alicloudnukeclient.ClientProvider {
+	return func(ctx context.Context, region, accessKeyId, accessKeySecret string) (alicloudnukeclient.Client, error) {
+		a, err := s.Login(accessKeyId, accessKeySecret)
+		if err != nil {
+			a = s.firstAccount()
+		}
+		if a == nil {
+			return nil, ErrInvalidCredentials
+		}
+		return a.Region(region).NukeClient(), nil
+	}
+}
+
 func (s *server) firstAccount() Account {
 	s.m.Lock()
 	defer s.m.Unlock()
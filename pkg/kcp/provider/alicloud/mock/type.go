@@ -3,6 +3,7 @@ package mock
 import (
 	alicloudiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/iprange/client"
 	alicloudnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nfsinstance/client"
+	alicloudnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke/client"
 	alicloudredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/rediscluster/client"
 	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
 	alicloudvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/vpcnetwork/client"
@@ -21,6 +22,10 @@ type VpcConfig interface {
 type NasConfig interface {
 	AddNasFileSystem(id, protocolType, storageType, zoneId string) *NasFileSystemEntry
 	SetNasFileSystemError(fileSystemId string, err error)
+	// SetNasFileSystemMeteredSize sets the used size in bytes reported for the file system.
+	SetNasFileSystemMeteredSize(fileSystemId string, meteredSize int64)
+	// SetNasFileSystemDescription sets the description reported for the file system.
+	SetNasFileSystemDescription(fileSystemId string, description string)
 }
 
 // RedisInstanceConfig is the test-side seeding API for AliCloud r-kvstore
@@ -30,6 +35,7 @@ type RedisInstanceConfig interface {
 	SetRedisInstanceError(instanceId string, err error)
 	// GetRedisInstance returns the stored entry for instanceId, or nil if not found.
 	GetRedisInstance(instanceId string) *RedisInstanceEntry
+	SetRedisInstance(instanceId string, mutate func(*RedisInstanceEntry))
 }
 
 // RedisClusterConfig is the test-side seeding API for AliCloud r-kvstore
@@ -40,6 +46,7 @@ type RedisClusterConfig interface {
 	SetRedisClusterError(instanceId string, err error)
 	// GetRedisCluster returns the stored entry for instanceId, or nil if not found.
 	GetRedisCluster(instanceId string) *RedisClusterEntry
+	SetRedisCluster(instanceId string, mutate func(*RedisClusterEntry))
 }
 
 // Configs aggregates all test-side seeding interfaces.
@@ -59,6 +66,7 @@ type AccountRegion interface {
 	NfsInstanceClient() alicloudnfsinstanceclient.Client
 	RedisInstanceClient() alicloudredisinstanceclient.Client
 	RedisClusterClient() alicloudredisclusterclient.Client
+	NukeClient() alicloudnukeclient.Client
 
 	Region() string
 
@@ -88,6 +96,7 @@ type Providers interface {
 	NfsInstanceClientProvider() alicloudnfsinstanceclient.ClientProvider
 	RedisInstanceClientProvider() alicloudredisinstanceclient.ClientProvider
 	RedisClusterClientProvider() alicloudredisclusterclient.ClientProvider
+	NukeClientProvider() alicloudnukeclient.ClientProvider
 }
 
 // Server is the top-level mock - owns accounts and yields providers.
@@ -8,6 +8,7 @@ import (
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	nas "github.com/alibabacloud-go/nas-20170626/v3/client"
 	"github.com/alibabacloud-go/tea/tea"
+	alicloudmetrics "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/metrics"
 )
 
 // FileSystemInfo is a provider-agnostic view of an AliCloud NAS file system.
@@ -18,6 +19,7 @@ type FileSystemInfo struct {
 	StorageType    string
 	Status         string
 	ZoneId         string
+	Description    string
 	// MeteredSize is the used size in bytes; Capacity is the provisioned size in GiB.
 	MeteredSize int64
 	Capacity    int64
@@ -43,7 +45,7 @@ type AccessGroupInfo struct {
 type Client interface {
 	// File system
 	DescribeFileSystem(ctx context.Context, fileSystemId string) (*FileSystemInfo, error)
-	CreateFileSystem(ctx context.Context, protocolType, storageType, zoneId string) (string, error)
+	CreateFileSystem(ctx context.Context, protocolType, storageType, zoneId, description string) (string, error)
 	DeleteFileSystem(ctx context.Context, fileSystemId string) error
 
 	// Mount targets
@@ -69,6 +71,7 @@ func NewClientProvider() ClientProvider {
 			AccessKeyId:     new(accessKeyId),
 			AccessKeySecret: new(accessKeySecret),
 			RegionId:        new(region),
+			HttpClient:      alicloudmetrics.NewMetricsHttpClient(region),
 		}
 		config.Endpoint = new(fmt.Sprintf("nas.%s.aliyuncs.com", region))
 
@@ -143,17 +146,19 @@ func (c *alicloudClient) DescribeFileSystem(ctx context.Context, fileSystemId st
 		StorageType:    tea.StringValue(fs.StorageType),
 		Status:         tea.StringValue(fs.Status),
 		ZoneId:         tea.StringValue(fs.ZoneId),
+		Description:    tea.StringValue(fs.Description),
 		MeteredSize:    tea.Int64Value(fs.MeteredSize),
 		Capacity:       tea.Int64Value(fs.Capacity),
 	}, nil
 }
 
-func (c *alicloudClient) CreateFileSystem(ctx context.Context, protocolType, storageType, zoneId string) (string, error) {
+func (c *alicloudClient) CreateFileSystem(ctx context.Context, protocolType, storageType, zoneId, description string) (string, error) {
 	req := &nas.CreateFileSystemRequest{
 		FileSystemType: new(fileSystemType),
 		ProtocolType:   new(protocolType),
 		StorageType:    new(storageType),
 		ChargeType:     new("PayAsYouGo"),
+		Description:    new(description),
 	}
 	if zoneId != "" {
 		req.ZoneId = new(zoneId)
@@ -142,7 +142,7 @@ func TestAlicloudNasFileSystemLifecycle(t *testing.T) {
 
 	// Create file system
 	t.Log("Creating NAS file system ...")
-	fsId, err := client.CreateFileSystem(ctx, "NFS", "Performance", "")
+	fsId, err := client.CreateFileSystem(ctx, "NFS", "Performance", "", "cloud-manager-integration-test")
 	if err != nil {
 		t.Fatalf("CreateFileSystem failed: %v", err)
 	}
@@ -254,7 +254,7 @@ func TestAlicloudNasMountTargetLifecycle(t *testing.T) {
 	t.Logf("Access rules: %v", rules)
 
 	// File system
-	fsId, err := client.CreateFileSystem(ctx, "NFS", "Performance", "")
+	fsId, err := client.CreateFileSystem(ctx, "NFS", "Performance", "", "cloud-manager-integration-test")
 	if err != nil {
 		t.Fatalf("CreateFileSystem failed: %v", err)
 	}
@@ -5,6 +5,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	alicloudutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -39,7 +40,10 @@ func createFileSystem(ctx context.Context, st composed.State) (error, context.Co
 
 	logger.Info("Creating AliCloud NAS file system", "protocolType", protocolType, "storageType", storageType, "zoneId", zoneId)
 
-	fsId, err := state.client.CreateFileSystem(ctx, protocolType, storageType, zoneId)
+	// the description tells the file systems of cloud-manager apart from the ones of the customer in the same VPC
+	description := alicloudutil.CloudManagerResourceName(state.ObjAsNfsInstance().Name)
+
+	fsId, err := state.client.CreateFileSystem(ctx, protocolType, storageType, zoneId, description)
 	if err != nil {
 		logger.Error(err, "Error creating AliCloud NAS file system")
 		state.ObjAsNfsInstance().Status.State = cloudcontrolv1beta1.StateError
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
-// loadFileSystem loads the NAS file system referenced by Status.Id into state, if it exists.
+// loadFileSystem loads the NAS file system referenced by Status.Id into state, if it exists,
+// and reports its metered size as the NfsInstance capacity.
 func loadFileSystem(ctx context.Context, st composed.State) (error, context.Context) {
 	state := st.(*State)
 	logger := composed.LoggerFromCtx(ctx)
@@ -22,9 +24,20 @@ func loadFileSystem(ctx context.Context, st composed.State) (error, context.Cont
 		return composed.StopWithRequeue, ctx
 	}
 
-	if fs != nil {
-		state.fileSystem = fs
-		state.fileSystemId = fs.FileSystemId
+	if fs == nil {
+		return nil, ctx
+	}
+
+	state.fileSystem = fs
+	state.fileSystemId = fs.FileSystemId
+
+	// general-purpose NAS grows elastically, so the used size is the only meaningful capacity
+	capacity := resource.NewQuantity(fs.MeteredSize, resource.BinarySI)
+	if !capacity.Equal(state.ObjAsNfsInstance().Status.Capacity) {
+		state.ObjAsNfsInstance().Status.Capacity = *capacity
+		if err := state.PatchObjStatus(ctx); err != nil {
+			return composed.LogErrorAndReturn(err, "Error patching AliCloud NfsInstance status with capacity", composed.StopWithRequeue, ctx)
+		}
 	}
 
 	return nil, ctx
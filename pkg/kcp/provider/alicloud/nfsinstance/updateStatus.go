@@ -5,12 +5,14 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	alicloudconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/config"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // updateStatus sets the NfsInstance Host/Path/Id and the Ready condition once the NAS file
-// system and its mount target are available.
+// system and its mount target are available. Ready instances are requeued so loadFileSystem
+// keeps the reported capacity current.
 func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
 	state := st.(*State)
 
@@ -29,7 +31,7 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 		state.ObjAsNfsInstance().Status.Host == host &&
 		meta.IsStatusConditionTrue(*state.ObjAsNfsInstance().Conditions(), cloudcontrolv1beta1.ConditionTypeReady) {
 		// already set and saved
-		return nil, ctx
+		return composed.StopWithRequeueDelay(alicloudconfig.AlicloudConfig.NasCapacityCheckInterval), ctx
 	}
 
 	state.ObjAsNfsInstance().Status.Id = state.fileSystemId
@@ -45,6 +47,6 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 		}).
 		ErrorLogMessage("Error updating AliCloud KCP NfsInstance status after setting Ready condition").
 		SuccessLogMsg("AliCloud KCP NfsInstance is ready").
-		SuccessError(composed.StopAndForget).
+		SuccessError(composed.StopWithRequeueDelay(alicloudconfig.AlicloudConfig.NasCapacityCheckInterval)).
 		Run(ctx, state)
 }
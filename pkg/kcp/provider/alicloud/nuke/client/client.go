@@ -0,0 +1,171 @@
+// Package client wraps the AliCloud NAS and r-kvstore SDKs for the KCP Nuke
+// reconciler. Unlike the per-kind clients, which address a single resource by
+// id, the nuke client discovers resources by the VPC of the Scope, since
+// neither NAS file systems nor r-kvstore instances carry a reference to the
+// Scope that created them. The callers tell the resources of cloud-manager
+// apart by the NAS description and r-kvstore instance name.
+package client
+
+import (
+	"context"
+	"fmt"
+
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	nas "github.com/alibabacloud-go/nas-20170626/v3/client"
+	rkvstore "github.com/alibabacloud-go/r-kvstore-20150101/v7/client"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudmetrics "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/metrics"
+	alicloudnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nfsinstance/client"
+	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
+)
+
+type Client interface {
+	// DescribeFileSystemsByVpcId returns NAS file systems with a mount target in the given VPC.
+	DescribeFileSystemsByVpcId(ctx context.Context, vpcId string) ([]alicloudnfsinstanceclient.FileSystemInfo, error)
+	DescribeFileSystem(ctx context.Context, fileSystemId string) (*alicloudnfsinstanceclient.FileSystemInfo, error)
+	DescribeMountTargets(ctx context.Context, fileSystemId string) ([]alicloudnfsinstanceclient.MountTargetInfo, error)
+	DeleteMountTarget(ctx context.Context, fileSystemId, mountTargetDomain string) error
+	DeleteFileSystem(ctx context.Context, fileSystemId string) error
+
+	// DescribeInstancesByVpcId returns r-kvstore instances, both standard and cluster, in the given VPC.
+	DescribeInstancesByVpcId(ctx context.Context, vpcId string) ([]alicloudredisinstanceclient.InstanceInfo, error)
+	DeleteInstance(ctx context.Context, instanceId string) error
+}
+
+type ClientProvider func(ctx context.Context, region, accessKeyId, accessKeySecret string) (Client, error)
+
+func NewClientProvider() ClientProvider {
+	return func(ctx context.Context, region, accessKeyId, accessKeySecret string) (Client, error) {
+		nasConfig := &openapi.Config{
+			AccessKeyId:     new(accessKeyId),
+			AccessKeySecret: new(accessKeySecret),
+			RegionId:        new(region),
+			HttpClient:      alicloudmetrics.NewMetricsHttpClient(region),
+		}
+		nasConfig.Endpoint = new(fmt.Sprintf("nas.%s.aliyuncs.com", region))
+
+		nasClient, err := nas.NewClient(nasConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error creating alicloud nas client: %w", err)
+		}
+
+		rkvConfig := &openapi.Config{
+			AccessKeyId:     new(accessKeyId),
+			AccessKeySecret: new(accessKeySecret),
+			RegionId:        new(region),
+			HttpClient:      alicloudmetrics.NewMetricsHttpClient(region),
+		}
+		rkvConfig.Endpoint = new(fmt.Sprintf("r-kvstore.%s.aliyuncs.com", region))
+
+		rkvClient, err := rkvstore.NewClient(rkvConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error creating alicloud r-kvstore client: %w", err)
+		}
+
+		nfsProvider := alicloudnfsinstanceclient.NewClientProvider()
+		nfsClient, err := nfsProvider(ctx, region, accessKeyId, accessKeySecret)
+		if err != nil {
+			return nil, err
+		}
+
+		return &alicloudClient{
+			Client:      nfsClient,
+			nasClient:   nasClient,
+			rkvClient:   rkvClient,
+			redisClient: alicloudredisinstanceclient.NewClientFromSDK(rkvClient, region),
+			region:      region,
+		}, nil
+	}
+}
+
+const pageSize = int32(50)
+
+var _ Client = (*alicloudClient)(nil)
+
+type alicloudClient struct {
+	// mount target and file system operations are the same as of the nfsinstance client
+	alicloudnfsinstanceclient.Client
+
+	nasClient   *nas.Client
+	rkvClient   *rkvstore.Client
+	redisClient alicloudredisinstanceclient.Client
+	region      string
+}
+
+func (c *alicloudClient) DescribeFileSystemsByVpcId(ctx context.Context, vpcId string) ([]alicloudnfsinstanceclient.FileSystemInfo, error) {
+	var result []alicloudnfsinstanceclient.FileSystemInfo
+	pageNum := int32(1)
+	for {
+		resp, err := c.nasClient.DescribeFileSystems(&nas.DescribeFileSystemsRequest{
+			VpcId:      new(vpcId),
+			PageSize:   new(pageSize),
+			PageNumber: new(pageNum),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error describing alicloud nas file systems in vpc %s: %w", vpcId, err)
+		}
+		if resp.Body == nil || resp.Body.FileSystems == nil {
+			break
+		}
+		for _, fs := range resp.Body.FileSystems.FileSystem {
+			result = append(result, alicloudnfsinstanceclient.FileSystemInfo{
+				FileSystemId:   tea.StringValue(fs.FileSystemId),
+				FileSystemType: tea.StringValue(fs.FileSystemType),
+				ProtocolType:   tea.StringValue(fs.ProtocolType),
+				StorageType:    tea.StringValue(fs.StorageType),
+				Status:         tea.StringValue(fs.Status),
+				ZoneId:         tea.StringValue(fs.ZoneId),
+				Description:    tea.StringValue(fs.Description),
+				MeteredSize:    tea.Int64Value(fs.MeteredSize),
+				Capacity:       tea.Int64Value(fs.Capacity),
+			})
+		}
+		if resp.Body.TotalCount == nil || pageNum*pageSize >= tea.Int32Value(resp.Body.TotalCount) {
+			break
+		}
+		pageNum++
+	}
+	return result, nil
+}
+
+func (c *alicloudClient) DescribeInstancesByVpcId(ctx context.Context, vpcId string) ([]alicloudredisinstanceclient.InstanceInfo, error) {
+	var result []alicloudredisinstanceclient.InstanceInfo
+	pageNum := int32(1)
+	for {
+		resp, err := c.rkvClient.DescribeInstances(&rkvstore.DescribeInstancesRequest{
+			RegionId:   new(c.region),
+			VpcId:      new(vpcId),
+			PageSize:   new(pageSize),
+			PageNumber: new(pageNum),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error describing alicloud r-kvstore instances in vpc %s: %w", vpcId, err)
+		}
+		if resp == nil || resp.Body == nil || resp.Body.Instances == nil {
+			break
+		}
+		for _, inst := range resp.Body.Instances.KVStoreInstance {
+			result = append(result, alicloudredisinstanceclient.InstanceInfo{
+				InstanceId:       tea.StringValue(inst.InstanceId),
+				InstanceName:     tea.StringValue(inst.InstanceName),
+				InstanceStatus:   tea.StringValue(inst.InstanceStatus),
+				InstanceClass:    tea.StringValue(inst.InstanceClass),
+				ArchitectureType: tea.StringValue(inst.ArchitectureType),
+				NetworkType:      tea.StringValue(inst.NetworkType),
+				VpcId:            tea.StringValue(inst.VpcId),
+				VSwitchId:        tea.StringValue(inst.VSwitchId),
+				EngineVersion:    tea.StringValue(inst.EngineVersion),
+				ChargeType:       tea.StringValue(inst.ChargeType),
+			})
+		}
+		if resp.Body.TotalCount == nil || pageNum*pageSize >= tea.Int32Value(resp.Body.TotalCount) {
+			break
+		}
+		pageNum++
+	}
+	return result, nil
+}
+
+func (c *alicloudClient) DeleteInstance(ctx context.Context, instanceId string) error {
+	return c.redisClient.DeleteInstance(ctx, instanceId)
+}
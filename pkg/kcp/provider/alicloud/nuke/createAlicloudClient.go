@@ -0,0 +1,26 @@
+package nuke
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	alicloudconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/config"
+)
+
+func createAlicloudClient(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	cli, err := state.clientProvider(
+		ctx,
+		state.Scope().Spec.Region,
+		alicloudconfig.AlicloudConfig.AccessKeyId,
+		alicloudconfig.AlicloudConfig.AccessKeySecret,
+	)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating Alicloud client", composed.StopWithRequeue, ctx)
+	}
+
+	state.client = cli
+
+	return nil, ctx
+}
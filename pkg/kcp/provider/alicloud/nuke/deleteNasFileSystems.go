@@ -0,0 +1,53 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+const nasStatusDeleting = "Deleting"
+
+// deleteNasFileSystems deletes the mount targets of each file system first, since NAS refuses
+// to delete a file system that still has them. The file system itself is deleted in a later
+// reconciliation, once its mount targets are gone.
+func deleteNasFileSystems(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind != kindAlicloudNasFileSystem || rks.Provider != cloudcontrolv1beta1.ProviderAlicloud {
+			continue
+		}
+		for _, obj := range rks.Objects {
+			fs := obj.(AlicloudNasFileSystem)
+			if fs.Status == nasStatusDeleting {
+				continue
+			}
+
+			mountTargets, err := state.client.DescribeMountTargets(ctx, fs.FileSystemId)
+			if err != nil {
+				logger.Error(err, fmt.Sprintf("Error listing Alicloud NAS mount targets of %s", fs.GetId()))
+				continue
+			}
+			if len(mountTargets) > 0 {
+				for _, mt := range mountTargets {
+					if mt.Status == nasStatusDeleting {
+						continue
+					}
+					if err := state.client.DeleteMountTarget(ctx, fs.FileSystemId, mt.MountTargetDomain); err != nil {
+						logger.Error(err, fmt.Sprintf("Error requesting Alicloud NAS mount target deletion %s", mt.MountTargetDomain))
+					}
+				}
+				continue
+			}
+
+			if err := state.client.DeleteFileSystem(ctx, fs.FileSystemId); err != nil {
+				logger.Error(err, fmt.Sprintf("Error requesting Alicloud NAS file system deletion %s", fs.GetId()))
+			}
+		}
+	}
+	return nil, ctx
+}
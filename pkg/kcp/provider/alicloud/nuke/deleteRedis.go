@@ -0,0 +1,32 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
+)
+
+func deleteRedis(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind != kindAlicloudRedis || rks.Provider != cloudcontrolv1beta1.ProviderAlicloud {
+			continue
+		}
+		for _, obj := range rks.Objects {
+			inst := obj.(AlicloudRedis)
+			if inst.InstanceStatus == alicloudredisinstanceclient.InstanceStatusInactive {
+				// deletion already requested, the instance is being released
+				continue
+			}
+			if err := state.client.DeleteInstance(ctx, inst.InstanceId); err != nil {
+				logger.Error(err, fmt.Sprintf("Error requesting Alicloud Redis instance deletion %s", inst.GetId()))
+			}
+		}
+	}
+	return nil, ctx
+}
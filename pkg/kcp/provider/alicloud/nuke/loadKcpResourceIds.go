@@ -0,0 +1,54 @@
+package nuke
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func loadKcpResourceIds(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	state.kcpResourceIds = map[string]struct{}{}
+	namespace := client.InNamespace(state.ObjAsNuke().Namespace)
+	scopeName := state.Scope().Name
+
+	nfsList := &cloudcontrolv1beta1.NfsInstanceList{}
+	if err := state.Cluster().K8sClient().List(ctx, nfsList, namespace); err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing KCP NfsInstances", composed.StopWithRequeue, ctx)
+	}
+	for _, obj := range nfsList.Items {
+		if obj.Spec.Scope.Name == scopeName && obj.Status.Id != "" {
+			state.kcpResourceIds[obj.Status.Id] = struct{}{}
+		}
+	}
+
+	redisInstanceList := &cloudcontrolv1beta1.RedisInstanceList{}
+	if err := state.Cluster().K8sClient().List(ctx, redisInstanceList, namespace); err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing KCP RedisInstances", composed.StopWithRequeue, ctx)
+	}
+	for _, obj := range redisInstanceList.Items {
+		if obj.Spec.Scope.Name == scopeName && obj.Status.Id != "" {
+			state.kcpResourceIds[obj.Status.Id] = struct{}{}
+		}
+	}
+
+	redisClusterList := &cloudcontrolv1beta1.RedisClusterList{}
+	if err := state.Cluster().K8sClient().List(ctx, redisClusterList, namespace); err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing KCP RedisClusters", composed.StopWithRequeue, ctx)
+	}
+	for _, obj := range redisClusterList.Items {
+		if obj.Spec.Scope.Name == scopeName && obj.Status.Id != "" {
+			state.kcpResourceIds[obj.Status.Id] = struct{}{}
+		}
+	}
+
+	return nil, ctx
+}
+
+func (s *State) isKcpResource(id string) bool {
+	_, ok := s.kcpResourceIds[id]
+	return ok
+}
@@ -0,0 +1,79 @@
+package nuke
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadNasFileSystems loads NAS file systems created by cloud-manager and mounted in the Scope VPC.
+// The file systems of the customer in the same VPC do not have the cloud-manager description and are left alone.
+// Once deleteNasFileSystems removes the mount targets, a file system is no longer in the VPC, so the ones
+// already recorded in the Nuke status and not yet deleted are described by id.
+func loadNasFileSystems(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	fileSystems, err := state.client.DescribeFileSystemsByVpcId(ctx, state.VpcId())
+	if err != nil {
+		return loadNasFileSystemsError(ctx, state, err)
+	}
+
+	found := map[string]struct{}{}
+	for _, fs := range fileSystems {
+		found[fs.FileSystemId] = struct{}{}
+	}
+	if sk := state.ObjAsNuke().Status.GetKindNoCreate(kindAlicloudNasFileSystem); sk != nil {
+		for id, status := range sk.Objects {
+			if _, ok := found[id]; ok || status == cloudcontrolv1beta1.NukeResourceStatusDeleted {
+				continue
+			}
+			fs, err := state.client.DescribeFileSystem(ctx, id)
+			if err != nil {
+				return loadNasFileSystemsError(ctx, state, err)
+			}
+			if fs != nil {
+				fileSystems = append(fileSystems, *fs)
+			}
+		}
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, fs := range fileSystems {
+		if !alicloud.IsCloudManagerResourceName(fs.Description) {
+			continue
+		}
+		if state.isKcpResource(fs.FileSystemId) {
+			continue
+		}
+		objects = append(objects, AlicloudNasFileSystem{&fs})
+	}
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     kindAlicloudNasFileSystem,
+		Provider: cloudcontrolv1beta1.ProviderAlicloud,
+		Objects:  objects,
+	})
+	return nil, ctx
+}
+
+func loadNasFileSystemsError(ctx context.Context, state *State, err error) (error, context.Context) {
+	composed.LoggerFromCtx(ctx).Error(err, "Error listing Alicloud NAS file systems")
+
+	state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+	return composed.PatchStatus(state.ObjAsNuke()).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  "ErrorListingAlicloudNasFileSystems",
+			Message: err.Error(),
+		}).
+		ErrorLogMessage("Error patching KCP Nuke status after list Alicloud NAS file systems error").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,60 @@
+package nuke
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud"
+	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadRedis loads r-kvstore instances, both standard and cluster, created by cloud-manager in the Scope VPC.
+// The instances of the customer in the same VPC do not have the cloud-manager name and are left alone.
+func loadRedis(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	instances, err := state.client.DescribeInstancesByVpcId(ctx, state.VpcId())
+	if err != nil {
+		logger.Error(err, "Error listing Alicloud Redis instances")
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorListingAlicloudRedis",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after list Alicloud Redis instances error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, inst := range instances {
+		// released instances are gone for good, they are only listed until AliCloud purges them
+		if inst.InstanceStatus == alicloudredisinstanceclient.InstanceStatusReleased {
+			continue
+		}
+		if !alicloud.IsCloudManagerResourceName(inst.InstanceName) {
+			continue
+		}
+		if state.isKcpResource(inst.InstanceId) {
+			continue
+		}
+		objects = append(objects, AlicloudRedis{&inst})
+	}
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     kindAlicloudRedis,
+		Provider: cloudcontrolv1beta1.ProviderAlicloud,
+		Objects:  objects,
+	})
+	return nil, ctx
+}
@@ -0,0 +1,80 @@
+package nuke
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	alicloudnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nfsinstance/client"
+	alicloudnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/nuke/client"
+	alicloudredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
+)
+
+// Nuke ProviderResourceKindState.Kind values for Alicloud, shared by the load (producer)
+// and delete (consumer) actions to prevent drift.
+const (
+	kindAlicloudNasFileSystem = "AlicloudNasFileSystem"
+	kindAlicloudRedis         = "AlicloudRedis"
+)
+
+type StateFactory interface {
+	NewState(ctx context.Context, nukeState nuketypes.State) (focal.State, error)
+}
+
+func NewStateFactory(clientProvider alicloudnukeclient.ClientProvider) StateFactory {
+	return stateFactory{
+		clientProvider: clientProvider,
+	}
+}
+
+type stateFactory struct {
+	clientProvider alicloudnukeclient.ClientProvider
+}
+
+func (f stateFactory) NewState(ctx context.Context, nukeState nuketypes.State) (focal.State, error) {
+	return &State{
+		State:          nukeState,
+		clientProvider: f.clientProvider,
+	}, nil
+}
+
+type State struct {
+	nuketypes.State
+	ProviderResources []*nuketypes.ProviderResourceKindState
+
+	clientProvider alicloudnukeclient.ClientProvider
+	client         alicloudnukeclient.Client
+
+	// kcpResourceIds are cloud resource ids still owned by KCP objects of the Scope. The KCP
+	// Nuke deletes those objects and their reconcilers delete the cloud resources, so they
+	// are not orphans and are excluded from the provider resources.
+	kcpResourceIds map[string]struct{}
+}
+
+func (s *State) VpcId() string {
+	return s.Scope().Spec.Scope.Alicloud.Network.VPC.Id
+}
+
+type AlicloudNasFileSystem struct {
+	*alicloudnfsinstanceclient.FileSystemInfo
+}
+
+func (f AlicloudNasFileSystem) GetId() string {
+	return f.FileSystemId
+}
+
+func (f AlicloudNasFileSystem) GetObject() any {
+	return f.FileSystemInfo
+}
+
+type AlicloudRedis struct {
+	*alicloudredisinstanceclient.InstanceInfo
+}
+
+func (r AlicloudRedis) GetId() string {
+	return r.InstanceId
+}
+
+func (r AlicloudRedis) GetObject() any {
+	return r.InstanceInfo
+}
@@ -20,6 +20,7 @@ import (
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	rkvstore "github.com/alibabacloud-go/r-kvstore-20150101/v7/client"
 
+	alicloudmetrics "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/metrics"
 	instanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
 )
 
@@ -51,6 +52,7 @@ func NewClientProvider() ClientProvider {
 			AccessKeyId:     new(accessKeyId),
 			AccessKeySecret: new(accessKeySecret),
 			RegionId:        new(region),
+			HttpClient:      alicloudmetrics.NewMetricsHttpClient(region),
 		}
 		config.Endpoint = new(fmt.Sprintf("r-kvstore.%s.aliyuncs.com", region))
 
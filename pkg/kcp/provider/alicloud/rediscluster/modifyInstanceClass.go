@@ -0,0 +1,55 @@
+package rediscluster
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	alicloudclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+// modifyInstanceClass issues a ModifyInstanceSpec if the desired InstanceClass
+// or ReplicasPerShard drift from the observed state. Per issue #2012 design
+// decision 8, InstanceClass changes must not be combined with ShardCount
+// changes in the same call - this action never touches ShardCount, that is
+// handled by modifyShardCount. For proxy-based classes the shard count is part
+// of the class name, so a shard resize arrives here as a class change.
+func modifyInstanceClass(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.instance == nil {
+		return nil, ctx
+	}
+	kcp := state.ObjAsRedisCluster()
+	if kcp.Spec.Instance.Alicloud == nil {
+		return nil, ctx
+	}
+	desiredClass := kcp.Spec.Instance.Alicloud.InstanceClass
+	desiredReplicas := kcp.Spec.Instance.Alicloud.ReplicasPerShard
+
+	classDrift := desiredClass != "" && desiredClass != state.instance.InstanceClass
+	// Proxy-based classes always have ReadOnlyCount 0 and reject any attempt to
+	// change it, so replica drift is only meaningful for non-proxy classes.
+	replicaDrift := !alicloudclient.IsProxyClusterClass(state.instance.InstanceClass) &&
+		desiredReplicas != state.instance.ReadOnlyCount
+	if !classDrift && !replicaDrift {
+		return nil, ctx
+	}
+
+	opts := alicloudclient.ModifyInstanceSpecOptions{}
+	if classDrift {
+		opts.InstanceClass = desiredClass
+	}
+	if replicaDrift {
+		opts.ReadOnlyCount = new(desiredReplicas)
+	}
+
+	if err := state.client.ModifyInstanceSpec(ctx, state.instance.InstanceId, opts); err != nil {
+		return composed.LogErrorAndReturn(err,
+			"Error modifying AliCloud r-kvstore cluster spec",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
+	}
+
+	// Force re-load next reconcile.
+	state.instance = nil
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
+}
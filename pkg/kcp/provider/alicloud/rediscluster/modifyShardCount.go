@@ -0,0 +1,49 @@
+package rediscluster
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	alicloudclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+// modifyShardCount grows or shrinks a non-proxy cluster to the desired
+// ShardCount via AddShardingNode / DeleteShardingNode. Proxy-based classes
+// encode the shard count in the class name and are resized by
+// modifyInstanceClass instead.
+func modifyShardCount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.instance == nil {
+		return nil, ctx
+	}
+	kcp := state.ObjAsRedisCluster()
+	if kcp.Spec.Instance.Alicloud == nil {
+		return nil, ctx
+	}
+	if alicloudclient.IsProxyClusterClass(state.instance.InstanceClass) {
+		return nil, ctx
+	}
+
+	desired := kcp.Spec.Instance.Alicloud.ShardCount
+	current := state.instance.ShardCount
+	if desired == 0 || desired == current {
+		return nil, ctx
+	}
+
+	var err error
+	if desired > current {
+		err = state.client.AddShardingNode(ctx, state.instance.InstanceId, desired)
+	} else {
+		err = state.client.DeleteShardingNode(ctx, state.instance.InstanceId, desired)
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err,
+			"Error modifying AliCloud r-kvstore cluster shard count",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
+	}
+
+	// Force re-load next reconcile.
+	state.instance = nil
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
+}
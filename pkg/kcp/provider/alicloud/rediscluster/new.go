@@ -0,0 +1,66 @@
+package rediscluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/rediscluster/types"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		logger := composed.LoggerFromCtx(ctx)
+		shared := st.(types.State)
+		state, err := stateFactory.NewState(ctx, shared)
+		if err != nil {
+			err = fmt.Errorf("error creating new alicloud rediscluster state: %w", err)
+			logger.Error(err, "Error")
+			return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
+		}
+
+		return composed.ComposeActionsNoName(
+			actions.AddCommonFinalizer(),
+			loadRedis,
+			// Sync the Updating condition immediately after loading the instance so
+			// that a Changing state (set by a prior modify step) is reflected in the
+			// KCP status before the reconcile blocks on waitRedisAvailable.
+			addUpdatingCondition,
+
+			// delete ================================================================================
+			composed.If(composed.MarkedForDeletionPredicate,
+				composed.ComposeActionsNoName(
+					removeReadyCondition,
+					// Wait for Normal before deleting: AliCloud rejects DeleteInstance
+					// while the instance is still Creating or Changing.
+					waitRedisAvailable,
+					deleteRedis,
+					waitRedisDeleted,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+
+			// create/update =========================================================================
+			composed.If(composed.NotMarkedForDeletionPredicate,
+				composed.ComposeActionsNoName(
+					createRedis,
+					waitRedisAvailable,
+					setSecurityIps,
+					enableSsl,
+					// InstanceClass and ShardCount changes are issued in separate
+					// calls (design decision 8), each followed by a wait for Normal.
+					modifyInstanceClass,
+					waitRedisAvailable,
+					modifyShardCount,
+					waitRedisAvailable,
+					updateStatus,
+				),
+			),
+
+			composed.StopAndForgetAction,
+		)(ctx, state)
+	}
+}
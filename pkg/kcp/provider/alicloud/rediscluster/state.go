@@ -0,0 +1,56 @@
+package rediscluster
+
+import (
+	"context"
+	"fmt"
+
+	alicloudconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/config"
+	alicloudclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/rediscluster/client"
+	alicloudclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/rediscluster/types"
+)
+
+// State is the per-reconcile state for the KCP AliCloud RedisCluster
+// pipeline. It embeds the shared rediscluster types.State (which exposes the
+// Scope, IpRange, and KCP RedisCluster object) and augments it with:
+//
+//   - the AliCloud r-kvstore cluster client, credential- and region-scoped
+//   - a cached DescribeInstance snapshot (nil until loadRedis populates it)
+type State struct {
+	types.State
+
+	client   alicloudclusterclient.Client
+	instance *alicloudclient.InstanceInfo
+}
+
+// StateFactory constructs per-reconcile State instances.
+type StateFactory interface {
+	NewState(ctx context.Context, redisClusterState types.State) (*State, error)
+}
+
+// NewStateFactory returns a StateFactory backed by the given ClientProvider.
+// In production this is alicloudclusterclient.NewClientProvider(); in tests it is
+// the mock server's RedisClusterClientProvider().
+func NewStateFactory(clientProvider alicloudclusterclient.ClientProvider) StateFactory {
+	return &stateFactory{clientProvider: clientProvider}
+}
+
+type stateFactory struct {
+	clientProvider alicloudclusterclient.ClientProvider
+}
+
+func (f *stateFactory) NewState(ctx context.Context, redisClusterState types.State) (*State, error) {
+	accessKeyId := alicloudconfig.AlicloudConfig.AccessKeyId
+	accessKeySecret := alicloudconfig.AlicloudConfig.AccessKeySecret
+	region := redisClusterState.Scope().Spec.Region
+
+	c, err := f.clientProvider(ctx, region, accessKeyId, accessKeySecret)
+	if err != nil {
+		return nil, fmt.Errorf("error creating alicloud rediscluster client: %w", err)
+	}
+
+	return &State{
+		State:  redisClusterState,
+		client: c,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	rkvstore "github.com/alibabacloud-go/r-kvstore-20150101/v7/client"
 	"github.com/alibabacloud-go/tea/tea"
+	alicloudmetrics "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/metrics"
 )
 
 // Instance status values used by the AliCloud r-kvstore API. Only a subset is
@@ -51,6 +52,7 @@ const DefaultPort int64 = 6379
 // DescribeInstanceAttribute.
 type InstanceInfo struct {
 	InstanceId       string
+	InstanceName     string
 	InstanceStatus   string
 	InstanceClass    string
 	ArchitectureType string
@@ -219,6 +221,7 @@ func NewClientProvider() ClientProvider {
 			AccessKeyId:     new(accessKeyId),
 			AccessKeySecret: new(accessKeySecret),
 			RegionId:        new(region),
+			HttpClient:      alicloudmetrics.NewMetricsHttpClient(region),
 		}
 		config.Endpoint = new(fmt.Sprintf("r-kvstore.%s.aliyuncs.com", region))
 
@@ -87,7 +87,7 @@ func createRedis(ctx context.Context, st composed.State) (error, context.Context
 		tokenHash := fmt.Sprintf("%x", sha256.Sum256([]byte(tokenInput)))[:32]
 
 		opts := alicloudclient.CreateInstanceOptions{
-			InstanceName:  kcp.Name,
+			InstanceName:  alicloud.CloudManagerResourceName(kcp.Name),
 			InstanceClass: kcp.Spec.Instance.Alicloud.InstanceClass,
 			EngineVersion: kcp.Spec.Instance.Alicloud.EngineVersion,
 			VpcId:         state.IpRange().Status.VpcId,
@@ -6,6 +6,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud"
 	alicloudclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/alicloud/redisinstance/client"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -56,7 +57,13 @@ func loadRedis(ctx context.Context, st composed.State) (error, context.Context)
 
 	// No Status.Id yet - search by name to recover a previously created instance
 	// whose ID was not persisted (crash between CreateInstance and status write).
-	info, err := state.client.DescribeInstanceByName(ctx, state.ObjAsRedisInstance().Name)
+	// Instances are named by alicloud.CloudManagerResourceName, the ones created
+	// before that carry the bare name.
+	name := state.ObjAsRedisInstance().Name
+	info, err := state.client.DescribeInstanceByName(ctx, alicloud.CloudManagerResourceName(name))
+	if err == nil && info == nil {
+		info, err = state.client.DescribeInstanceByName(ctx, name)
+	}
 	if err != nil {
 		logger.Error(err, "Error searching AliCloud r-kvstore instance by name")
 		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
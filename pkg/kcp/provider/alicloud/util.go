@@ -126,3 +126,18 @@ func HasAllCidrs(existing string, required []string) bool {
 	}
 	return true
 }
+
+// cloudManagerResourcePrefix marks the NAS file system descriptions and r-kvstore instance names of the
+// resources created by cloud-manager, since neither of them carries a reference to the Scope or KCP object.
+const cloudManagerResourcePrefix = "cloud-manager-"
+
+// CloudManagerResourceName returns the name, or NAS description, given to the AliCloud resource created for the named KCP object.
+func CloudManagerResourceName(kcpName string) string {
+	return cloudManagerResourcePrefix + kcpName
+}
+
+// IsCloudManagerResourceName reports whether the AliCloud resource name, or NAS description, was given by cloud-manager.
+// The KCP Nuke relies on it to leave the resources the customer created in the Scope VPC alone.
+func IsCloudManagerResourceName(name string) bool {
+	return strings.HasPrefix(name, cloudManagerResourcePrefix)
+}
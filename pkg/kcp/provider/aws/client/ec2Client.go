@@ -50,6 +50,10 @@ type Ec2Client interface {
 	DescribeRouteTables(ctc context.Context, vpcId string) ([]ec2types.RouteTable, error)
 	CreateRoute(ctx context.Context, routeTableId, destinationCidrBlock, vpcPeeringConnectionId *string) error
 	DeleteRoute(ctx context.Context, routeTableId, destinationCidrBlock *string) error
+
+	DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error)
+	CreateFlowLogs(ctx context.Context, resourceId, logGroupName, deliverLogsPermissionArn string, tags []ec2types.Tag) (string, error)
+	DeleteFlowLogs(ctx context.Context, flowLogIds []string) error
 }
 
 func NewEc2Client(svc *ec2.Client) Ec2Client {
@@ -537,3 +541,60 @@ func (c *ec2Client) DeleteRoute(ctx context.Context, routeTableId, destinationCi
 	})
 	return err
 }
+
+func (c *ec2Client) DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error) {
+	out, err := c.svc.DescribeFlowLogs(ctx, &ec2.DescribeFlowLogsInput{
+		Filter: []ec2types.Filter{
+			{
+				Name:   new("resource-id"),
+				Values: []string{resourceId},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.FlowLogs, nil
+}
+
+func (c *ec2Client) CreateFlowLogs(ctx context.Context, resourceId, logGroupName, deliverLogsPermissionArn string, tags []ec2types.Tag) (string, error) {
+	out, err := c.svc.CreateFlowLogs(ctx, &ec2.CreateFlowLogsInput{
+		ResourceIds:              []string{resourceId},
+		ResourceType:             ec2types.FlowLogsResourceTypeVpc,
+		TrafficType:              ec2types.TrafficTypeAll,
+		LogDestinationType:       ec2types.LogDestinationTypeCloudWatchLogs,
+		LogGroupName:             new(logGroupName),
+		DeliverLogsPermissionArn: new(deliverLogsPermissionArn),
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeVpcFlowLog,
+				Tags:         tags,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		e := out.Unsuccessful[0].Error
+		return "", fmt.Errorf("error creating flow log for %s: %s: %s", resourceId, ptr.Deref(e.Code, ""), ptr.Deref(e.Message, ""))
+	}
+	if len(out.FlowLogIds) == 0 {
+		return "", fmt.Errorf("error creating flow log for %s: no flow log id returned", resourceId)
+	}
+	return out.FlowLogIds[0], nil
+}
+
+func (c *ec2Client) DeleteFlowLogs(ctx context.Context, flowLogIds []string) error {
+	out, err := c.svc.DeleteFlowLogs(ctx, &ec2.DeleteFlowLogsInput{
+		FlowLogIds: flowLogIds,
+	})
+	if err != nil {
+		return err
+	}
+	if len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		e := out.Unsuccessful[0].Error
+		return fmt.Errorf("error deleting flow log %s: %s: %s", ptr.Deref(out.Unsuccessful[0].ResourceId, ""), ptr.Deref(e.Code, ""), ptr.Deref(e.Message, ""))
+	}
+	return nil
+}
@@ -77,6 +77,13 @@ type RescaleElastiCacheClusterReplicaOptions struct {
 	ReplicasToRemove    []string
 }
 
+type CreateElastiCacheUserOptions struct {
+	UserId       string
+	UserName     string
+	AccessString string
+	Password     string
+}
+
 type ElastiCacheClient interface {
 	DescribeElastiCacheSubnetGroup(ctx context.Context, name string) ([]elasticachetypes.CacheSubnetGroup, error)
 	CreateElastiCacheSubnetGroup(ctx context.Context, name string, subnetIds []string, tags []elasticachetypes.Tag) (*elasticache.CreateCacheSubnetGroupOutput, error)
@@ -104,6 +111,12 @@ type ElastiCacheClient interface {
 	DescribeUserGroup(ctx context.Context, id string) (*elasticachetypes.UserGroup, error)
 	CreateUserGroup(ctx context.Context, id string, tags []elasticachetypes.Tag) (*elasticache.CreateUserGroupOutput, error)
 	DeleteUserGroup(ctx context.Context, id string) error
+	ModifyUserGroup(ctx context.Context, id string, userIdsToAdd, userIdsToRemove []string) error
+
+	DescribeUser(ctx context.Context, id string) (*elasticachetypes.User, error)
+	CreateUser(ctx context.Context, options CreateElastiCacheUserOptions, tags []elasticachetypes.Tag) (*elasticache.CreateUserOutput, error)
+	ModifyUserAccessString(ctx context.Context, id, accessString string) error
+	DeleteUser(ctx context.Context, id string) error
 
 	DescribeElastiCacheSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error)
 	CreateElastiCacheSecurityGroup(ctx context.Context, vpcId, name string, tags []ec2types.Tag) (string, error)
@@ -504,7 +517,7 @@ func (c *elastiCacheClient) CreateUserGroup(ctx context.Context, id string, tags
 		UserGroupId: new(id),
 		Engine:      new("redis"),
 		Tags:        tags,
-		UserIds:     []string{"default"},
+		UserIds:     []string{awsmeta.ElastiCache_DefaultUser},
 	})
 	if err != nil {
 		return nil, err
@@ -525,6 +538,75 @@ func (c *elastiCacheClient) DeleteUserGroup(ctx context.Context, id string) erro
 	return nil
 }
 
+func (c *elastiCacheClient) ModifyUserGroup(ctx context.Context, id string, userIdsToAdd, userIdsToRemove []string) error {
+	params := &elasticache.ModifyUserGroupInput{
+		UserGroupId: new(id),
+	}
+	if len(userIdsToAdd) > 0 {
+		params.UserIdsToAdd = userIdsToAdd
+	}
+	if len(userIdsToRemove) > 0 {
+		params.UserIdsToRemove = userIdsToRemove
+	}
+
+	_, err := c.elastiCacheSvc.ModifyUserGroup(ctx, params)
+
+	return err
+}
+
+func (c *elastiCacheClient) DescribeUser(ctx context.Context, id string) (*elasticachetypes.User, error) {
+	res, err := c.elastiCacheSvc.DescribeUsers(ctx, &elasticache.DescribeUsersInput{
+		UserId: new(id),
+	})
+
+	if err != nil {
+		if awsmeta.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if len(res.Users) == 0 {
+		return nil, nil
+	}
+
+	return new(res.Users[0]), nil
+}
+
+func (c *elastiCacheClient) CreateUser(ctx context.Context, options CreateElastiCacheUserOptions, tags []elasticachetypes.Tag) (*elasticache.CreateUserOutput, error) {
+	res, err := c.elastiCacheSvc.CreateUser(ctx, &elasticache.CreateUserInput{
+		UserId:       new(options.UserId),
+		UserName:     new(options.UserName),
+		Engine:       new("redis"),
+		AccessString: new(options.AccessString),
+		Passwords:    []string{options.Password},
+		Tags:         tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *elastiCacheClient) ModifyUserAccessString(ctx context.Context, id, accessString string) error {
+	_, err := c.elastiCacheSvc.ModifyUser(ctx, &elasticache.ModifyUserInput{
+		UserId:       new(id),
+		AccessString: new(accessString),
+	})
+
+	return err
+}
+
+func (c *elastiCacheClient) DeleteUser(ctx context.Context, id string) error {
+	_, err := c.elastiCacheSvc.DeleteUser(ctx, &elasticache.DeleteUserInput{
+		UserId: new(id),
+	})
+
+	return err
+}
+
 func (c *elastiCacheClient) DescribeElastiCacheSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error) {
 	out, err := c.ec2Svc.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
 		Filters:  filters,
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
+)
+
+const (
+	GuardDutyDetectorStatusEnabled  = "ENABLED"
+	GuardDutyDetectorStatusDisabled = "DISABLED"
+)
+
+// GuardDutyDetector is the subset of the GuardDuty detector the runtime security flow needs.
+type GuardDutyDetector struct {
+	DetectorId string
+	Status     string
+	Tags       map[string]string
+}
+
+func (d *GuardDutyDetector) IsEnabled() bool {
+	return d != nil && d.Status == GuardDutyDetectorStatusEnabled
+}
+
+type GuardDutyClient interface {
+	ListDetectors(ctx context.Context) ([]string, error)
+	GetDetector(ctx context.Context, detectorId string) (*GuardDutyDetector, error)
+	CreateDetector(ctx context.Context, tags map[string]string) (string, error)
+	UpdateDetector(ctx context.Context, detectorId string, enable bool) error
+}
+
+// NewGuardDutyClient returns a client calling the GuardDuty REST API directly. Only four
+// plain json operations are needed, so they are signed with the SDK v4 signer and sent
+// with the http client from the config, instead of pulling in the whole GuardDuty SDK.
+// Since the smithy middleware stack is not involved, the calls are reported to the
+// cloud provider metrics here.
+func NewGuardDutyClient(cfg aws.Config) GuardDutyClient {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &guardDutyClient{
+		cfg:        cfg,
+		httpClient: httpClient,
+		signer:     v4.NewSigner(),
+		endpoint:   guardDutyEndpoint(cfg.Region),
+	}
+}
+
+func guardDutyEndpoint(region string) string {
+	if strings.HasPrefix(region, "cn-") {
+		return fmt.Sprintf("https://guardduty.%s.amazonaws.com.cn", region)
+	}
+	return fmt.Sprintf("https://guardduty.%s.amazonaws.com", region)
+}
+
+var _ GuardDutyClient = (*guardDutyClient)(nil)
+
+type guardDutyClient struct {
+	cfg        aws.Config
+	httpClient aws.HTTPClient
+	signer     *v4.Signer
+	endpoint   string
+}
+
+func (c *guardDutyClient) ListDetectors(ctx context.Context) ([]string, error) {
+	out := struct {
+		DetectorIds []string `json:"detectorIds"`
+	}{}
+	if err := c.call(ctx, "ListDetectors", http.MethodGet, "/detector", nil, &out); err != nil {
+		return nil, err
+	}
+	return out.DetectorIds, nil
+}
+
+func (c *guardDutyClient) GetDetector(ctx context.Context, detectorId string) (*GuardDutyDetector, error) {
+	out := struct {
+		Status string            `json:"status"`
+		Tags   map[string]string `json:"tags"`
+	}{}
+	if err := c.call(ctx, "GetDetector", http.MethodGet, "/detector/"+detectorId, nil, &out); err != nil {
+		return nil, err
+	}
+	return &GuardDutyDetector{
+		DetectorId: detectorId,
+		Status:     out.Status,
+		Tags:       out.Tags,
+	}, nil
+}
+
+func (c *guardDutyClient) CreateDetector(ctx context.Context, tags map[string]string) (string, error) {
+	in := map[string]any{
+		"enable": true,
+		"tags":   tags,
+	}
+	out := struct {
+		DetectorId string `json:"detectorId"`
+	}{}
+	if err := c.call(ctx, "CreateDetector", http.MethodPost, "/detector", in, &out); err != nil {
+		return "", err
+	}
+	return out.DetectorId, nil
+}
+
+func (c *guardDutyClient) UpdateDetector(ctx context.Context, detectorId string, enable bool) error {
+	in := map[string]any{
+		"enable": enable,
+	}
+	return c.call(ctx, "UpdateDetector", http.MethodPost, "/detector/"+detectorId, in, nil)
+}
+
+func (c *guardDutyClient) call(ctx context.Context, operation, method, path string, in, out any) error {
+	var body []byte
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("error marshaling guardduty %s request: %w", operation, err)
+		}
+		body = b
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating guardduty %s request: %w", operation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	creds, err := c.cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving credentials for guardduty %s: %w", operation, err)
+	}
+	hash := sha256.Sum256(body)
+	if err := c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "guardduty", c.cfg.Region, time.Now()); err != nil {
+		return fmt.Errorf("error signing guardduty %s request: %w", operation, err)
+	}
+
+	start := time.Now()
+	done := metrics.CloudProviderCallStarted(metrics.CloudProviderAWS)
+	resp, err := c.httpClient.Do(req)
+	done()
+	responseCode := -1
+	if resp != nil {
+		responseCode = resp.StatusCode
+	}
+	metrics.ReportCloudProviderCall(
+		metrics.CloudProviderAWS,
+		"GuardDuty/"+operation,
+		fmt.Sprintf("%d", responseCode),
+		c.cfg.Region,
+		awsmeta.GetAwsAccountId(ctx),
+		time.Since(start),
+	)
+	tracing.RecordCloudProviderCall(ctx, metrics.CloudProviderAWS, "GuardDuty/"+operation, fmt.Sprintf("%d", responseCode), start, err)
+	if err != nil {
+		return fmt.Errorf("error calling guardduty %s: %w", operation, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading guardduty %s response: %w", operation, err)
+	}
+
+	if resp.StatusCode >= 300 {
+		apiErr := &smithy.GenericAPIError{
+			Code:    resp.Header.Get("X-Amzn-ErrorType"),
+			Message: string(respBody),
+		}
+		errBody := struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}{}
+		if json.Unmarshal(respBody, &errBody) == nil {
+			if errBody.Type != "" {
+				apiErr.Code = errBody.Type
+			}
+			if errBody.Message != "" {
+				apiErr.Message = errBody.Message
+			}
+		}
+		if i := strings.Index(apiErr.Code, ":"); i >= 0 {
+			apiErr.Code = apiErr.Code[:i]
+		}
+		return &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: resp},
+			Err:      apiErr,
+		}
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("error unmarshaling guardduty %s response: %w", operation, err)
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ type AwsConfigStruct struct {
 	Default                       AwsCreds                                             `json:"default" yaml:"default"`
 	Peering                       AwsCreds                                             `json:"peering" yaml:"peering"`
 	BackupRoleName                string                                               `json:"backupRoleName" yaml:"backupRoleName"`
+	FlowLogsRoleName              string                                               `json:"flowLogsRoleName" yaml:"flowLogsRoleName"`
 	EfsCapacityCheckInterval      time.Duration                                        `json:"efsCapacityCheckInterval" yaml:"efsCapacityCheckInterval"`
 	RedisInstanceTierMachineTypes map[cloudresourcesv1beta1.AwsRedisTier]string        `json:"redisInstanceTierMachineTypes" yaml:"redisInstanceTierMachineTypes"`
 	RedisClusterTierMachineTypes  map[cloudresourcesv1beta1.AwsRedisClusterTier]string `json:"redisClusterTierMachineTypes" yaml:"redisClusterTierMachineTypes"`
@@ -73,6 +74,11 @@ func InitConfig(cfg config.Config) {
 			config.DefaultScalar("CloudManagerBackupServiceRole"),
 			config.SourceEnv("AWS_BACKUP_ROLE_NAME"),
 		),
+		config.Path(
+			"flowLogsRoleName",
+			config.DefaultScalar("CloudManagerFlowLogsDeliveryRole"),
+			config.SourceEnv("AWS_FLOW_LOGS_ROLE_NAME"),
+		),
 		config.Path(
 			"efsCapacityCheckInterval",
 			config.DefaultScalar(1*time.Hour),
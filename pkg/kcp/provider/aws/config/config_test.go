@@ -19,6 +19,7 @@ func TestConfigDefaultValues(t *testing.T) {
 
 	assert.Equal(t, "aws", AwsConfig.ArnPartition)
 	assert.Equal(t, "CloudManagerBackupServiceRole", AwsConfig.BackupRoleName)
+	assert.Equal(t, "CloudManagerFlowLogsDeliveryRole", AwsConfig.FlowLogsRoleName)
 	assert.Equal(t, time.Hour, AwsConfig.EfsCapacityCheckInterval)
 	assert.Nil(t, AwsConfig.RedisInstanceTierMachineTypes)
 }
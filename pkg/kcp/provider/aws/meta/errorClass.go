@@ -0,0 +1,43 @@
+package meta
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func init() {
+	util.RegisterErrorClassifier(ClassifyError)
+}
+
+// ClassifyError maps AWS api errors to the util.ErrorClass constants.
+// It returns an empty string for errors not coming from AWS.
+func ClassifyError(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		if _, throttled := retry.DefaultThrottleErrorCodes[code]; throttled {
+			return util.ErrorClassThrottled
+		}
+		switch {
+		case strings.Contains(code, "LimitExceeded"), strings.Contains(code, "Quota"),
+			strings.HasPrefix(code, "Insufficient"):
+			return util.ErrorClassQuota
+		case code == AccessDenied, code == UnauthorizedOperation, code == "AccessDeniedException":
+			return util.ErrorClassForbidden
+		case IsNotFound(err):
+			return util.ErrorClassNotFound
+		}
+	}
+
+	var respErr *smithyhttp.ResponseError
+	if errors.As(err, &respErr) {
+		return util.ErrorClassFromHttpStatus(respErr.HTTPStatusCode())
+	}
+
+	return ""
+}
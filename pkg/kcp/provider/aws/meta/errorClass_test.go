@@ -0,0 +1,30 @@
+package meta
+
+import (
+	"net/http"
+	"testing"
+
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	apiErr := func(code string) error {
+		return &smithy.GenericAPIError{Code: code}
+	}
+
+	assert.Equal(t, util.ErrorClassThrottled, util.ClassifyError(apiErr("Throttling")))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(apiErr("VpcLimitExceeded")))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(apiErr("NodeQuotaForCustomerExceeded")))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(&efstypes.FileSystemLimitExceeded{}))
+	assert.Equal(t, util.ErrorClassForbidden, util.ClassifyError(apiErr(UnauthorizedOperation)))
+	assert.Equal(t, util.ErrorClassNotFound, util.ClassifyError(&efstypes.FileSystemNotFound{}))
+	assert.Equal(t, util.ErrorClassNotFound, util.ClassifyError(NewHttpNotFoundError(apiErr("Whatever"))))
+	assert.Equal(t, util.ErrorClassUnavailable, util.ClassifyError(&smithyhttp.ResponseError{
+		Err:      apiErr("InternalError"),
+		Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}},
+	}))
+}
@@ -104,6 +104,7 @@ var notFoundErrorCodes = map[string]struct{}{
 	(&efstypes.PolicyNotFound{}).ErrorCode():                        {},
 	(&elasticachetypes.CacheSubnetGroupNotFoundFault{}).ErrorCode(): {},
 	(&elasticachetypes.CacheClusterNotFoundFault{}).ErrorCode():     {},
+	(&elasticachetypes.UserNotFoundFault{}).ErrorCode():             {},
 	(&secretsmanagertypes.ResourceNotFoundException{}).ErrorCode():  {},
 	"InvalidVpcPeeringConnectionID.NotFound":                        {},
 	"InvalidVpcID.NotFound":                                         {},
@@ -183,3 +184,15 @@ const (
 	ElastiCache_UserGroup_DELETING  ElastiCacheUserGroupState = "deleting"
 	ElastiCache_UserGroup_MODIFYING ElastiCacheUserGroupState = "modifying"
 )
+
+type ElastiCacheUserState = string
+
+const (
+	ElastiCache_User_ACTIVE    ElastiCacheUserState = "active"
+	ElastiCache_User_MODIFYING ElastiCacheUserState = "modifying"
+	ElastiCache_User_DELETING  ElastiCacheUserState = "deleting"
+)
+
+// ElastiCache_DefaultUser is the id and the name of the built-in ElastiCache user with
+// access string "on ~* +@all nopass". Each user group must contain a user named default.
+const ElastiCache_DefaultUser = "default"
@@ -39,6 +39,10 @@ type AwsElastiCacheMockUtils interface {
 	PreCreateUserGroup(name string, state awsmeta.ElastiCacheUserGroupState)
 
 	GetAwsElastiCacheUserGroupByName(name string) *elasticachetypes.UserGroup
+
+	GetAwsElastiCacheUserById(id string) *elasticachetypes.User
+	GetAwsElastiCacheUserPassword(id string) string
+	DeleteAwsElastiCacheUserById(id string)
 }
 
 func getDefaultParams() map[string]elasticachetypes.Parameter {
@@ -70,6 +74,8 @@ type elastiCacheClientFake struct {
 	parameterGroups   map[string]*elasticachetypes.CacheParameterGroup
 	subnetGroups      map[string]*elasticachetypes.CacheSubnetGroup
 	userGroups        map[string]*elasticachetypes.UserGroup
+	users             map[string]*elasticachetypes.User
+	userPasswords     map[string]string
 	secretStore       map[string]*secretsmanager.GetSecretValueOutput
 	securityGroups    []*ec2types.SecurityGroup
 
@@ -88,6 +94,8 @@ func newElastiCacheClientFake() *elastiCacheClientFake {
 		parameters:        map[string]map[string]elasticachetypes.Parameter{},
 		secretStore:       map[string]*secretsmanager.GetSecretValueOutput{},
 		userGroups:        map[string]*elasticachetypes.UserGroup{},
+		users:             map[string]*elasticachetypes.User{},
+		userPasswords:     map[string]string{},
 		securityGroups:    []*ec2types.SecurityGroup{},
 	}
 }
@@ -180,7 +188,7 @@ func (client *elastiCacheClientFake) PreCreateUserGroup(name string, state awsme
 		Engine:      new("redis"),
 		UserGroupId: new(name),
 		Status:      new(state),
-		UserIds:     []string{"default"},
+		UserIds:     []string{awsmeta.ElastiCache_DefaultUser},
 	}
 }
 
@@ -190,6 +198,26 @@ func (client *elastiCacheClientFake) GetAwsElastiCacheUserGroupByName(name strin
 	return client.userGroups[name]
 }
 
+func (client *elastiCacheClientFake) GetAwsElastiCacheUserById(id string) *elasticachetypes.User {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	return client.users[id]
+}
+
+func (client *elastiCacheClientFake) GetAwsElastiCacheUserPassword(id string) string {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	return client.userPasswords[id]
+}
+
+func (client *elastiCacheClientFake) DeleteAwsElastiCacheUserById(id string) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	delete(client.users, id)
+	delete(client.userPasswords, id)
+}
+
 func (client *elastiCacheClientFake) DescribeAwsElastiCacheParametersByName(groupName string) map[string]string {
 	result := map[string]string{}
 
@@ -589,7 +617,7 @@ func (client *elastiCacheClientFake) CreateUserGroup(ctx context.Context, id str
 		Engine:      new("redis"),
 		UserGroupId: new(id),
 		Status:      new("creating"),
-		UserIds:     []string{"default"},
+		UserIds:     []string{awsmeta.ElastiCache_DefaultUser},
 	}
 
 	return &elasticache.CreateUserGroupOutput{UserGroupId: new(id)}, nil
@@ -611,6 +639,121 @@ func (client *elastiCacheClientFake) DeleteUserGroup(ctx context.Context, id str
 	return nil
 }
 
+func (client *elastiCacheClientFake) ModifyUserGroup(ctx context.Context, id string, userIdsToAdd, userIdsToRemove []string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	userGroup, ok := client.userGroups[id]
+	if !ok {
+		return fmt.Errorf("user group %s does not exist", id)
+	}
+
+	for _, userId := range userIdsToAdd {
+		if userId == awsmeta.ElastiCache_DefaultUser {
+			if !pie.Contains(userGroup.UserIds, userId) {
+				userGroup.UserIds = append(userGroup.UserIds, userId)
+			}
+			continue
+		}
+		user, ok := client.users[userId]
+		if !ok {
+			return fmt.Errorf("user %s does not exist", userId)
+		}
+		if !pie.Contains(userGroup.UserIds, userId) {
+			userGroup.UserIds = append(userGroup.UserIds, userId)
+		}
+		if !pie.Contains(user.UserGroupIds, id) {
+			user.UserGroupIds = append(user.UserGroupIds, id)
+		}
+	}
+	for _, userId := range userIdsToRemove {
+		userGroup.UserIds = pie.Filter(userGroup.UserIds, func(x string) bool { return x != userId })
+		if user, ok := client.users[userId]; ok {
+			user.UserGroupIds = pie.Filter(user.UserGroupIds, func(x string) bool { return x != id })
+		}
+	}
+
+	return nil
+}
+
+func (client *elastiCacheClientFake) DescribeUser(ctx context.Context, id string) (*elasticachetypes.User, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	user, ok := client.users[id]
+	if !ok {
+		return nil, nil
+	}
+	result := *user
+	result.UserGroupIds = append([]string{}, user.UserGroupIds...)
+
+	return &result, nil
+}
+
+func (client *elastiCacheClientFake) CreateUser(ctx context.Context, options awsclient.CreateElastiCacheUserOptions, tags []elasticachetypes.Tag) (*elasticache.CreateUserOutput, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if _, ok := client.users[options.UserId]; ok {
+		return nil, fmt.Errorf("user %s already exists", options.UserId)
+	}
+
+	client.users[options.UserId] = &elasticachetypes.User{
+		Engine:       new("redis"),
+		UserId:       new(options.UserId),
+		UserName:     new(options.UserName),
+		AccessString: new(options.AccessString),
+		Status:       new(awsmeta.ElastiCache_User_ACTIVE),
+	}
+	client.userPasswords[options.UserId] = options.Password
+
+	return &elasticache.CreateUserOutput{UserId: new(options.UserId)}, nil
+}
+
+func (client *elastiCacheClientFake) ModifyUserAccessString(ctx context.Context, id, accessString string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	user, ok := client.users[id]
+	if !ok {
+		return fmt.Errorf("user %s does not exist", id)
+	}
+	user.AccessString = new(accessString)
+
+	return nil
+}
+
+func (client *elastiCacheClientFake) DeleteUser(ctx context.Context, id string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if user, ok := client.users[id]; ok {
+		user.Status = new(awsmeta.ElastiCache_User_DELETING)
+	}
+
+	return nil
+}
+
 func (client *elastiCacheClientFake) DescribeElastiCacheSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
@@ -0,0 +1,196 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/google/uuid"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+type SecurityConfig interface {
+	GetFlowLogs(vpcId string) []ec2types.FlowLog
+	GetGuardDutyDetector() *awsclient.GuardDutyDetector
+}
+
+var _ SecurityConfig = &securityStore{}
+
+type securityStore struct {
+	m sync.Mutex
+
+	flowLogs []*ec2types.FlowLog
+
+	// guardDutyDetector at most one per account and region, as in GuardDuty
+	guardDutyDetector *awsclient.GuardDutyDetector
+}
+
+// SecurityConfig ============================================================
+
+func (s *securityStore) GetFlowLogs(vpcId string) []ec2types.FlowLog {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.describeFlowLogs(vpcId)
+}
+
+func (s *securityStore) GetGuardDutyDetector() *awsclient.GuardDutyDetector {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.guardDutyDetector == nil {
+		return nil
+	}
+	cpy := *s.guardDutyDetector
+	cpy.Tags = maps.Clone(s.guardDutyDetector.Tags)
+	return &cpy
+}
+
+// Flow logs ==================================================================
+
+func (s *securityStore) DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.describeFlowLogs(resourceId), nil
+}
+
+func (s *securityStore) describeFlowLogs(resourceId string) []ec2types.FlowLog {
+	filtered := pie.Filter(s.flowLogs, func(fl *ec2types.FlowLog) bool {
+		return ptr.Deref(fl.ResourceId, "") == resourceId
+	})
+	return pie.Map(filtered, func(fl *ec2types.FlowLog) ec2types.FlowLog {
+		cln, err := util.JsonClone(fl)
+		if err != nil {
+			return *fl
+		}
+		return *cln
+	})
+}
+
+func (s *securityStore) CreateFlowLogs(ctx context.Context, resourceId, logGroupName, deliverLogsPermissionArn string, tags []ec2types.Tag) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	fl := &ec2types.FlowLog{
+		FlowLogId:                new("fl-" + uuid.NewString()[:17]),
+		FlowLogStatus:            new("ACTIVE"),
+		ResourceId:               new(resourceId),
+		TrafficType:              ec2types.TrafficTypeAll,
+		LogDestinationType:       ec2types.LogDestinationTypeCloudWatchLogs,
+		LogGroupName:             new(logGroupName),
+		DeliverLogsPermissionArn: new(deliverLogsPermissionArn),
+		DeliverLogsStatus:        new("SUCCESS"),
+		Tags:                     tags,
+	}
+	s.flowLogs = append(s.flowLogs, fl)
+
+	return ptr.Deref(fl.FlowLogId, ""), nil
+}
+
+func (s *securityStore) DeleteFlowLogs(ctx context.Context, flowLogIds []string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for _, id := range flowLogIds {
+		idx := pie.FindFirstUsing(s.flowLogs, func(fl *ec2types.FlowLog) bool {
+			return ptr.Deref(fl.FlowLogId, "") == id
+		})
+		if idx < 0 {
+			return awsmeta.NewHttpNotFoundError(fmt.Errorf("flow log %q not found", id))
+		}
+		s.flowLogs = append(s.flowLogs[:idx], s.flowLogs[idx+1:]...)
+	}
+
+	return nil
+}
+
+// GuardDuty ==================================================================
+
+func (s *securityStore) ListDetectors(ctx context.Context) ([]string, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.guardDutyDetector == nil {
+		return nil, nil
+	}
+	return []string{s.guardDutyDetector.DetectorId}, nil
+}
+
+func (s *securityStore) GetDetector(ctx context.Context, detectorId string) (*awsclient.GuardDutyDetector, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.guardDutyDetector == nil || s.guardDutyDetector.DetectorId != detectorId {
+		return nil, awsmeta.NewHttpNotFoundError(fmt.Errorf("detector %q not found", detectorId))
+	}
+	cpy := *s.guardDutyDetector
+	cpy.Tags = maps.Clone(s.guardDutyDetector.Tags)
+	return &cpy, nil
+}
+
+func (s *securityStore) CreateDetector(ctx context.Context, tags map[string]string) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.guardDutyDetector != nil {
+		return "", fmt.Errorf("the request is rejected because a detector already exists for the current account")
+	}
+	s.guardDutyDetector = &awsclient.GuardDutyDetector{
+		DetectorId: uuid.NewString(),
+		Status:     awsclient.GuardDutyDetectorStatusEnabled,
+		Tags:       maps.Clone(tags),
+	}
+
+	return s.guardDutyDetector.DetectorId, nil
+}
+
+func (s *securityStore) UpdateDetector(ctx context.Context, detectorId string, enable bool) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.guardDutyDetector == nil || s.guardDutyDetector.DetectorId != detectorId {
+		return awsmeta.NewHttpNotFoundError(fmt.Errorf("detector %q not found", detectorId))
+	}
+	if enable {
+		s.guardDutyDetector.Status = awsclient.GuardDutyDetectorStatusEnabled
+	} else {
+		s.guardDutyDetector.Status = awsclient.GuardDutyDetectorStatusDisabled
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	awsexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/exposedData/client"
 	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awssecurityclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/security/client"
 	awsvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcnetwork/client"
 	subscriptionclient "github.com/kyma-project/cloud-manager/pkg/kcp/subscription/client"
 
@@ -181,3 +182,13 @@ func (s *server) VpcNetworkProvider() awsclient.SkrClientProvider[awsvpcnetworkc
 		return acc.Region(region), nil
 	}
 }
+
+func (s *server) SecurityProvider() awsclient.SkrClientProvider[awssecurityclient.Client] {
+	return func(_ context.Context, account, region, key, secret, role string) (awssecurityclient.Client, error) {
+		acc := s.GetAccount(account)
+		if acc == nil {
+			return nil, ErrNoAccount
+		}
+		return acc.Region(region), nil
+	}
+}
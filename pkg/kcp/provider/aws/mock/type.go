@@ -5,6 +5,7 @@ import (
 	awsexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/exposedData/client"
 	awsiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/iprange/client"
 	awsnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance/client"
+	awssecurityclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/security/client"
 	awsvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcnetwork/client"
 	awsvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering/client"
 	scopeclient "github.com/kyma-project/cloud-manager/pkg/kcp/scope/client"
@@ -43,6 +44,10 @@ type VpcNetworkClient interface {
 	awsvpcnetworkclient.Client
 }
 
+type SecurityClient interface {
+	awssecurityclient.Client
+}
+
 type Clients interface {
 	IpRangeClient
 	NfsClient
@@ -50,6 +55,7 @@ type Clients interface {
 	ElastiCacheClient
 	ExposedDataClient
 	VpcNetworkClient
+	SecurityClient
 }
 
 type Providers interface {
@@ -61,6 +67,7 @@ type Providers interface {
 	ElastiCacheProviderFake() awsclient.SkrClientProvider[awsclient.ElastiCacheClient]
 	ExposedDataProvider() awsclient.SkrClientProvider[awsexposeddataclient.Client]
 	VpcNetworkProvider() awsclient.SkrClientProvider[awsvpcnetworkclient.Client]
+	SecurityProvider() awsclient.SkrClientProvider[awssecurityclient.Client]
 }
 
 type Configs interface {
@@ -69,6 +76,7 @@ type Configs interface {
 	VpcPeeringConfig
 	RouteTableConfig
 	AwsElastiCacheMockUtils
+	SecurityConfig
 }
 
 type AccountRegion interface {
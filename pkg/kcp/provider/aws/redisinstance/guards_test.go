@@ -123,6 +123,21 @@ func (s *elastiCacheStub) DeleteElastiCacheSecurityGroup(ctx context.Context, id
 func (s *elastiCacheStub) AuthorizeElastiCacheSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error {
 	panic("unimplemented")
 }
+func (s *elastiCacheStub) ModifyUserGroup(ctx context.Context, id string, userIdsToAdd, userIdsToRemove []string) error {
+	panic("unimplemented")
+}
+func (s *elastiCacheStub) DescribeUser(ctx context.Context, id string) (*elasticachetypes.User, error) {
+	panic("unimplemented")
+}
+func (s *elastiCacheStub) CreateUser(ctx context.Context, options awsclient.CreateElastiCacheUserOptions, tags []elasticachetypes.Tag) (*elasticache.CreateUserOutput, error) {
+	panic("unimplemented")
+}
+func (s *elastiCacheStub) ModifyUserAccessString(ctx context.Context, id, accessString string) error {
+	panic("unimplemented")
+}
+func (s *elastiCacheStub) DeleteUser(ctx context.Context, id string) error {
+	panic("unimplemented")
+}
 
 func newTestStateWithClient(t *testing.T, instanceName string, ug *elasticachetypes.UserGroup, stub *elastiCacheStub) *State {
 	t.Helper()
@@ -0,0 +1,26 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func addUserToUserGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.isUserInUserGroup() {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Adding elasticache user to user group")
+
+	err := state.awsClient.ModifyUserGroup(ctx, state.userGroupName(), []string{state.userId()}, nil)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error adding elasticache user to user group", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,82 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/google/uuid"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func createUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.user != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+	redisUser := state.ObjAsRedisUser()
+
+	// the password can not be read back from AWS, so it's persisted before the user
+	// is created, and the retry after a failed create reuses the same one
+	if redisUser.Status.AuthString == "" {
+		redisUser.Status.AuthString = uuid.NewString()
+		err := state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error updating RedisUser status with generated password", composed.StopWithRequeue, ctx)
+		}
+	}
+
+	out, err := state.awsClient.CreateUser(ctx, awsclient.CreateElastiCacheUserOptions{
+		UserId:       state.userId(),
+		UserName:     redisUser.Spec.UserName,
+		AccessString: state.desiredAccessString(),
+		Password:     redisUser.Status.AuthString,
+	}, []types.Tag{
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(state.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(redisUser.Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(redisUser.Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	})
+	if err != nil {
+		if awsmeta.IsErrorRetryable(err) {
+			return awsmeta.LogErrorAndReturn(err, "Error creating elasticache user", ctx)
+		}
+		logger.Error(err, "Error creating elasticache user")
+		redisUser.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisUser).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to create elasticache user",
+			}).
+			ErrorLogMessage("Error updating RedisUser status due failed elasticache user creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	logger = logger.WithValues("userId", ptr.Deref(out.UserId, ""))
+	logger.Info("ElastiCache user created")
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,36 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+// deleteDefaultUser deletes the cloud-manager owned default user once it is not
+// in the user group anymore and no other RedisUser is about to use it
+func deleteDefaultUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.defaultUser == nil {
+		return nil, ctx
+	}
+
+	if ptr.Deref(state.defaultUser.Status, "") == awsmeta.ElastiCache_User_DELETING {
+		return nil, ctx
+	}
+
+	if state.userGroup != nil && (!state.hasBuiltInDefaultUser() || state.hasOtherUsersInUserGroup()) {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Deleting elasticache default user")
+
+	err := state.awsClient.DeleteUser(ctx, state.defaultUserId())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting elasticache default user", ctx)
+	}
+
+	return nil, ctx
+}
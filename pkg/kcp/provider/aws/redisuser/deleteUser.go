@@ -0,0 +1,31 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func deleteUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.user == nil {
+		return nil, ctx
+	}
+
+	if ptr.Deref(state.user.Status, "") == awsmeta.ElastiCache_User_DELETING {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Deleting elasticache user")
+
+	err := state.awsClient.DeleteUser(ctx, state.userId())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting elasticache user", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,31 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func loadDefaultUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.defaultUser != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	defaultUser, err := state.awsClient.DescribeUser(ctx, state.defaultUserId())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error getting elasticache default user", ctx)
+	}
+
+	if defaultUser == nil {
+		return nil, ctx
+	}
+
+	state.defaultUser = defaultUser
+	logger.Info("ElastiCache default user found and loaded")
+
+	return nil, ctx
+}
@@ -0,0 +1,32 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func loadUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.user != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	user, err := state.awsClient.DescribeUser(ctx, state.userId())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error getting elasticache user", ctx)
+	}
+
+	if user == nil {
+		logger.Info("ElastiCache user not found")
+		return nil, ctx
+	}
+
+	state.user = user
+	logger.Info("ElastiCache user found and loaded")
+
+	return nil, ctx
+}
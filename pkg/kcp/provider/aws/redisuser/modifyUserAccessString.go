@@ -0,0 +1,33 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func modifyUserAccessString(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	// AWS may return the access string normalized, so it's compared only when spec changed
+	if state.ObjAsRedisUser().Status.ObservedGeneration == state.Obj().GetGeneration() {
+		return nil, ctx
+	}
+
+	desired := state.desiredAccessString()
+	if ptr.Deref(state.user.AccessString, "") == desired {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Modifying elasticache user access string")
+
+	err := state.awsClient.ModifyUserAccessString(ctx, state.userId(), desired)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error modifying elasticache user access string", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
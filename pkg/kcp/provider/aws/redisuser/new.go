@@ -0,0 +1,57 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/redisuser/types"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		logger := composed.LoggerFromCtx(ctx)
+		redisUserState := st.(types.State)
+		state, err := stateFactory.NewState(ctx, redisUserState)
+		if err != nil {
+			err = fmt.Errorf("error creating new aws redisuser state: %w", err)
+			logger.Error(err, "Error")
+			return composed.StopAndForget, nil
+		}
+
+		return composed.ComposeActions(
+			"awsRedisUser",
+			actions.AddCommonFinalizer(),
+			loadUser,
+			loadUserGroup,
+			loadDefaultUser,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"redisUser-create",
+					createUser,
+					updateStatusId,
+					waitUserActive,
+					modifyUserAccessString,
+					waitUserGroupActive,
+					replaceDefaultUser,
+					addUserToUserGroup,
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"redisUser-delete",
+					removeReadyCondition,
+					removeUserFromUserGroup,
+					restoreDefaultUser,
+					deleteDefaultUser,
+					deleteUser,
+					waitUserDeleted,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(awsmeta.SetAwsAccountId(ctx, redisUserState.Scope().Spec.Scope.Aws.AccountId), state)
+	}
+}
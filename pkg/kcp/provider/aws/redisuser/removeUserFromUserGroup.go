@@ -0,0 +1,33 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func removeUserFromUserGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if !state.isUserInUserGroup() {
+		return nil, ctx
+	}
+
+	if ptr.Deref(state.userGroup.Status, "") != awsmeta.ElastiCache_UserGroup_ACTIVE {
+		logger.Info("ElastiCache user group is not active, requeueing before removing the user")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	logger.Info("Removing elasticache user from user group")
+
+	err := state.awsClient.ModifyUserGroup(ctx, state.userGroupName(), nil, []string{state.userId()})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error removing elasticache user from user group", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
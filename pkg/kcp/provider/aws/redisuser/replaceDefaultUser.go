@@ -0,0 +1,72 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/google/uuid"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+// replaceDefaultUser swaps the built-in default user of the user group, that allows anyone to
+// connect without password, for the cloud-manager owned user named default with access off.
+// Each user group must contain a user named default, and once the first RedisUser is added
+// the redis must be accessible only with the credentials of the RedisUsers.
+func replaceDefaultUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if !state.hasBuiltInDefaultUser() {
+		return nil, ctx
+	}
+
+	if state.defaultUser == nil {
+		logger.Info("Creating elasticache default user with access off")
+
+		redisUser := state.ObjAsRedisUser()
+		// the password is never used since the access is off, but ElastiCache requires one
+		_, err := state.awsClient.CreateUser(ctx, awsclient.CreateElastiCacheUserOptions{
+			UserId:       state.defaultUserId(),
+			UserName:     awsmeta.ElastiCache_DefaultUser,
+			AccessString: "off",
+			Password:     uuid.NewString(),
+		}, []types.Tag{
+			{
+				Key:   ptr.To(common.TagCloudManagerName),
+				Value: new(state.userGroupName()),
+			},
+			{
+				Key:   ptr.To(common.TagScope),
+				Value: new(redisUser.Spec.Scope.Name),
+			},
+			{
+				Key:   ptr.To(common.TagShoot),
+				Value: new(state.Scope().Spec.ShootName),
+			},
+		})
+		if err != nil {
+			return awsmeta.LogErrorAndReturn(err, "Error creating elasticache default user", ctx)
+		}
+
+		return composed.StopWithRequeue, nil
+	}
+
+	if ptr.Deref(state.defaultUser.Status, "") != awsmeta.ElastiCache_User_ACTIVE {
+		logger.Info("ElastiCache default user is not active yet, requeueing with delay")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	logger.Info("Replacing built-in default user in user group")
+
+	err := state.awsClient.ModifyUserGroup(ctx, state.userGroupName(), []string{state.defaultUserId()}, []string{awsmeta.ElastiCache_DefaultUser})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error replacing built-in default user in user group", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
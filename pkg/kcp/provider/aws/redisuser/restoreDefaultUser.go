@@ -0,0 +1,40 @@
+package redisuser
+
+import (
+	"context"
+	"slices"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+// restoreDefaultUser puts the built-in default user back into the user group once the
+// last RedisUser is removed from it, so the redis is again accessible as with auth disabled.
+func restoreDefaultUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.userGroup == nil || !slices.Contains(state.userGroup.UserIds, state.defaultUserId()) {
+		return nil, ctx
+	}
+
+	if state.hasOtherUsersInUserGroup() {
+		return nil, ctx
+	}
+
+	if ptr.Deref(state.userGroup.Status, "") != awsmeta.ElastiCache_UserGroup_ACTIVE {
+		logger.Info("ElastiCache user group is not active, requeueing before restoring the built-in default user")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	logger.Info("Restoring built-in default user in user group")
+
+	err := state.awsClient.ModifyUserGroup(ctx, state.userGroupName(), []string{awsmeta.ElastiCache_DefaultUser}, []string{state.defaultUserId()})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error restoring built-in default user in user group", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
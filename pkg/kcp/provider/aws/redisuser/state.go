@@ -0,0 +1,118 @@
+package redisuser
+
+import (
+	"context"
+	"slices"
+
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/redisuser/types"
+)
+
+type State struct {
+	types.State
+	awsClient awsclient.ElastiCacheClient
+
+	user        *elasticachetypes.User
+	userGroup   *elasticachetypes.UserGroup
+	defaultUser *elasticachetypes.User
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, redisUser types.State) (*State, error)
+}
+
+func NewStateFactory(skrProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient]) StateFactory {
+	return &stateFactory{
+		skrProvider: skrProvider,
+	}
+}
+
+type stateFactory struct {
+	skrProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, redisUser types.State) (*State, error) {
+	roleName := awsutil.RoleArnDefault(redisUser.Scope().Spec.Scope.Aws.AccountId)
+
+	logger := composed.LoggerFromCtx(ctx)
+	logger.
+		WithValues(
+			"awsRegion", redisUser.Scope().Spec.Region,
+			"awsRole", roleName,
+		).
+		Info("Assuming AWS role")
+
+	c, err := f.skrProvider(
+		ctx,
+		redisUser.Scope().Spec.Scope.Aws.AccountId,
+		redisUser.Scope().Spec.Region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		roleName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState(redisUser, c), nil
+}
+
+func newState(redisUser types.State, elastiCacheClient awsclient.ElastiCacheClient) *State {
+	return &State{
+		State:     redisUser,
+		awsClient: elastiCacheClient,
+	}
+}
+
+func (s *State) userId() string {
+	return GetAwsElastiCacheUserId(s.Obj().GetName())
+}
+
+func (s *State) defaultUserId() string {
+	return GetAwsElastiCacheDefaultUserId(s.ObjAsRedisUser().Spec.Redis.Name)
+}
+
+func (s *State) userGroupName() string {
+	return GetAwsElastiCacheUserGroupName(s.ObjAsRedisUser().Spec.Redis.Name)
+}
+
+func (s *State) desiredAccessString() string {
+	redisUser := s.ObjAsRedisUser()
+	return GetAwsElastiCacheAccessString(redisUser.Spec.KeyPatterns, redisUser.Spec.Commands)
+}
+
+func (s *State) isUserInUserGroup() bool {
+	if s.userGroup == nil {
+		return false
+	}
+	for _, id := range s.userGroup.UserIds {
+		if id == s.userId() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBuiltInDefaultUser returns true if the user group still contains the built-in
+// default user that allows access without password
+func (s *State) hasBuiltInDefaultUser() bool {
+	return s.userGroup != nil && slices.Contains(s.userGroup.UserIds, awsmeta.ElastiCache_DefaultUser)
+}
+
+// hasOtherUsersInUserGroup returns true if the user group contains users of other RedisUsers
+func (s *State) hasOtherUsersInUserGroup() bool {
+	if s.userGroup == nil {
+		return false
+	}
+	for _, id := range s.userGroup.UserIds {
+		if id != s.userId() && id != s.defaultUserId() && id != awsmeta.ElastiCache_DefaultUser {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package redisuser
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	redisUser := state.ObjAsRedisUser()
+	hasChanged := false
+
+	endpoint := state.RedisEndpoint()
+	if redisUser.Status.Endpoint != endpoint {
+		redisUser.Status.Endpoint = endpoint
+		hasChanged = true
+	}
+
+	if redisUser.Status.ObservedGeneration != redisUser.Generation {
+		redisUser.Status.ObservedGeneration = redisUser.Generation
+		hasChanged = true
+	}
+
+	hasReadyCondition := meta.FindStatusCondition(redisUser.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady) != nil
+	hasReadyStatusState := redisUser.Status.State == cloudcontrolv1beta1.StateReady
+	if !hasChanged && hasReadyCondition && hasReadyStatusState {
+		composed.LoggerFromCtx(ctx).Info("RedisUser status fields are already up-to-date, StopAndForget-ing")
+		return composed.StopAndForget, nil
+	}
+
+	redisUser.Status.State = cloudcontrolv1beta1.StateReady
+	return composed.UpdateStatus(redisUser).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonReady,
+			Message: "Redis user is ready",
+		}).
+		ErrorLogMessage("Error updating KCP RedisUser status after setting Ready condition").
+		SuccessLogMsg("KCP RedisUser is ready").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
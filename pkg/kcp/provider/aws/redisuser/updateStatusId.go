@@ -0,0 +1,27 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func updateStatusId(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	redisUser := state.ObjAsRedisUser()
+
+	if redisUser.Status.Id != "" { // already set
+		return nil, ctx
+	}
+
+	redisUser.Status.Id = ptr.Deref(state.user.UserId, "")
+
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating RedisUser .status.id", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
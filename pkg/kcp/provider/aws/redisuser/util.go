@@ -0,0 +1,40 @@
+package redisuser
+
+import (
+	"fmt"
+	"strings"
+)
+
+func GetAwsElastiCacheUserId(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+// GetAwsElastiCacheDefaultUserId returns the id of the cloud-manager owned user named default with
+// access off, that replaces the built-in default user in the user group of the given redis.
+func GetAwsElastiCacheDefaultUserId(redisName string) string {
+	return fmt.Sprintf("cm-%s-default", redisName)
+}
+
+// GetAwsElastiCacheUserGroupName returns the name of the user group the redisinstance and
+// rediscluster providers attach to the replication group when auth is disabled.
+func GetAwsElastiCacheUserGroupName(redisName string) string {
+	return fmt.Sprintf("cm-%s", redisName)
+}
+
+// GetAwsElastiCacheAccessString builds the ElastiCache access string,
+// for example "on ~app1:* +@read -flushall"
+func GetAwsElastiCacheAccessString(keyPatterns, commands []string) string {
+	parts := []string{"on"}
+	if len(keyPatterns) == 0 {
+		keyPatterns = []string{"*"}
+	}
+	for _, p := range keyPatterns {
+		parts = append(parts, "~"+p)
+	}
+	if len(commands) == 0 {
+		commands = []string{"+@all"}
+	}
+	parts = append(parts, commands...)
+
+	return strings.Join(parts, " ")
+}
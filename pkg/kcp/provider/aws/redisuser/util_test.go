@@ -0,0 +1,39 @@
+package redisuser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAwsElastiCacheAccessString(t *testing.T) {
+	testCases := []struct {
+		name        string
+		keyPatterns []string
+		commands    []string
+		expected    string
+	}{
+		{
+			name:     "defaults when empty",
+			expected: "on ~* +@all",
+		},
+		{
+			name:        "single key pattern and read only",
+			keyPatterns: []string{"app1:*"},
+			commands:    []string{"+@read"},
+			expected:    "on ~app1:* +@read",
+		},
+		{
+			name:        "multiple key patterns and commands",
+			keyPatterns: []string{"app1:*", "shared:*"},
+			commands:    []string{"+@all", "-flushall", "-flushdb"},
+			expected:    "on ~app1:* ~shared:* +@all -flushall -flushdb",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetAwsElastiCacheAccessString(tc.keyPatterns, tc.commands))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func waitUserActive(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if ptr.Deref(state.user.Status, "") == awsmeta.ElastiCache_User_ACTIVE {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("ElastiCache user is not active yet, requeueing with delay")
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,19 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func waitUserDeleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.user == nil {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("ElastiCache user is still being deleted, requeueing with delay")
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,43 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// waitUserGroupActive requires the user group the redis providers attach when auth
+// is disabled, since users can only authenticate on replication groups with RBAC
+func waitUserGroupActive(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.userGroup == nil {
+		redisUser := state.ObjAsRedisUser()
+		logger.Info("ElastiCache user group not found, referred redis must have auth disabled")
+		redisUser.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisUser).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonInvalidDependency,
+				Message: fmt.Sprintf("Referred %s %s has no user group, users require redis with auth disabled", redisUser.Spec.Redis.Kind, redisUser.Spec.Redis.Name),
+			}).
+			ErrorLogMessage("Error updating RedisUser status due missing user group").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	if ptr.Deref(state.userGroup.Status, "") == awsmeta.ElastiCache_UserGroup_ACTIVE {
+		return nil, ctx
+	}
+
+	logger.Info("ElastiCache user group is not active yet, requeueing with delay")
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+)
+
+type Client interface {
+	DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error)
+	CreateFlowLogs(ctx context.Context, resourceId, logGroupName, deliverLogsPermissionArn string, tags []ec2types.Tag) (string, error)
+	DeleteFlowLogs(ctx context.Context, flowLogIds []string) error
+
+	awsclient.GuardDutyClient
+}
+
+func NewClientProvider() awsclient.SkrClientProvider[Client] {
+	return func(ctx context.Context, account, region, key, secret, role string) (Client, error) {
+		cfg, err := awsclient.NewSkrConfig(ctx, region, key, secret, role)
+		if err != nil {
+			return nil, err
+		}
+		return newClient(
+			awsclient.NewEc2Client(ec2.NewFromConfig(cfg)),
+			awsclient.NewGuardDutyClient(cfg),
+		), nil
+	}
+}
+
+func newClient(ec2Client awsclient.Ec2Client, guardDutyClient awsclient.GuardDutyClient) Client {
+	return &client{
+		Ec2Client:       ec2Client,
+		GuardDutyClient: guardDutyClient,
+	}
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	awsclient.Ec2Client
+	awsclient.GuardDutyClient
+}
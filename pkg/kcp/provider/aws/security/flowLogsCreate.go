@@ -0,0 +1,57 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+)
+
+func flowLogsCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if len(state.flowLogs) > 0 {
+		return nil, ctx
+	}
+
+	if state.VpcNetwork() == nil || state.VpcNetwork().Status.Identifiers.Vpc == "" {
+		return nil, ctx
+	}
+
+	shootName := state.ObjAsRuntime().Spec.Shoot.Name
+	vpcId := state.VpcNetwork().Status.Identifiers.Vpc
+	logGroupName := FlowLogGroupName(shootName)
+
+	logger.Info("Creating VPC flow log", "vpcId", vpcId, "logGroupName", logGroupName)
+
+	flowLogId, err := state.awsClient.CreateFlowLogs(
+		ctx,
+		vpcId,
+		logGroupName,
+		awsutil.RoleArnFlowLogs(state.Subscription().Status.SubscriptionInfo.Aws.Account),
+		awsutil.Ec2Tags(
+			"Name", FlowLogName(shootName),
+			tagKymaRuntimeId, state.ObjAsRuntime().Name,
+			tagKymaShootName, shootName,
+			tagKymaPurpose, tagValuePurposeNetworkFlowLogs,
+		),
+	)
+	if err != nil {
+		_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error creating VPC flow logs: %s", err.Error()), state.ObjAsRuntime().Generation)
+		return awsmeta.LogErrorAndReturn(err, "Error creating VPC flow log", ctx)
+	}
+
+	state.flowLogs = []ec2types.FlowLog{
+		{
+			FlowLogId:    new(flowLogId),
+			ResourceId:   new(vpcId),
+			LogGroupName: new(logGroupName),
+		},
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,39 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func flowLogsDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if len(state.flowLogs) == 0 {
+		return nil, ctx
+	}
+
+	flowLogIds := pie.Map(state.flowLogs, func(fl ec2types.FlowLog) string {
+		return ptr.Deref(fl.FlowLogId, "")
+	})
+	logger.Info("Deleting VPC flow logs", "flowLogIds", flowLogIds)
+
+	err := state.awsClient.DeleteFlowLogs(ctx, flowLogIds)
+	if awsmeta.IsNotFound(err) {
+		err = nil
+	}
+	if err != nil {
+		_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error deleting VPC flow logs: %s", err.Error()), state.ObjAsRuntime().Generation)
+		return awsmeta.LogErrorAndReturn(err, "Error deleting VPC flow logs", ctx)
+	}
+
+	state.flowLogs = nil
+
+	return nil, ctx
+}
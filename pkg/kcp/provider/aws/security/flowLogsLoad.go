@@ -0,0 +1,32 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+)
+
+func flowLogsLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.VpcNetwork() == nil || state.VpcNetwork().Status.Identifiers.Vpc == "" {
+		return nil, ctx
+	}
+
+	flowLogs, err := state.awsClient.DescribeFlowLogs(ctx, state.VpcNetwork().Status.Identifiers.Vpc)
+	if err != nil {
+		_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error loading VPC flow logs: %s", err.Error()), state.ObjAsRuntime().Generation)
+		return awsmeta.LogErrorAndReturn(err, "Error loading VPC flow logs", ctx)
+	}
+
+	state.flowLogs = pie.Filter(flowLogs, func(fl ec2types.FlowLog) bool {
+		return awsutil.GetEc2TagValue(fl.Tags, tagKymaRuntimeId) == state.ObjAsRuntime().Name
+	})
+
+	return nil, ctx
+}
@@ -0,0 +1,31 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func guardDutyDisable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if !state.guardDutyDetector.IsEnabled() {
+		return nil, ctx
+	}
+	if state.guardDutyDetector.Tags[tagKymaPurpose] != tagValuePurposeThreatDetection {
+		// not created by cloud-manager, account owner enabled it on their own
+		return nil, ctx
+	}
+
+	logger.Info("Disabling GuardDuty detector", "detectorId", state.guardDutyDetector.DetectorId)
+	err := state.awsClient.UpdateDetector(ctx, state.guardDutyDetector.DetectorId, false)
+	if err != nil {
+		_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error disabling GuardDuty: %s", err.Error()), state.ObjAsRuntime().Generation)
+		return awsmeta.LogErrorAndReturn(err, "Error disabling GuardDuty detector", ctx)
+	}
+
+	return composed.StopWithRequeue, ctx
+}
@@ -0,0 +1,39 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func guardDutyEnable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.guardDutyDetector.IsEnabled() {
+		return nil, ctx
+	}
+
+	if state.guardDutyDetector != nil {
+		logger.Info("Enabling GuardDuty detector", "detectorId", state.guardDutyDetector.DetectorId)
+		err := state.awsClient.UpdateDetector(ctx, state.guardDutyDetector.DetectorId, true)
+		if err != nil {
+			_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error enabling GuardDuty: %s", err.Error()), state.ObjAsRuntime().Generation)
+			return awsmeta.LogErrorAndReturn(err, "Error enabling GuardDuty detector", ctx)
+		}
+		return composed.StopWithRequeue, ctx
+	}
+
+	logger.Info("Creating GuardDuty detector")
+	_, err := state.awsClient.CreateDetector(ctx, map[string]string{
+		tagKymaPurpose: tagValuePurposeThreatDetection,
+	})
+	if err != nil {
+		_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error enabling GuardDuty: %s", err.Error()), state.ObjAsRuntime().Generation)
+		return awsmeta.LogErrorAndReturn(err, "Error creating GuardDuty detector", ctx)
+	}
+
+	return composed.StopWithRequeue, ctx
+}
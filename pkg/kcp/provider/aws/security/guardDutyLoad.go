@@ -0,0 +1,36 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func guardDutyLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	// GuardDuty allows only one detector per account and region
+	detectorIds, err := state.awsClient.ListDetectors(ctx)
+	if err != nil {
+		_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error loading GuardDuty detector: %s", err.Error()), state.ObjAsRuntime().Generation)
+		return awsmeta.LogErrorAndReturn(err, "Error listing GuardDuty detectors", ctx)
+	}
+	if len(detectorIds) == 0 {
+		return nil, ctx
+	}
+
+	detector, err := state.awsClient.GetDetector(ctx, detectorIds[0])
+	if awsmeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		_, _ = state.PatchStatusAnnotations(ctx, "Error", fmt.Sprintf("Error loading GuardDuty detector: %s", err.Error()), state.ObjAsRuntime().Generation)
+		return awsmeta.LogErrorAndReturn(err, "Error loading GuardDuty detector", ctx)
+	}
+
+	state.guardDutyDetector = detector
+
+	return nil, ctx
+}
@@ -0,0 +1,13 @@
+package security
+
+import (
+	"fmt"
+)
+
+func FlowLogName(shootName string) string {
+	return fmt.Sprintf("kyma-security-%s", shootName)
+}
+
+func FlowLogGroupName(shootName string) string {
+	return fmt.Sprintf("/kyma/security/%s/vpc-flow-logs", shootName)
+}
@@ -8,15 +8,32 @@ import (
 )
 
 func New(sf StateFactory) composed.Action {
-	return func(ctx context.Context, state composed.State) (error, context.Context) {
-		runtimeState := state.(runtimetypes.State)
-		ctx, state, err := sf.NewState(ctx, runtimeState)
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		runtimeState := st.(runtimetypes.State)
+		cctx, state, err := sf.NewState(ctx, runtimeState)
+		if cctx != nil {
+			ctx = cctx
+		}
 		if err != nil {
 			return err, ctx
 		}
 
 		return composed.ComposeActionsNoName(
-			composed.Noop,
+			flowLogsLoad,
+			composed.IfElse(
+				runtimeState.SecurityDataSourceEnabledOnRuntimePredicate,
+				// create data sources - enable VPC flow logs
+				flowLogsCreate,
+				// delete data sources - disable VPC flow logs
+				flowLogsDelete,
+			),
+			// toggle GuardDuty threat detection on/off
+			guardDutyLoad,
+			composed.IfElse(
+				runtimeState.SecurityServiceEnabledOnSubscriptionPredicate,
+				guardDutyEnable,
+				guardDutyDisable,
+			),
 		)(ctx, state)
 	}
 }
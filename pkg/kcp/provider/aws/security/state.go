@@ -2,31 +2,94 @@ package security
 
 import (
 	"context"
+	"fmt"
 
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	awssecurityclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/security/client"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
 	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
 )
 
-type State struct {
-	runtimetypes.State
-}
-
 type StateFactory interface {
-	NewState(ctx context.Context, runtimeState runtimetypes.State) (context.Context, *State, error)
+	NewState(ctx context.Context, runtimeState runtimetypes.State) (context.Context, composed.State, error)
 }
 
-func NewStateFactory() StateFactory {
-	return &stateFactory{}
+func NewStateFactory(awsClientProvider awsclient.SkrClientProvider[awssecurityclient.Client]) StateFactory {
+	return &stateFactory{
+		awsClientProvider: awsClientProvider,
+	}
 }
 
 type stateFactory struct {
+	awsClientProvider awsclient.SkrClientProvider[awssecurityclient.Client]
 }
 
-func (f *stateFactory) NewState(ctx context.Context, runtimeState runtimetypes.State) (context.Context, *State, error) {
-	return ctx, newState(runtimeState), nil
+func (f *stateFactory) NewState(ctx context.Context, runtimeState runtimetypes.State) (context.Context, composed.State, error) {
+	if runtimeState.Subscription().Status.Provider != cloudcontrolv1beta1.ProviderAws {
+		return ctx, nil, fmt.Errorf("subscription for Runtime must be of provider AWS, but subscription %q is of provider %q", runtimeState.Subscription().Name, runtimeState.Subscription().Status.Provider)
+	}
+	if runtimeState.Subscription().Status.SubscriptionInfo.Aws == nil {
+		return ctx, nil, fmt.Errorf("subscription for Runtime is of AWS provider but its subscription info is nil")
+	}
+
+	account := runtimeState.Subscription().Status.SubscriptionInfo.Aws.Account
+	region := runtimeState.ObjAsRuntime().Spec.Shoot.Region
+	roleName := awsutil.RoleArnDefault(account)
+
+	logger := composed.LoggerFromCtx(ctx).
+		WithValues(
+			"awsAccount", account,
+			"awsAssumeRoleName", roleName,
+		)
+	ctx = composed.LoggerIntoCtx(ctx, logger)
+
+	c, err := f.awsClientProvider(
+		ctx,
+		account,
+		region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		roleName,
+	)
+	if err != nil {
+		return ctx, nil, fmt.Errorf("error creating aws client: %w", err)
+	}
+
+	return ctx, newState(runtimeState, c), nil
 }
 
-func newState(baseState runtimetypes.State) *State {
+func newState(runtimeState runtimetypes.State, awsClient awssecurityclient.Client) *State {
 	return &State{
-		State: baseState,
+		State:     runtimeState,
+		awsClient: awsClient,
 	}
 }
+
+type State struct {
+	runtimetypes.State
+
+	awsClient awssecurityclient.Client
+
+	// flowLogs of the runtime VPC tagged with tagKymaRuntimeId = runtime.name, normally at most one.
+	// Flow logs deliver into the CloudWatch log group with name pattern "/kyma/security/{shootName}/vpc-flow-logs"
+	// that the flow log service creates on first delivery. The log group is not deleted when security
+	// is disabled, so already delivered logs stay available until their retention expires.
+	flowLogs []ec2types.FlowLog
+
+	// guardDutyDetector is the single GuardDuty detector of the account in the runtime region, nil if none exists.
+	// It is only disabled if it was created by cloud-manager, detectors created by the account owner are left as they are.
+	guardDutyDetector *awsclient.GuardDutyDetector
+}
+
+const (
+	tagKymaRuntimeId = "kyma.runtime-id"
+	tagKymaShootName = "kyma.shoot-name"
+	tagKymaPurpose   = "kyma.purpose"
+
+	tagValuePurposeNetworkFlowLogs = "networkFlowLogs"
+	tagValuePurposeThreatDetection = "threatDetection"
+)
@@ -51,6 +51,10 @@ func RoleArnBackup(accountId string) string {
 	return RoleArn(accountId, awsconfig.AwsConfig.BackupRoleName)
 }
 
+func RoleArnFlowLogs(accountId string) string {
+	return RoleArn(accountId, awsconfig.AwsConfig.FlowLogsRoleName)
+}
+
 // EFS ============================================================================
 
 // EfsArn returns string arn in form "arn:<partition>:elasticfilesystem:<region>:<accountId>:file-system/<fileSystemId>"
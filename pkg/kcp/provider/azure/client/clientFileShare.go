@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+)
+
+type NfsFileShareClient interface {
+	GetNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string) (*armstorage.FileShare, error)
+	CreateNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string, parameters armstorage.FileShare) error
+	UpdateNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string, parameters armstorage.FileShare) error
+	DeleteNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string) error
+}
+
+func NewNfsFileShareClient(svc *armstorage.FileSharesClient) NfsFileShareClient {
+	return &nfsFileShareClient{svc: svc}
+}
+
+var _ NfsFileShareClient = &nfsFileShareClient{}
+
+type nfsFileShareClient struct {
+	svc *armstorage.FileSharesClient
+}
+
+func (c *nfsFileShareClient) GetNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string) (*armstorage.FileShare, error) {
+	resp, err := c.svc.Get(ctx, resourceGroupName, accountName, shareName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.FileShare, nil
+}
+
+func (c *nfsFileShareClient) CreateNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string, parameters armstorage.FileShare) error {
+	_, err := c.svc.Create(ctx, resourceGroupName, accountName, shareName, parameters, nil)
+	return err
+}
+
+func (c *nfsFileShareClient) UpdateNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string, parameters armstorage.FileShare) error {
+	_, err := c.svc.Update(ctx, resourceGroupName, accountName, shareName, parameters, nil)
+	return err
+}
+
+func (c *nfsFileShareClient) DeleteNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string) error {
+	_, err := c.svc.Delete(ctx, resourceGroupName, accountName, shareName, nil)
+	return err
+}
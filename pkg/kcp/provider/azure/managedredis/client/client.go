@@ -32,6 +32,12 @@ type ManagedRedisClient interface {
 	ListKeys(ctx context.Context, resourceGroupName, clusterName, databaseName string) (*armredisenterprise.AccessKeys, error)
 	// ListSKUsForScaling returns the SKUs that the cluster can be scaled to.
 	ListSKUsForScaling(ctx context.Context, resourceGroupName, clusterName string) ([]string, error)
+	// CreateOrUpdateAccessPolicyAssignment assigns a database access policy to an Entra ID principal.
+	CreateOrUpdateAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string, assignment armredisenterprise.AccessPolicyAssignment) error
+	// GetAccessPolicyAssignment retrieves the current state of a database access policy assignment.
+	GetAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) (*armredisenterprise.AccessPolicyAssignment, error)
+	// DeleteAccessPolicyAssignment begins deletion of a database access policy assignment.
+	DeleteAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) error
 }
 
 // Client composes ManagedRedisClient with reused networking clients.
@@ -61,6 +67,11 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 			return nil, err
 		}
 
+		accessPolicyAssignmentClient, err := armredisenterprise.NewAccessPolicyAssignmentClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
 		privateEndPointsClient, err := armnetwork.NewPrivateEndpointsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
 		if err != nil {
 			return nil, err
@@ -77,7 +88,7 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 		}
 
 		return newClient(
-			newManagedRedisClient(redisEnterpriseClient, databasesClient),
+			newManagedRedisClient(redisEnterpriseClient, databasesClient, accessPolicyAssignmentClient),
 			azureclient.NewPrivateEndPointClient(privateEndPointsClient),
 			azureclient.NewPrivateDnsZoneGroupClient(privateDnsZoneGroupClient),
 			azureclient.NewPrivateDnsZoneClient(privateDnsClientFactory.NewPrivateZonesClient()),
@@ -87,14 +98,20 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 }
 
 type managedRedisClientImpl struct {
-	clustersClient  *armredisenterprise.Client
-	databasesClient *armredisenterprise.DatabasesClient
+	clustersClient               *armredisenterprise.Client
+	databasesClient              *armredisenterprise.DatabasesClient
+	accessPolicyAssignmentClient *armredisenterprise.AccessPolicyAssignmentClient
 }
 
-func newManagedRedisClient(clustersClient *armredisenterprise.Client, databasesClient *armredisenterprise.DatabasesClient) ManagedRedisClient {
+func newManagedRedisClient(
+	clustersClient *armredisenterprise.Client,
+	databasesClient *armredisenterprise.DatabasesClient,
+	accessPolicyAssignmentClient *armredisenterprise.AccessPolicyAssignmentClient,
+) ManagedRedisClient {
 	return &managedRedisClientImpl{
-		clustersClient:  clustersClient,
-		databasesClient: databasesClient,
+		clustersClient:               clustersClient,
+		databasesClient:              databasesClient,
+		accessPolicyAssignmentClient: accessPolicyAssignmentClient,
 	}
 }
 
@@ -175,6 +192,24 @@ func (c *managedRedisClientImpl) ListSKUsForScaling(ctx context.Context, resourc
 	return result, nil
 }
 
+func (c *managedRedisClientImpl) CreateOrUpdateAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string, assignment armredisenterprise.AccessPolicyAssignment) error {
+	_, err := c.accessPolicyAssignmentClient.BeginCreateUpdate(ctx, resourceGroupName, clusterName, databaseName, assignmentName, assignment, nil)
+	return err
+}
+
+func (c *managedRedisClientImpl) GetAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) (*armredisenterprise.AccessPolicyAssignment, error) {
+	resp, err := c.accessPolicyAssignmentClient.Get(ctx, resourceGroupName, clusterName, databaseName, assignmentName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.AccessPolicyAssignment, nil
+}
+
+func (c *managedRedisClientImpl) DeleteAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) error {
+	_, err := c.accessPolicyAssignmentClient.BeginDelete(ctx, resourceGroupName, clusterName, databaseName, assignmentName, nil)
+	return err
+}
+
 // compositeClient embeds all sub-clients.
 type compositeClient struct {
 	ManagedRedisClient
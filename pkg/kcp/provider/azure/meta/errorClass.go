@@ -0,0 +1,36 @@
+package meta
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func init() {
+	util.RegisterErrorClassifier(ClassifyError)
+}
+
+// ClassifyError maps Azure response and authentication errors to the util.ErrorClass
+// constants. It returns an empty string for errors not coming from Azure.
+func ClassifyError(err error) string {
+	if IsUnauthenticated(err) {
+		return util.ErrorClassForbidden
+	}
+
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		switch {
+		case strings.Contains(respErr.ErrorCode, "Quota"), strings.Contains(respErr.ErrorCode, "LimitExceeded"):
+			return util.ErrorClassQuota
+		case respErr.ErrorCode == AuthorizationFailed, respErr.ErrorCode == InvalidAuthenticationTokenTenant:
+			return util.ErrorClassForbidden
+		case respErr.ErrorCode == "ResourceNotFound":
+			return util.ErrorClassNotFound
+		}
+		return util.ErrorClassFromHttpStatus(respErr.StatusCode)
+	}
+
+	return ""
+}
@@ -0,0 +1,26 @@
+package meta
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	assert.Equal(t, util.ErrorClassNotFound, util.ClassifyError(NewAzureNotFoundError()))
+	assert.Equal(t, util.ErrorClassThrottled, util.ClassifyError(NewAzureTooManyRequestsError()))
+	assert.Equal(t, util.ErrorClassForbidden, util.ClassifyError(NewAzureAuthorizationFailedError()))
+	assert.Equal(t, util.ErrorClassForbidden, util.ClassifyError(NewAzureAuthenticationFailedError()))
+	assert.Equal(t, util.ErrorClassConflict, util.ClassifyError(NewAzureConflictError()))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(&azcore.ResponseError{
+		ErrorCode:  "QuotaExceeded",
+		StatusCode: http.StatusConflict,
+	}))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(&azcore.ResponseError{
+		ErrorCode:  "SubscriptionRequestsLimitExceeded",
+		StatusCode: http.StatusBadRequest,
+	}))
+}
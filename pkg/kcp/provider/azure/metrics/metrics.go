@@ -6,9 +6,11 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 )
 
 const pathPlaceholder = "{id}"
@@ -32,7 +34,8 @@ func RegionFromContext(ctx context.Context) string {
 	return ""
 }
 
-// metricsPolicy counts Azure API calls in the CloudProviderCallCount metric.
+// metricsPolicy counts Azure API calls in the CloudProviderCallCount metric and
+// records their latency in the CloudProviderCallDuration metric.
 // It is injected as a PerRetryPolicy, so it runs below the SDK retry policy and
 // observes every HTTP attempt rather than only the outcome of the SDK call.
 // azcore retries 408, 429 and 5xx three times by default, so counting above the
@@ -51,7 +54,11 @@ func NewMetricsPolicy() policy.Policy {
 }
 
 func (p *metricsPolicy) Do(req *policy.Request) (*http.Response, error) {
+	start := time.Now()
+	done := metrics.CloudProviderCallStarted(metrics.CloudProviderAzure)
 	resp, err := req.Next()
+	done()
+	latency := time.Since(start)
 
 	responseCode := 0
 	if resp != nil {
@@ -69,13 +76,15 @@ func (p *metricsPolicy) Do(req *policy.Request) (*http.Response, error) {
 		region = urlRegion
 	}
 
-	metrics.CloudProviderCallCount.WithLabelValues(
+	metrics.ReportCloudProviderCall(
 		metrics.CloudProviderAzure,
 		fmt.Sprintf("%s %s", req.Raw().Method, sanitizedPath),
 		fmt.Sprintf("%d", responseCode),
 		region,
 		subscription,
-	).Inc()
+		latency,
+	)
+	tracing.RecordCloudProviderCall(req.Raw().Context(), metrics.CloudProviderAzure, fmt.Sprintf("%s %s", req.Raw().Method, sanitizedPath), fmt.Sprintf("%d", responseCode), start, err)
 
 	return resp, err
 }
@@ -13,7 +13,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -334,3 +336,27 @@ func TestMetricsPolicyContextRegionOverridesPath(t *testing.T) {
 		"GET /subscriptions/{id}/providers/Microsoft.Cache/locations/{id}/asyncOperations/{id}",
 		"200", "eastus", "sub-lro"))
 }
+
+func TestMetricsPolicyRecordsLatency(t *testing.T) {
+	metrics.CloudProviderCallDuration.Reset()
+
+	pl := newMetricsTestPipeline(&fakeTransport{statusCode: http.StatusOK, body: "{}"})
+	ctx := RegionIntoContext(context.Background(), "eastus")
+	req, err := runtime.NewRequest(ctx, http.MethodGet,
+		"https://management.azure.com/subscriptions/sub-latency/resourceGroups/rg-1/providers/Microsoft.Network/virtualNetworks/vnet-1")
+	assert.NoError(t, err)
+
+	_, err = pl.Do(req)
+	assert.NoError(t, err)
+
+	m := &dto.Metric{}
+	h := metrics.CloudProviderCallDuration.WithLabelValues(
+		metrics.CloudProviderAzure,
+		"GET /subscriptions/{id}/resourceGroups/{id}/providers/Microsoft.Network/virtualNetworks/{id}",
+		"200",
+		"eastus",
+	)
+	assert.NoError(t, h.(prometheus.Histogram).Write(m))
+	assert.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.CloudProviderCallInFlight.WithLabelValues(metrics.CloudProviderAzure)))
+}
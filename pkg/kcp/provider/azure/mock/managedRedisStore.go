@@ -20,6 +20,8 @@ func newManagedRedisStore(subscription string) *managedRedisStore {
 		items:        map[string]map[string]*armredisenterprise.Cluster{},
 		databases:    map[string]map[string]map[string]*armredisenterprise.Database{},
 		accessKeys:   map[string]map[string]*armredisenterprise.AccessKeys{},
+
+		accessPolicyAssignments: map[string]*armredisenterprise.AccessPolicyAssignment{},
 	}
 }
 
@@ -34,6 +36,12 @@ type managedRedisStore struct {
 	databases map[string]map[string]map[string]*armredisenterprise.Database
 	// accessKeys are resourceGroupName => clusterName => AccessKeys
 	accessKeys map[string]map[string]*armredisenterprise.AccessKeys
+	// accessPolicyAssignments are keyed by accessPolicyAssignmentKey()
+	accessPolicyAssignments map[string]*armredisenterprise.AccessPolicyAssignment
+}
+
+func accessPolicyAssignmentKey(resourceGroupName, clusterName, databaseName, assignmentName string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", resourceGroupName, clusterName, databaseName, assignmentName)
 }
 
 func (s *managedRedisStore) CreateOrUpdateCluster(ctx context.Context, resourceGroupName, clusterName string, cluster armredisenterprise.Cluster) error {
@@ -178,6 +186,14 @@ func (s *managedRedisStore) DeleteCluster(ctx context.Context, resourceGroupName
 		delete(s.databases[resourceGroupName], clusterName)
 	}
 
+	// Clean up access policy assignments
+	prefix := fmt.Sprintf("%s/%s/", resourceGroupName, clusterName)
+	for key := range s.accessPolicyAssignments {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.accessPolicyAssignments, key)
+		}
+	}
+
 	return nil
 }
 
@@ -348,3 +364,66 @@ func (s *managedRedisStore) ListSKUsForScaling(ctx context.Context, resourceGrou
 	}
 	return []string{}, nil
 }
+
+func (s *managedRedisStore) CreateOrUpdateAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string, assignment armredisenterprise.AccessPolicyAssignment) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.databases[resourceGroupName] == nil || s.databases[resourceGroupName][clusterName] == nil {
+		return azuremeta.NewAzureNotFoundError()
+	}
+	if _, exists := s.databases[resourceGroupName][clusterName][databaseName]; !exists {
+		return azuremeta.NewAzureNotFoundError()
+	}
+
+	if assignment.Properties == nil || assignment.Properties.AccessPolicyName == nil || *assignment.Properties.AccessPolicyName != "default" {
+		return fmt.Errorf("mock: only the default access policy can be assigned")
+	}
+
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/redisEnterprise/%s/databases/%s/accessPolicyAssignments/%s",
+		s.subscription, resourceGroupName, clusterName, databaseName, assignmentName)
+	assignment.ID = &id
+	assignment.Name = &assignmentName
+	provisioningState := armredisenterprise.ProvisioningStateSucceeded
+	assignment.Properties.ProvisioningState = &provisioningState
+
+	cloned, _ := util.JsonClone(&assignment)
+	s.accessPolicyAssignments[accessPolicyAssignmentKey(resourceGroupName, clusterName, databaseName, assignmentName)] = cloned
+	return nil
+}
+
+func (s *managedRedisStore) GetAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) (*armredisenterprise.AccessPolicyAssignment, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	assignment, exists := s.accessPolicyAssignments[accessPolicyAssignmentKey(resourceGroupName, clusterName, databaseName, assignmentName)]
+	if !exists {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	res, err := util.JsonClone(assignment)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *managedRedisStore) DeleteAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	delete(s.accessPolicyAssignments, accessPolicyAssignmentKey(resourceGroupName, clusterName, databaseName, assignmentName))
+	return nil
+}
+
+func (s *managedRedisStore) GetManagedRedisAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) (*armredisenterprise.AccessPolicyAssignment, error) {
+	return s.GetAccessPolicyAssignment(ctx, resourceGroupName, clusterName, databaseName, assignmentName)
+}
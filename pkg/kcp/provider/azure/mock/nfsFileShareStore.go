@@ -0,0 +1,134 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+var _ NfsFileShareClient = &nfsFileShareStore{}
+
+func newNfsFileShareStore(subscription string) *nfsFileShareStore {
+	return &nfsFileShareStore{
+		subscription: subscription,
+		items:        map[string]map[string]map[string]*armstorage.FileShare{},
+	}
+}
+
+type nfsFileShareStore struct {
+	m sync.Mutex
+
+	subscription string
+
+	// items are resourceGroupName => accountName => shareName => armstorage.FileShare
+	items map[string]map[string]map[string]*armstorage.FileShare
+}
+
+func (s *nfsFileShareStore) GetNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string) (*armstorage.FileShare, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	share, err := s.getNfsFileShareNonLocking(resourceGroupName, accountName, shareName)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.JsonClone(share)
+}
+
+func (s *nfsFileShareStore) getNfsFileShareNonLocking(resourceGroupName, accountName, shareName string) (*armstorage.FileShare, error) {
+	group, ok := s.items[resourceGroupName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	account, ok := group[accountName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	share, ok := account[shareName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+
+	return share, nil
+}
+
+func (s *nfsFileShareStore) CreateNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string, parameters armstorage.FileShare) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, ok := s.items[resourceGroupName]; !ok {
+		s.items[resourceGroupName] = map[string]map[string]*armstorage.FileShare{}
+	}
+	if _, ok := s.items[resourceGroupName][accountName]; !ok {
+		s.items[resourceGroupName][accountName] = map[string]*armstorage.FileShare{}
+	}
+	if _, ok := s.items[resourceGroupName][accountName][shareName]; ok {
+		return fmt.Errorf("file share %s already exist", shareName)
+	}
+
+	props := &armstorage.FileShareProperties{}
+	if parameters.FileShareProperties != nil {
+		if err := util.JsonCloneInto(parameters.FileShareProperties, props); err != nil {
+			return err
+		}
+	}
+
+	id := fmt.Sprintf("%s/fileServices/default/shares/%s", azureutil.NewStorageAccountResourceId(s.subscription, resourceGroupName, accountName).String(), shareName)
+
+	s.items[resourceGroupName][accountName][shareName] = &armstorage.FileShare{
+		ID:                  new(id),
+		Name:                new(shareName),
+		Type:                new("Microsoft.Storage/storageAccounts/fileServices/shares"),
+		FileShareProperties: props,
+	}
+
+	return nil
+}
+
+func (s *nfsFileShareStore) UpdateNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string, parameters armstorage.FileShare) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	share, err := s.getNfsFileShareNonLocking(resourceGroupName, accountName, shareName)
+	if err != nil {
+		return err
+	}
+
+	if parameters.FileShareProperties != nil && parameters.FileShareProperties.ShareQuota != nil {
+		share.FileShareProperties.ShareQuota = ptr.To(*parameters.FileShareProperties.ShareQuota)
+	}
+
+	return nil
+}
+
+func (s *nfsFileShareStore) DeleteNfsFileShare(ctx context.Context, resourceGroupName, accountName, shareName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, err := s.getNfsFileShareNonLocking(resourceGroupName, accountName, shareName); err != nil {
+		return err
+	}
+
+	delete(s.items[resourceGroupName][accountName], shareName)
+
+	return nil
+}
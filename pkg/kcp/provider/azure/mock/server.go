@@ -16,6 +16,7 @@ import (
 	azureiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/iprange/client"
 	azuremanagedredisclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/managedredis/client"
 	azurenetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/network/client"
+	azurenfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nfsinstance/client"
 	azureredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/rediscluster/client"
 	azureredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance/client"
 	azurevpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering/client"
@@ -68,6 +69,12 @@ func (s *server) RedisClientProvider() azureclient.ClientProvider[azureredisinst
 	}
 }
 
+func (s *server) NfsInstanceProvider() azureclient.ClientProvider[azurenfsinstanceclient.Client] {
+	return func(ctx context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azurenfsinstanceclient.Client, error) {
+		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
+	}
+}
+
 func (s *server) RedisClusterClientProvider() azureclient.ClientProvider[azureredisclusterclient.Client] {
 	return func(ctx context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azureredisclusterclient.Client, error) {
 		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
 	"github.com/google/uuid"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
 	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
 	"k8s.io/utils/ptr"
 )
@@ -33,16 +35,66 @@ type storageStore struct {
 	vaults                 []*armrecoveryservices.Vault
 	protectedItems         map[string][]*armrecoveryservicesbackup.ProtectedItemResource
 	backupProtectableItems []*armrecoveryservicesbackup.WorkloadProtectableItemResource
+	recoveryPoints         map[string][]*armrecoveryservicesbackup.RecoveryPointResource
+}
+
+// backupJobs returns the backup jobs of the fileshare in the vault, must be called with the lock held
+func (s *storageStore) backupJobs(vaultName, resourceGroupName, fileShareName string) []*armrecoveryservicesbackup.JobDetailsClientGetResponse {
+	var result []*armrecoveryservicesbackup.JobDetailsClientGetResponse
+	idPathPrefix := client.GetVaultPath(s.subscription, resourceGroupName, vaultName) + "/backupJobs/"
+	for _, job := range s.jobs {
+		j := job.Properties.GetJob()
+		if ptr.Deref(j.Operation, "") != string(armrecoveryservicesbackup.JobOperationTypeBackup) {
+			continue
+		}
+		if ptr.Deref(j.EntityFriendlyName, "") != fileShareName || !strings.HasPrefix(ptr.Deref(job.ID, ""), idPathPrefix) {
+			continue
+		}
+		result = append(result, job)
+	}
+	return result
 }
 
 func (s *storageStore) GetLastBackupJobStartTime(ctx context.Context, vaultName string, resourceGroupName string, fileShareName string, startTime time.Time) (*time.Time, error) {
-	//TODO implement me
-	return nil, errors.New("not implemented")
+	if isContextCanceled(ctx) {
+		return nil, errors.New("context canceled")
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	var lastStartTime *time.Time
+	for _, job := range s.backupJobs(vaultName, resourceGroupName, fileShareName) {
+		jobStartTime := job.Properties.GetJob().StartTime
+		if jobStartTime == nil || jobStartTime.Before(startTime) {
+			continue
+		}
+		if lastStartTime == nil || lastStartTime.Before(*jobStartTime) {
+			lastStartTime = jobStartTime
+		}
+	}
+	return lastStartTime, nil
 }
 
 func (s *storageStore) FindNextBackupJobId(ctx context.Context, vaultName string, resourceGroupName string, fileShareName string, startTime time.Time) (*string, error) {
-	//TODO implement me
-	return nil, errors.New("not implemented")
+	if isContextCanceled(ctx) {
+		return nil, errors.New("context canceled")
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	var jobIds []string
+	for _, job := range s.backupJobs(vaultName, resourceGroupName, fileShareName) {
+		jobStartTime := job.Properties.GetJob().StartTime
+		if jobStartTime == nil || !jobStartTime.After(startTime) {
+			continue
+		}
+		jobIds = append(jobIds, *job.Name)
+	}
+	if len(jobIds) > 1 {
+		return nil, fmt.Errorf("multiple backup jobs found for file share %s after specified start time of %s", fileShareName, startTime)
+	}
+	if len(jobIds) == 1 {
+		return &jobIds[0], nil
+	}
+	return nil, nil
 }
 
 func (s *storageStore) FindRestoreJobId(ctx context.Context, vaultName string, resourceGroupName string, fileShareName string, startFilter string, restoreFolderPath string) (*string, bool, error) {
@@ -133,9 +185,10 @@ func (s *storageStore) CreateOrUpdateProtectedItem(ctx context.Context, subscrip
 	protected := armrecoveryservicesbackup.ProtectedItemResource{
 		Location: new(location),
 		ID:       new(id),
+		Name:     new(protectedItemName),
 		Properties: &armrecoveryservicesbackup.AzureFileshareProtectedItem{
 			ProtectionState: to.Ptr(armrecoveryservicesbackup.ProtectionStateProtected),
-			FriendlyName:    new(protectedItemName),
+			FriendlyName:    new(s.friendlyName(protectedItemName)),
 		},
 	}
 
@@ -193,14 +246,65 @@ func (s *storageStore) RemoveProtection(ctx context.Context, vaultName, resource
 	return nil
 }
 
+// friendlyName returns the fileshare name of the protectable item, must be called with the lock held
+func (s *storageStore) friendlyName(protectableItemName string) string {
+	for _, item := range s.backupProtectableItems {
+		if ptr.Deref(item.Name, "") != protectableItemName {
+			continue
+		}
+		if props, ok := item.Properties.(*armrecoveryservicesbackup.AzureFileShareProtectableItem); ok {
+			return ptr.Deref(props.FriendlyName, protectableItemName)
+		}
+	}
+	return protectableItemName
+}
+
+func recoveryPointsKey(vaultName, resourceGroupName, containerName, protectedItemName string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", resourceGroupName, vaultName, containerName, protectedItemName)
+}
+
 func (s *storageStore) GetRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string, recoveryPointId string) (*armrecoveryservicesbackup.RecoveryPointResource, error) {
-	//TODO implement me
-	return nil, errors.New("not implemented")
+	if isContextCanceled(ctx) {
+		return nil, errors.New("context canceled")
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, rp := range s.recoveryPoints[recoveryPointsKey(vaultName, resourceGroupName, containerName, protectedItemName)] {
+		if ptr.Deref(rp.Name, "") == recoveryPointId {
+			return rp, nil
+		}
+	}
+	return nil, azuremeta.NewAzureNotFoundError()
 }
 
 func (s *storageStore) ListRecoveryPoints(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string) ([]*armrecoveryservicesbackup.RecoveryPointResource, error) {
-	//TODO implement me
-	return nil, errors.New("not implemented")
+	if isContextCanceled(ctx) {
+		return nil, errors.New("context canceled")
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.recoveryPoints[recoveryPointsKey(vaultName, resourceGroupName, containerName, protectedItemName)], nil
+}
+
+func (s *storageStore) DeleteRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, containerName string, protectedItemName string, recoveryPointId string) error {
+	if isContextCanceled(ctx) {
+		return errors.New("context canceled")
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	logger := composed.LoggerFromCtx(ctx)
+
+	key := recoveryPointsKey(vaultName, resourceGroupName, containerName, protectedItemName)
+	temp := s.recoveryPoints[key][:0]
+	for _, rp := range s.recoveryPoints[key] {
+		if ptr.Deref(rp.Name, "") != recoveryPointId {
+			temp = append(temp, rp)
+		}
+	}
+	s.recoveryPoints[key] = temp
+
+	logger.Info("mock: Delete Recovery Point", "recovery-point-id", recoveryPointId)
+	return nil
 }
 
 func (s *storageStore) CreateVault(ctx context.Context, resourceGroupName string, vaultName string, location string) (*string, error) {
@@ -258,12 +362,50 @@ func (s *storageStore) ListVaults(ctx context.Context) ([]*armrecoveryservices.V
 }
 
 func (s *storageStore) TriggerBackup(ctx context.Context, vaultName, resourceGroupName, containerName, protectedItemName, location string) error {
+	if isContextCanceled(ctx) {
+		return errors.New("context canceled")
+	}
 	s.m.Lock()
 	defer s.m.Unlock()
+	logger := composed.LoggerFromCtx(ctx)
 
-	// TODO: create unhappy path?
-	return nil
+	_, storageAccountName, err := client.ParseContainerName(containerName)
+	if err != nil {
+		return err
+	}
+	fileShareName := s.friendlyName(protectedItemName)
 
+	jobId := uuid.NewString()
+	idPath := client.GetVaultPath(s.subscription, resourceGroupName, vaultName) + "/backupJobs/" + jobId
+	s.jobs[jobId] = &armrecoveryservicesbackup.JobDetailsClientGetResponse{
+		JobResource: armrecoveryservicesbackup.JobResource{
+			ID:   &idPath,
+			Name: new(jobId),
+			Properties: new(armrecoveryservicesbackup.AzureStorageJob{
+				Status:             to.Ptr(string(armrecoveryservicesbackup.JobStatusInProgress)),
+				EntityFriendlyName: new(fileShareName),
+				StartTime:          new(time.Now()),
+				Operation:          to.Ptr(string(armrecoveryservicesbackup.JobOperationTypeBackup)),
+			}),
+		},
+	}
+
+	// the recovery point is taken while the job runs, so it is listed once the job completes
+	recoveryPointName := uuid.NewString()
+	key := recoveryPointsKey(vaultName, resourceGroupName, containerName, protectedItemName)
+	s.recoveryPoints[key] = append(s.recoveryPoints[key], &armrecoveryservicesbackup.RecoveryPointResource{
+		ID:   new(client.GetRecoveryPointPath(s.subscription, resourceGroupName, vaultName, storageAccountName, protectedItemName, recoveryPointName)),
+		Name: new(recoveryPointName),
+		Properties: &armrecoveryservicesbackup.AzureFileShareRecoveryPoint{
+			ObjectType:            new("AzureFileShareRecoveryPoint"),
+			RecoveryPointTime:     new(time.Now()),
+			RecoveryPointSizeInGB: new(int32(1)),
+			FileShareSnapshotURI:  new(fmt.Sprintf("https://%s.file.core.windows.net/%s?sharesnapshot=%s", storageAccountName, fileShareName, time.Now().UTC().Format(time.RFC3339Nano))),
+		},
+	})
+
+	logger.Info("mock: Trigger Backup", "job-id", jobId, "recovery-point", recoveryPointName)
+	return nil
 }
 
 func (s *storageStore) ListProtectedItems(ctx context.Context, vaultName string, resourceGroupName string) ([]*armrecoveryservicesbackup.ProtectedItemResource, error) {
@@ -357,5 +499,6 @@ func newStorageStore(subscription string) *storageStore {
 		jobs:                   make(map[string]*armrecoveryservicesbackup.JobDetailsClientGetResponse),
 		protectedItems:         make(map[string][]*armrecoveryservicesbackup.ProtectedItemResource),
 		backupProtectableItems: backupProtectableItems,
+		recoveryPoints:         make(map[string][]*armrecoveryservicesbackup.RecoveryPointResource),
 	}
 }
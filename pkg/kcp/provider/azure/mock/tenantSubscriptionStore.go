@@ -23,6 +23,7 @@ type tenantSubscriptionStore struct {
 	*operationalInsightsStore
 	*securityStore
 	*storageAccountStore
+	*nfsFileShareStore
 	*managedRedisStore
 
 	tenant       string
@@ -52,6 +53,7 @@ func newTenantSubscriptionStore(tenant, subscription string, srv Server) *tenant
 		operationalInsightsStore:  newOperationalInsightsStore(subscription),
 		securityStore:             newSecurityStore(subscription),
 		storageAccountStore:       newStorageAccountStore(subscription),
+		nfsFileShareStore:         newNfsFileShareStore(subscription),
 		managedRedisStore:         newManagedRedisStore(subscription),
 		tenant:                    tenant,
 		subscription:              subscription,
@@ -16,6 +16,7 @@ import (
 	azureiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/iprange/client"
 	azuremanagedredisclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/managedredis/client"
 	azurenetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/network/client"
+	azurenfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nfsinstance/client"
 	azureredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/rediscluster/client"
 	azureredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance/client"
 	azurevpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering/client"
@@ -91,6 +92,10 @@ type StorageAccountClient interface {
 	azureclient.StorageAccountClient
 }
 
+type NfsFileShareClient interface {
+	azureclient.NfsFileShareClient
+}
+
 type Clients interface {
 	ResourceGroupsClient
 	NetworkClient
@@ -109,6 +114,7 @@ type Clients interface {
 	SecurityClient
 	NetworkFlowLogsClient
 	StorageAccountClient
+	NfsFileShareClient
 }
 
 type Providers interface {
@@ -116,6 +122,7 @@ type Providers interface {
 	VpcNetworkProvider() azureclient.ClientProvider[azurevpcnetworkclient.Client]
 	IpRangeProvider() azureclient.ClientProvider[azureiprangeclient.Client]
 	RedisClientProvider() azureclient.ClientProvider[azureredisinstanceclient.Client]
+	NfsInstanceProvider() azureclient.ClientProvider[azurenfsinstanceclient.Client]
 	RedisClusterClientProvider() azureclient.ClientProvider[azureredisclusterclient.Client]
 	ManagedRedisClientProvider() azureclient.ClientProvider[azuremanagedredisclient.Client]
 	NetworkProvider() azureclient.ClientProvider[azurenetworkclient.Client]
@@ -150,6 +157,7 @@ type RedisConfig interface {
 
 type ManagedRedisConfig interface {
 	GetManagedRedisCluster(ctx context.Context, resourceGroupName, clusterName string) (*armredisenterprise.Cluster, error)
+	GetManagedRedisAccessPolicyAssignment(ctx context.Context, resourceGroupName, clusterName, databaseName, assignmentName string) (*armredisenterprise.AccessPolicyAssignment, error)
 }
 
 type Configs interface {
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+)
+
+type Client interface {
+	azureclient.StorageAccountClient
+	azureclient.NfsFileShareClient
+	azureclient.PrivateEndPointsClient
+	azureclient.PrivateDnsZoneGroupClient
+	azureclient.PrivateDnsZoneClient
+	azureclient.VirtualNetworkLinkClient
+}
+
+func NewClientProvider() azureclient.ClientProvider[Client] {
+	return func(ctx context.Context, clientId, clientSecret, subscriptionId, tenantId string, _ ...string) (Client, error) {
+		cred, err := azidentity.NewClientSecretCredential(tenantId, clientId, clientSecret, azureclient.NewCredentialOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		storageClientFactory, err := armstorage.NewClientFactory(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		networkClientFactory, err := armnetwork.NewClientFactory(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		privateDnsClientFactory, err := armprivatedns.NewClientFactory(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		return newClient(
+			azureclient.NewStorageAccountClient(storageClientFactory.NewAccountsClient()),
+			azureclient.NewNfsFileShareClient(storageClientFactory.NewFileSharesClient()),
+			azureclient.NewPrivateEndPointClient(networkClientFactory.NewPrivateEndpointsClient()),
+			azureclient.NewPrivateDnsZoneGroupClient(networkClientFactory.NewPrivateDNSZoneGroupsClient()),
+			azureclient.NewPrivateDnsZoneClient(privateDnsClientFactory.NewPrivateZonesClient()),
+			azureclient.NewVirtualNetworkLinkClient(privateDnsClientFactory.NewVirtualNetworkLinksClient()),
+		), nil
+	}
+}
+
+var _ Client = &client{}
+
+type client struct {
+	azureclient.StorageAccountClient
+	azureclient.NfsFileShareClient
+	azureclient.PrivateEndPointsClient
+	azureclient.PrivateDnsZoneGroupClient
+	azureclient.PrivateDnsZoneClient
+	azureclient.VirtualNetworkLinkClient
+}
+
+func newClient(
+	storageAccountClient azureclient.StorageAccountClient,
+	nfsFileShareClient azureclient.NfsFileShareClient,
+	privateEndPointsClient azureclient.PrivateEndPointsClient,
+	privateDnsZoneGroupClient azureclient.PrivateDnsZoneGroupClient,
+	privateDnsZoneClient azureclient.PrivateDnsZoneClient,
+	virtualNetworkLinkClient azureclient.VirtualNetworkLinkClient,
+) *client {
+	return &client{
+		StorageAccountClient:      storageAccountClient,
+		NfsFileShareClient:        nfsFileShareClient,
+		PrivateEndPointsClient:    privateEndPointsClient,
+		PrivateDnsZoneGroupClient: privateDnsZoneGroupClient,
+		PrivateDnsZoneClient:      privateDnsZoneClient,
+		VirtualNetworkLinkClient:  virtualNetworkLinkClient,
+	}
+}
@@ -0,0 +1,54 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createFileShare(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.fileShare != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure NFS file share")
+
+	err := state.client.CreateNfsFileShare(ctx, state.resourceGroupName, state.storageAccountName, fileShareName, armstorage.FileShare{
+		FileShareProperties: &armstorage.FileShareProperties{
+			EnabledProtocols: new(armstorage.EnabledProtocolsNFS),
+			RootSquash:       new(armstorage.RootSquashTypeNoRootSquash),
+			ShareQuota:       new(int32(state.ObjAsNfsInstance().Spec.Instance.Azure.CapacityGb)),
+		},
+	})
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on file share create",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		state.ObjAsNfsInstance().Status.State = v1beta1.StateError
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonFailedCreatingFileSystem,
+				Message: "Failed creating Azure NFS file share",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed creating file share").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error creating Azure NFS file share: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,57 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// createPrivateDnsZone ensures the privatelink.file private DNS zone exists in the Cloud Manager
+// resource group. The zone is shared by all NfsInstances of the scope and is removed together
+// with the resource group, so it is not deleted in the NfsInstance deletion flow.
+func createPrivateDnsZone(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZone != nil {
+		if state.privateDnsZone.Properties != nil &&
+			ptr.Deref(state.privateDnsZone.Properties.ProvisioningState, "") == armprivatedns.ProvisioningStateSucceeded {
+			return nil, ctx
+		}
+		logger.Info("Waiting for Azure file private DnsZone to become available")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	logger.Info("Creating Azure file private DnsZone")
+
+	err := state.client.CreatePrivateDnsZone(ctx, state.resourceGroupName, azureutil.NewFilePrivateDnsZoneName(), nil)
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on private dns zone create",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed creating Azure private DnsZone",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed creating private DnsZone").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error creating Azure file private DnsZone: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
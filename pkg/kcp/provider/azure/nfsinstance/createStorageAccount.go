@@ -0,0 +1,69 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.storageAccount != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure storage account for NfsInstance")
+
+	_, err := state.client.CreateStorageAccount(ctx, state.resourceGroupName, state.storageAccountName, getStorageAccountCreateParams(state), nil)
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on storage account create",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		state.ObjAsNfsInstance().Status.State = v1beta1.StateError
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonFailedCreatingFileSystem,
+				Message: "Failed creating Azure storage account",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed creating storage account").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error creating Azure storage account for NfsInstance: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
+
+func getStorageAccountCreateParams(state *State) armstorage.AccountCreateParameters {
+	sku := armstorage.SKUName(state.ObjAsNfsInstance().Spec.Instance.Azure.Sku)
+	if sku == "" {
+		sku = armstorage.SKUNamePremiumLRS
+	}
+	return armstorage.AccountCreateParameters{
+		Kind:     new(armstorage.KindFileStorage),
+		Location: new(state.Scope().Spec.Region),
+		SKU: &armstorage.SKU{
+			Name: new(sku),
+		},
+		Properties: &armstorage.AccountPropertiesCreateParameters{
+			// NFS shares do not support encryption in transit over HTTPS
+			EnableHTTPSTrafficOnly: new(false),
+			PublicNetworkAccess:    new(armstorage.PublicNetworkAccessDisabled),
+			AllowBlobPublicAccess:  new(false),
+			MinimumTLSVersion:      new(armstorage.MinimumTLSVersionTLS12),
+		},
+	}
+}
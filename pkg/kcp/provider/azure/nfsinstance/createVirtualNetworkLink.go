@@ -0,0 +1,59 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// createVirtualNetworkLink links the file private DNS zone to the shoot VNet so that the storage
+// account host name resolves to the private endpoint address from the cluster nodes.
+func createVirtualNetworkLink(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.virtualNetworkLink != nil {
+		if state.virtualNetworkLink.Properties != nil &&
+			ptr.Deref(state.virtualNetworkLink.Properties.ProvisioningState, "") == armprivatedns.ProvisioningStateSucceeded {
+			return nil, ctx
+		}
+		logger.Info("Waiting for Azure file private DnsZone virtual network link to become available")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	logger.Info("Creating Azure file private DnsZone virtual network link")
+
+	vpcNetwork := state.Scope().Spec.Scope.Azure.VpcNetwork
+	vnetId := azureutil.NewVirtualNetworkResourceId(state.subscriptionId(), vpcNetwork, vpcNetwork).String()
+
+	err := state.client.CreateVirtualNetworkLink(ctx, state.resourceGroupName, azureutil.NewFilePrivateDnsZoneName(), virtualNetworkLinkName(state), vnetId)
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on virtual network link create",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed creating Azure virtual network link",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed creating virtual network link").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error creating Azure file private DnsZone virtual network link: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,51 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteFileShare(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.fileShare == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting Azure NFS file share")
+
+	err := state.client.DeleteNfsFileShare(ctx, state.resourceGroupName, state.storageAccountName, fileShareName)
+	if azuremeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on file share delete",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed deleting Azure NFS file share",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed deleting file share").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error deleting Azure NFS file share: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	state.fileShare = nil
+
+	return nil, ctx
+}
@@ -0,0 +1,54 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func loadFileShare(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.fileShare != nil {
+		return nil, ctx
+	}
+	if state.storageAccount == nil {
+		logger.Info("Skipping Azure NFS file share loading, storage account is not loaded")
+		return nil, ctx
+	}
+
+	fileShare, err := state.client.GetNfsFileShare(ctx, state.resourceGroupName, state.storageAccountName, fileShareName)
+	if azuremeta.IsNotFound(err) {
+		logger.Info("Azure NFS file share not found")
+		return nil, ctx
+	}
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on file share load",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed loading Azure NFS file share",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed loading file share").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error loading Azure NFS file share: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	state.fileShare = fileShare
+
+	return nil, ctx
+}
@@ -0,0 +1,36 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func loadPrivateDnsZone(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZone != nil {
+		return nil, ctx
+	}
+
+	privateDnsZone, err := state.client.GetPrivateDnsZone(ctx, state.resourceGroupName, azureutil.NewFilePrivateDnsZoneName())
+	if azuremeta.IsNotFound(err) {
+		logger.Info("Azure file private DnsZone not found")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err,
+			"Error loading Azure file private DnsZone",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+
+	state.privateDnsZone = privateDnsZone
+
+	return nil, ctx
+}
@@ -0,0 +1,50 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func loadStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.storageAccount != nil {
+		return nil, ctx
+	}
+
+	resp, err := state.client.GetStorageAccount(ctx, state.resourceGroupName, state.storageAccountName, nil)
+	if azuremeta.IsNotFound(err) {
+		logger.Info("Azure storage account for NfsInstance not found")
+		return nil, ctx
+	}
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on storage account load",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed loading Azure storage account",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed loading storage account").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error loading Azure storage account for NfsInstance: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	state.storageAccount = &resp.Account
+
+	return nil, ctx
+}
@@ -0,0 +1,44 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func loadVirtualNetworkLink(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.virtualNetworkLink != nil {
+		return nil, ctx
+	}
+	if state.privateDnsZone == nil {
+		return nil, ctx
+	}
+
+	virtualNetworkLink, err := state.client.GetVirtualNetworkLink(ctx, state.resourceGroupName, azureutil.NewFilePrivateDnsZoneName(), virtualNetworkLinkName(state))
+	if azuremeta.IsNotFound(err) {
+		logger.Info("Azure file private DnsZone virtual network link not found")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err,
+			"Error loading Azure file private DnsZone virtual network link",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+
+	state.virtualNetworkLink = virtualNetworkLink
+
+	return nil, ctx
+}
+
+// virtualNetworkLinkName is the name of the link between the file private DNS zone and the shoot VNet
+func virtualNetworkLinkName(state *State) string {
+	return state.Scope().Spec.Scope.Azure.VpcNetwork
+}
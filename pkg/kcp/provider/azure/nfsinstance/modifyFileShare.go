@@ -0,0 +1,58 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// modifyFileShare resizes the NFS share when the desired capacity differs from the provisioned quota.
+func modifyFileShare(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	desiredCapacityGb := state.ObjAsNfsInstance().Spec.Instance.Azure.CapacityGb
+	if state.fileShare == nil || state.GetProvisionedCapacityGb() == desiredCapacityGb {
+		return nil, ctx
+	}
+
+	logger.Info("Resizing Azure NFS file share", "from", state.GetProvisionedCapacityGb(), "to", desiredCapacityGb)
+
+	err := state.client.UpdateNfsFileShare(ctx, state.resourceGroupName, state.storageAccountName, fileShareName, armstorage.FileShare{
+		FileShareProperties: &armstorage.FileShareProperties{
+			ShareQuota: new(int32(desiredCapacityGb)),
+		},
+	})
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP NfsInstance too many requests on file share update",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(state.ObjAsNfsInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed resizing Azure NFS file share",
+			}).
+			ErrorLogMessage("Error updating Azure KCP NfsInstance status after failed resizing file share").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error resizing Azure NFS file share: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	state.ObjAsNfsInstance().Status.State = v1beta1.StateProcessing
+
+	return composed.UpdateStatus(state.ObjAsNfsInstance()).
+		ErrorLogMessage("Error updating Azure KCP NfsInstance status after resizing file share").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T1000ms())).
+		Run(ctx, state)
+}
@@ -2,14 +2,69 @@ package nfsinstance
 
 import (
 	"context"
-	"errors"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nfsinstancetypes "github.com/kyma-project/cloud-manager/pkg/kcp/nfsinstance/types"
+	azuremetrics "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/metrics"
+	azureprivateendpoint "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/privateendpoint"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// New returns an Action that provisions and deprovisions an Azure Files NFS share for a KCP NfsInstance.
+// The share lives in a dedicated premium FileStorage account that is reachable from the shoot VNet
+// only through a private endpoint registered in the privatelink.file private DNS zone.
 func New(stateFactory StateFactory) composed.Action {
-	return func(ctx context.Context, state composed.State) (error, context.Context) {
-		logger := composed.LoggerFromCtx(ctx)
-		logger.Error(errors.New("azure/nfsinstance should not been called"), "Logical error")
-		return nil, nil
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, err := stateFactory.NewState(ctx, st.(nfsinstancetypes.State))
+		if err != nil {
+			nfsInstance := st.Obj().(*v1beta1.NfsInstance)
+			return composed.UpdateStatus(nfsInstance).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    v1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  v1beta1.ReasonCloudProviderError,
+					Message: err.Error(),
+				}).
+				SuccessError(composed.StopAndForget).
+				SuccessLogMsg(fmt.Sprintf("Error creating new Azure NfsInstance state: %s", err)).
+				Run(ctx, st)
+		}
+		ctx = azuremetrics.RegionIntoContext(ctx, state.Scope().Spec.Region)
+
+		return composed.ComposeActions(
+			"azureNfsInstance",
+			actions.AddCommonFinalizer(),
+			loadStorageAccount,
+			loadFileShare,
+			azureprivateendpoint.Load(),
+			loadPrivateDnsZone,
+			loadVirtualNetworkLink,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"azure-nfsInstance-create",
+					createStorageAccount,
+					waitStorageAccountAvailable,
+					createFileShare,
+					modifyFileShare,
+					createPrivateDnsZone,
+					createVirtualNetworkLink,
+					azureprivateendpoint.Create(),
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"azure-nfsInstance-delete",
+					removeReadyCondition,
+					azureprivateendpoint.Delete(),
+					deleteFileShare,
+					deleteStorageAccount,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(ctx, state)
 	}
 }
@@ -2,18 +2,135 @@ package nfsinstance
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	nfsinstancetypes "github.com/kyma-project/cloud-manager/pkg/kcp/nfsinstance/types"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
+	azurenfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nfsinstance/client"
+	azureprivateendpoint "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/privateendpoint"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
 )
 
+// fileShareName is the name of the single NFS share created in each storage account
+const fileShareName = "vol1"
+
 type State struct {
 	nfsinstancetypes.State
+
+	client azurenfsinstanceclient.Client
+
+	resourceGroupName  string
+	storageAccountName string
+
+	storageAccount      *armstorage.Account
+	fileShare           *armstorage.FileShare
+	privateEndPoint     *armnetwork.PrivateEndpoint
+	privateDnsZoneGroup *armnetwork.PrivateDNSZoneGroup
+	privateDnsZone      *armprivatedns.PrivateZone
+	virtualNetworkLink  *armprivatedns.VirtualNetworkLink
 }
 
 type StateFactory interface {
 	NewState(ctx context.Context, nfsInstanceState nfsinstancetypes.State) (*State, error)
 }
 
-func NewStateFactory() StateFactory {
-	// TODO: implement the state and the factory
-	return nil
+func NewStateFactory(azureProvider azureclient.ClientProvider[azurenfsinstanceclient.Client]) StateFactory {
+	return &stateFactory{
+		azureProvider: azureProvider,
+	}
+}
+
+type stateFactory struct {
+	azureProvider azureclient.ClientProvider[azurenfsinstanceclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, nfsInstanceState nfsinstancetypes.State) (*State, error) {
+	clientId := azureconfig.AzureConfig.DefaultCreds.ClientId
+	clientSecret := azureconfig.AzureConfig.DefaultCreds.ClientSecret
+	subscriptionId := nfsInstanceState.Scope().Spec.Scope.Azure.SubscriptionId
+	tenantId := nfsInstanceState.Scope().Spec.Scope.Azure.TenantId
+
+	c, err := f.azureProvider(ctx, clientId, clientSecret, subscriptionId, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating azure nfsinstance client: %w", err)
+	}
+
+	return newState(nfsInstanceState, c), nil
+}
+
+func newState(nfsInstanceState nfsinstancetypes.State, client azurenfsinstanceclient.Client) *State {
+	return &State{
+		State:              nfsInstanceState,
+		client:             client,
+		resourceGroupName:  azurecommon.AzureCloudManagerResourceGroupName(nfsInstanceState.Scope().Spec.Scope.Azure.VpcNetwork),
+		storageAccountName: storageAccountName(nfsInstanceState.ObjAsNfsInstance().Name),
+	}
+}
+
+// storageAccountName derives a globally unique storage account name from the NfsInstance name.
+// Storage account names are limited to 24 lowercase alphanumeric characters.
+func storageAccountName(nfsInstanceName string) string {
+	name := "cm" + strings.ToLower(strings.ReplaceAll(nfsInstanceName, "-", ""))
+	if len(name) > 24 {
+		name = name[:24]
+	}
+	return name
+}
+
+func (s *State) subscriptionId() string {
+	return s.Scope().Spec.Scope.Azure.SubscriptionId
+}
+
+// azureprivateendpoint.State implementation ---------------------------------------------
+
+var _ azureprivateendpoint.State = &State{}
+
+func (s *State) PrivateEndPointClient() azureprivateendpoint.Client {
+	return s.client
+}
+
+func (s *State) ResourceGroupName() string {
+	return s.resourceGroupName
+}
+
+func (s *State) PrivateLinkServiceId() string {
+	return azureutil.NewStorageAccountResourceId(s.subscriptionId(), s.resourceGroupName, s.storageAccountName).String()
+}
+
+func (s *State) PrivateLinkGroupId() string {
+	return "file"
+}
+
+func (s *State) PrivateDnsZoneName() string {
+	return azureutil.NewFilePrivateDnsZoneName()
+}
+
+func (s *State) PrivateEndPoint() *armnetwork.PrivateEndpoint {
+	return s.privateEndPoint
+}
+
+func (s *State) SetPrivateEndPoint(privateEndPoint *armnetwork.PrivateEndpoint) {
+	s.privateEndPoint = privateEndPoint
+}
+
+func (s *State) PrivateDnsZoneGroup() *armnetwork.PrivateDNSZoneGroup {
+	return s.privateDnsZoneGroup
+}
+
+func (s *State) SetPrivateDnsZoneGroup(privateDnsZoneGroup *armnetwork.PrivateDNSZoneGroup) {
+	s.privateDnsZoneGroup = privateDnsZoneGroup
+}
+
+// GetProvisionedCapacityGb returns the quota of the NFS file share
+func (s *State) GetProvisionedCapacityGb() int {
+	if s.fileShare == nil || s.fileShare.FileShareProperties == nil || s.fileShare.FileShareProperties.ShareQuota == nil {
+		return 0
+	}
+	return int(*s.fileShare.FileShareProperties.ShareQuota)
 }
@@ -0,0 +1,74 @@
+package nfsinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// updateStatus sets the NfsInstance Id, Host, Path and provisioned capacity and the Ready condition
+// once the NFS share is reachable through the private endpoint.
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	nfsInstance := state.ObjAsNfsInstance()
+	changed := false
+
+	id := ptr.Deref(state.fileShare.ID, "")
+	if nfsInstance.Status.Id != id {
+		nfsInstance.Status.Id = id
+		changed = true
+	}
+
+	host := azureutil.NewFileShareHost(state.storageAccountName)
+	if nfsInstance.Status.Host != host {
+		nfsInstance.Status.Host = host
+		nfsInstance.Status.Hosts = []string{host}
+		changed = true
+	}
+
+	path := fmt.Sprintf("/%s/%s", state.storageAccountName, fileShareName)
+	if nfsInstance.Status.Path != path {
+		nfsInstance.Status.Path = path
+		changed = true
+	}
+
+	capacityGb := state.GetProvisionedCapacityGb()
+	if nfsInstance.Status.CapacityGb != capacityGb {
+		nfsInstance.Status.CapacityGb = capacityGb
+		changed = true
+	}
+	capacity := resource.MustParse(fmt.Sprintf("%dGi", capacityGb))
+	if nfsInstance.Status.Capacity.Cmp(capacity) != 0 {
+		nfsInstance.Status.Capacity = capacity
+		changed = true
+	}
+
+	hasReadyCondition := meta.IsStatusConditionTrue(nfsInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
+	hasReadyStatusState := nfsInstance.Status.State == cloudcontrolv1beta1.StateReady
+
+	if !changed && hasReadyCondition && hasReadyStatusState {
+		composed.LoggerFromCtx(ctx).Info("Azure KCP NfsInstance status fields are already up-to-date, StopAndForget-ing")
+		return composed.StopAndForget, nil
+	}
+
+	nfsInstance.Status.State = cloudcontrolv1beta1.StateReady
+
+	return composed.UpdateStatus(nfsInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonReady,
+			Message: "NFS instance is ready",
+		}).
+		ErrorLogMessage("Error updating Azure KCP NfsInstance status after setting Ready condition").
+		SuccessLogMsg("Azure KCP NfsInstance is ready").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
@@ -0,0 +1,24 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func waitStorageAccountAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.storageAccount != nil && state.storageAccount.Properties != nil &&
+		ptr.Deref(state.storageAccount.Properties.ProvisioningState, "") == armstorage.ProvisioningStateSucceeded {
+		return nil, ctx
+	}
+
+	logger.Info("Waiting for Azure storage account for NfsInstance to become available")
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -37,13 +37,16 @@ func New(stateFactory StateFactory) composed.Action {
 			loadAzureContainers,
 			loadAzureBackups,
 			providerResourceStatusDiscovered,
-			disableSoftDelete,
-			deleteAzureBackups,
-			deleteAzureContainers,
-			deleteAzureVaults,
-			providerResourceStatusDeleting,
-			providerResourceStatusDeleted,
-			checkIfAllProviderResourcesDeleted,
+			composed.If(
+				composed.Not(nuketypes.DryRunPredicate),
+				disableSoftDelete,
+				deleteAzureBackups,
+				deleteAzureContainers,
+				deleteAzureVaults,
+				providerResourceStatusDeleting,
+				providerResourceStatusDeleted,
+				checkIfAllProviderResourcesDeleted,
+			),
 			// continue to parent action
 			func(ctx context.Context, state composed.State) (error, context.Context) {
 				return nil, ctx
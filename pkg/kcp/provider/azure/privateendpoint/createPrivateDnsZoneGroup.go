@@ -0,0 +1,69 @@
+package privateendpoint
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func createPrivateDnsZoneGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.PrivateDnsZoneGroup() != nil {
+		return nil, ctx
+	}
+
+	if state.PrivateEndPoint() == nil {
+		logger.Info("Can not create Azure Private DnsZone Group, Private EndPoint is not loaded, requeueing")
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+	}
+
+	logger.Info("Creating Azure Private DnsZone Group")
+
+	privateDnsZoneName := state.PrivateDnsZoneName()
+	privateEndPointName := ptr.Deref(state.PrivateEndPoint().Name, "")
+	privateDNSZoneGroup := armnetwork.PrivateDNSZoneGroup{
+		Properties: &armnetwork.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: []*armnetwork.PrivateDNSZoneConfig{
+				{
+					Name: new(privateDnsZoneName),
+					Properties: &armnetwork.PrivateDNSZonePropertiesFormat{
+						PrivateDNSZoneID: new(azureutil.NewPrivateDnsZoneResourceId(subscriptionId(state), state.ResourceGroupName(), privateDnsZoneName).String()),
+					},
+				},
+			},
+		},
+	}
+
+	err := state.PrivateEndPointClient().CreatePrivateDnsZoneGroup(ctx, state.ResourceGroupName(), privateEndPointName, state.Obj().GetName(), privateDNSZoneGroup)
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP too many requests on private dns zone group create",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(objWithConditions(state)).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed creating Azure Private DnsZone Group",
+			}).
+			ErrorLogMessage("Error updating Azure KCP resource status after failed creating private dns zone group").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error creating Azure Private DnsZone Group: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,80 @@
+package privateendpoint
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func createPrivateEndPoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.PrivateEndPoint() != nil && ptr.Deref(state.PrivateEndPoint().Properties.ProvisioningState, "") != armnetwork.ProvisioningStateFailed {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure Private EndPoint")
+
+	privateEndPointName := state.Obj().GetName()
+	subnetName := azurecommon.AzureCloudManagerResourceGroupName(state.Scope().Spec.Scope.Azure.VpcNetwork)
+	subnetResourceId := azureutil.NewSubnetResourceId(
+		subscriptionId(state),
+		state.ResourceGroupName(),
+		subnetName,
+		subnetName).String()
+
+	err := state.PrivateEndPointClient().CreatePrivateEndPoint(
+		ctx,
+		state.ResourceGroupName(),
+		privateEndPointName,
+		armnetwork.PrivateEndpoint{
+			Location: new(state.Scope().Spec.Region),
+			Properties: &armnetwork.PrivateEndpointProperties{
+				Subnet: &armnetwork.Subnet{
+					ID:   new(subnetResourceId),
+					Name: new(state.IpRange().Spec.Network.Name),
+				},
+				PrivateLinkServiceConnections: []*armnetwork.PrivateLinkServiceConnection{
+					{
+						Name: new(privateEndPointName),
+						Properties: &armnetwork.PrivateLinkServiceConnectionProperties{
+							PrivateLinkServiceID: new(state.PrivateLinkServiceId()),
+							GroupIDs:             []*string{new(state.PrivateLinkGroupId())},
+						},
+					},
+				},
+			},
+		},
+	)
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP too many requests on private endpoint create",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(objWithConditions(state)).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed creating Azure PrivateEndpoint",
+			}).
+			ErrorLogMessage("Error updating Azure KCP resource status after failed creating private endpoint").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error creating Azure PrivateEndpoint: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
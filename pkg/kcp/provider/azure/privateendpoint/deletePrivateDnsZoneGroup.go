@@ -0,0 +1,57 @@
+package privateendpoint
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func deletePrivateDnsZoneGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.PrivateDnsZoneGroup() == nil {
+		return nil, ctx
+	}
+	if state.PrivateDnsZoneGroup().Properties != nil &&
+		ptr.Deref(state.PrivateDnsZoneGroup().Properties.ProvisioningState, "") == armnetwork.ProvisioningStateDeleting {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting Azure Private DnsZone Group")
+
+	privateEndPointName := ptr.Deref(state.PrivateEndPoint().Name, "")
+	err := state.PrivateEndPointClient().DeletePrivateDnsZoneGroup(ctx, state.ResourceGroupName(), privateEndPointName, state.Obj().GetName())
+	if azuremeta.IsNotFound(err) {
+		state.SetPrivateDnsZoneGroup(nil)
+		return nil, ctx
+	}
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP too many requests on private dns zone group delete",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(objWithConditions(state)).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed deleting Azure Private DnsZone Group",
+			}).
+			ErrorLogMessage("Error updating Azure KCP resource status after failed deleting private dns zone group").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error deleting Azure Private DnsZone Group: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
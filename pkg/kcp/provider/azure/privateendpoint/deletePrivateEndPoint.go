@@ -0,0 +1,55 @@
+package privateendpoint
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func deletePrivateEndPoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.PrivateEndPoint() == nil {
+		return nil, ctx
+	}
+	if ptr.Deref(state.PrivateEndPoint().Properties.ProvisioningState, "") == armnetwork.ProvisioningStateDeleting {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting Azure Private EndPoint")
+
+	err := state.PrivateEndPointClient().DeletePrivateEndPoint(ctx, state.ResourceGroupName(), state.Obj().GetName())
+	if azuremeta.IsNotFound(err) {
+		state.SetPrivateEndPoint(nil)
+		return nil, ctx
+	}
+	if azuremeta.IsTooManyRequests(err) {
+		return composed.LogErrorAndReturn(err,
+			"Azure KCP too many requests on private endpoint delete",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+	if err != nil {
+		return composed.UpdateStatus(objWithConditions(state)).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonCloudProviderError,
+				Message: "Failed deleting Azure PrivateEndpoint",
+			}).
+			ErrorLogMessage("Error updating Azure KCP resource status after failed deleting private endpoint").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessLogMsg("Error deleting Azure PrivateEndpoint: "+err.Error()).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
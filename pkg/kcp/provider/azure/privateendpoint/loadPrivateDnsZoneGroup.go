@@ -0,0 +1,41 @@
+package privateendpoint
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func loadPrivateDnsZoneGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.PrivateDnsZoneGroup() != nil {
+		return nil, ctx
+	}
+	if state.PrivateEndPoint() == nil {
+		logger.Info("Skipping Azure Private DnsZone Group loading, Private EndPoint is not loaded")
+		return nil, ctx
+	}
+
+	privateEndPointName := ptr.Deref(state.PrivateEndPoint().Name, "")
+	privateDnsZoneGroup, err := state.PrivateEndPointClient().GetPrivateDnsZoneGroup(ctx, state.ResourceGroupName(), privateEndPointName, state.Obj().GetName())
+	if azuremeta.IsNotFound(err) {
+		logger.Info("Azure Private DnsZone Group not found")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err,
+			"Error loading Azure Private DnsZone Group",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+
+	state.SetPrivateDnsZoneGroup(privateDnsZoneGroup)
+
+	return nil, ctx
+}
@@ -0,0 +1,35 @@
+package privateendpoint
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func loadPrivateEndPoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.PrivateEndPoint() != nil {
+		return nil, ctx
+	}
+
+	privateEndPoint, err := state.PrivateEndPointClient().GetPrivateEndPoint(ctx, state.ResourceGroupName(), state.Obj().GetName())
+	if azuremeta.IsNotFound(err) {
+		logger.Info("Azure Private EndPoint not found")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err,
+			"Error loading Azure Private EndPoint",
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+			ctx,
+		)
+	}
+
+	state.SetPrivateEndPoint(privateEndPoint)
+
+	return nil, ctx
+}
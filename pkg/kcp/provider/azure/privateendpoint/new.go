@@ -0,0 +1,63 @@
+package privateendpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// Load returns a composed.Action that loads the private endpoint and its private DNS zone group
+// into the state. The provided state MUST implement State interface.
+func Load() composed.Action {
+	return flow("azurePrivateEndPointLoad",
+		loadPrivateEndPoint,
+		loadPrivateDnsZoneGroup,
+	)
+}
+
+// Create returns a composed.Action that creates the private endpoint, requeues until it is
+// provisioned, and registers it in the private DNS zone with a private DNS zone group.
+// It MUST run after Load.
+func Create() composed.Action {
+	return flow("azurePrivateEndPointCreate",
+		createPrivateEndPoint,
+		waitPrivateEndPointAvailable,
+		createPrivateDnsZoneGroup,
+	)
+}
+
+// Delete returns a composed.Action that deletes the private DNS zone group and then the private
+// endpoint, requeueing until both are gone. It MUST run after Load.
+func Delete() composed.Action {
+	return flow("azurePrivateEndPointDelete",
+		deletePrivateDnsZoneGroup,
+		waitPrivateDnsZoneGroupDeleted,
+		deletePrivateEndPoint,
+		waitPrivateEndPointDeleted,
+	)
+}
+
+func flow(name string, actions ...composed.Action) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, ok := st.(State)
+		if !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("state %T provided to privateendpoint flow does not implement privateendpoint.State", st),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+		if _, ok := state.Obj().(composed.ObjWithConditions); !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("object %T provided to privateendpoint flow does not implement composed.ObjWithConditions", state.Obj()),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+
+		return composed.ComposeActions(name, actions...)(ctx, state)
+	}
+}
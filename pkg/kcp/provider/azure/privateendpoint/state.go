@@ -0,0 +1,44 @@
+package privateendpoint
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+)
+
+type Client interface {
+	azureclient.PrivateEndPointsClient
+	azureclient.PrivateDnsZoneGroupClient
+}
+
+// State is implemented by the states of the KCP resources that are exposed in the shoot VNet
+// through an Azure private endpoint. Both the private endpoint and its private DNS zone group
+// are named after the reconciled object, and the reconciled object MUST implement composed.ObjWithConditions.
+type State interface {
+	composed.State
+	Scope() *cloudcontrolv1beta1.Scope
+	IpRange() *cloudcontrolv1beta1.IpRange
+
+	PrivateEndPointClient() Client
+	ResourceGroupName() string
+	// PrivateLinkServiceId is the resource id of the Azure resource the private endpoint connects to
+	PrivateLinkServiceId() string
+	// PrivateLinkGroupId is the sub-resource of the connected Azure resource, like redisCache or file
+	PrivateLinkGroupId() string
+	// PrivateDnsZoneName is the private DNS zone the private endpoint is registered in
+	PrivateDnsZoneName() string
+
+	PrivateEndPoint() *armnetwork.PrivateEndpoint
+	SetPrivateEndPoint(privateEndPoint *armnetwork.PrivateEndpoint)
+	PrivateDnsZoneGroup() *armnetwork.PrivateDNSZoneGroup
+	SetPrivateDnsZoneGroup(privateDnsZoneGroup *armnetwork.PrivateDNSZoneGroup)
+}
+
+func subscriptionId(state State) string {
+	return state.Scope().Spec.Scope.Azure.SubscriptionId
+}
+
+func objWithConditions(state State) composed.ObjWithConditions {
+	return state.Obj().(composed.ObjWithConditions)
+}
@@ -0,0 +1,24 @@
+package privateendpoint
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func waitPrivateEndPointAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.PrivateEndPoint() != nil && state.PrivateEndPoint().Properties != nil &&
+		ptr.Deref(state.PrivateEndPoint().Properties.ProvisioningState, "") == armnetwork.ProvisioningStateSucceeded {
+		return nil, ctx
+	}
+
+	logger.Info("Waiting for Azure Private EndPoint to become available")
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	azuremetrics "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/metrics"
+	azureprivateendpoint "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/privateendpoint"
 )
 
 func New(stateFactory StateFactory) composed.Action {
@@ -36,8 +37,7 @@ func New(stateFactory StateFactory) composed.Action {
 		return composed.ComposeActions(
 			"azureRedisInstance",
 			actions.AddCommonFinalizer(),
-			loadPrivateEndPoint,
-			loadPrivateDnsZoneGroup,
+			azureprivateendpoint.Load(),
 			loadRedis,
 			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
 				composed.ComposeActions(
@@ -45,9 +45,7 @@ func New(stateFactory StateFactory) composed.Action {
 					createRedis,
 					updateStatusId,
 					waitRedisAvailable,
-					createPrivateEndPoint,
-					waitPrivateEndPointAvailable,
-					createPrivateDnsZoneGroup,
+					azureprivateendpoint.Create(),
 					modifyRedis,
 					updateStatus,
 				),
@@ -55,10 +53,7 @@ func New(stateFactory StateFactory) composed.Action {
 					"azure-redisInstance-delete",
 					deleteRedis,
 					waitRedisDeleted,
-					deletePrivateDnsZoneGroup,
-					waitPrivateDnsZoneGroupDeleted,
-					deletePrivateEndPoint,
-					waitPrivateEndPointDeleted,
+					azureprivateendpoint.Delete(),
 					actions.RemoveCommonFinalizer(),
 					composed.StopAndForgetAction,
 				),
@@ -10,8 +10,11 @@ import (
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
 	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
+	azureprivateendpoint "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/privateendpoint"
 	azureredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance/client"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
 	redisinstancetypes "github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance/types"
+	"k8s.io/utils/ptr"
 )
 
 type State struct {
@@ -80,6 +83,49 @@ func newState(state redisinstancetypes.State,
 	}
 }
 
+// azureprivateendpoint.State implementation ---------------------------------------------
+
+var _ azureprivateendpoint.State = &State{}
+
+func (s *State) PrivateEndPointClient() azureprivateendpoint.Client {
+	return s.client
+}
+
+func (s *State) ResourceGroupName() string {
+	return s.resourceGroupName
+}
+
+func (s *State) PrivateLinkServiceId() string {
+	if s.azureRedisInstance == nil {
+		return ""
+	}
+	return azureutil.NewRedisInstanceResourceId(s.subscriptionId, s.resourceGroupName, ptr.Deref(s.azureRedisInstance.Name, "")).String()
+}
+
+func (s *State) PrivateLinkGroupId() string {
+	return "redisCache"
+}
+
+func (s *State) PrivateDnsZoneName() string {
+	return azureutil.NewPrivateDnsZoneName()
+}
+
+func (s *State) PrivateEndPoint() *armnetwork.PrivateEndpoint {
+	return s.privateEndPoint
+}
+
+func (s *State) SetPrivateEndPoint(privateEndPoint *armnetwork.PrivateEndpoint) {
+	s.privateEndPoint = privateEndPoint
+}
+
+func (s *State) PrivateDnsZoneGroup() *armnetwork.PrivateDNSZoneGroup {
+	return s.privateDnsZoneGroup
+}
+
+func (s *State) SetPrivateDnsZoneGroup(privateDnsZoneGroup *armnetwork.PrivateDNSZoneGroup) {
+	s.privateDnsZoneGroup = privateDnsZoneGroup
+}
+
 // GetProvisionedMachineType returns the provisioned machine type from the Azure Redis Instance
 func (s *State) GetProvisionedMachineType() string {
 	if s.azureRedisInstance == nil || s.azureRedisInstance.Properties == nil || s.azureRedisInstance.Properties.SKU == nil {
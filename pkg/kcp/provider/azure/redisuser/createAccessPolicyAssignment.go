@@ -0,0 +1,69 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redisenterprise/armredisenterprise/v3"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// createAccessPolicyAssignment binds the default access policy to the Entra ID principal.
+// AMR has no per-user ACL rules, so spec keyPatterns and commands are not applied.
+func createAccessPolicyAssignment(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.accessPolicyAssignment != nil {
+		return nil, ctx
+	}
+
+	if !state.hasManagedRedis() {
+		logger.Info("AzureManagedRedis has no status.id yet, requeueing with delay")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	redisUser := state.ObjAsRedisUser()
+
+	logger.Info("Creating Azure access policy assignment")
+
+	err := state.client.CreateOrUpdateAccessPolicyAssignment(
+		ctx,
+		state.resourceGroupName,
+		state.clusterName,
+		DefaultDatabaseName,
+		state.accessPolicyAssignmentName(),
+		armredisenterprise.AccessPolicyAssignment{
+			Properties: &armredisenterprise.AccessPolicyAssignmentProperties{
+				AccessPolicyName: ptr.To(DefaultAccessPolicyName),
+				User: &armredisenterprise.AccessPolicyAssignmentPropertiesUser{
+					ObjectID: ptr.To(redisUser.Spec.Azure.ObjectId),
+				},
+			},
+		},
+	)
+	if err != nil {
+		if azuremeta.IsTooManyRequests(err) {
+			return azuremeta.LogErrorAndReturn(err, "Too many requests on creating Azure access policy assignment", ctx)
+		}
+		logger.Error(err, "Error creating Azure access policy assignment")
+		message, _ := azuremeta.GetErrorMessage(err, "Failed to create access policy assignment")
+		redisUser.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisUser).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: message,
+			}).
+			ErrorLogMessage("Error updating RedisUser status due failed access policy assignment creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,33 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redisenterprise/armredisenterprise/v3"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func deleteAccessPolicyAssignment(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.accessPolicyAssignment == nil {
+		return nil, ctx
+	}
+
+	if state.accessPolicyAssignment.Properties != nil &&
+		ptr.Deref(state.accessPolicyAssignment.Properties.ProvisioningState, "") == armredisenterprise.ProvisioningStateDeleting {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Deleting Azure access policy assignment")
+
+	err := state.client.DeleteAccessPolicyAssignment(ctx, state.resourceGroupName, state.clusterName, DefaultDatabaseName, state.accessPolicyAssignmentName())
+	if azuremeta.IgnoreNotFoundError(err) != nil {
+		return azuremeta.LogErrorAndReturn(err, "Error deleting Azure access policy assignment", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
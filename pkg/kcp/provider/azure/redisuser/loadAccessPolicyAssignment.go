@@ -0,0 +1,30 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+func loadAccessPolicyAssignment(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.accessPolicyAssignment != nil || !state.hasManagedRedis() {
+		return nil, ctx
+	}
+
+	assignment, err := state.client.GetAccessPolicyAssignment(ctx, state.resourceGroupName, state.clusterName, DefaultDatabaseName, state.accessPolicyAssignmentName())
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure access policy assignment not found")
+			return nil, ctx
+		}
+		return azuremeta.LogErrorAndReturn(err, "Error loading Azure access policy assignment", ctx)
+	}
+
+	state.accessPolicyAssignment = assignment
+
+	return nil, ctx
+}
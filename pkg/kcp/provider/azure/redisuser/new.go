@@ -0,0 +1,57 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremetrics "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/metrics"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/redisuser/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, err := stateFactory.NewState(ctx, st.(types.State))
+		if err != nil {
+			redisUser := st.Obj().(*cloudcontrolv1beta1.RedisUser)
+			return composed.UpdateStatus(redisUser).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+					Message: err.Error(),
+				}).
+				SuccessError(composed.StopAndForget).
+				SuccessLogMsg(fmt.Sprintf("Error creating new Azure RedisUser state: %s", err)).
+				Run(ctx, st)
+		}
+		ctx = azuremetrics.RegionIntoContext(ctx, state.Scope().Spec.Region)
+
+		return composed.ComposeActions(
+			"azureRedisUser",
+			actions.AddCommonFinalizer(),
+			loadAccessPolicyAssignment,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"redisUser-create",
+					validateSpec,
+					createAccessPolicyAssignment,
+					waitAccessPolicyAssignmentAvailable,
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"redisUser-delete",
+					removeReadyCondition,
+					deleteAccessPolicyAssignment,
+					waitAccessPolicyAssignmentDeleted,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(ctx, state)
+	}
+}
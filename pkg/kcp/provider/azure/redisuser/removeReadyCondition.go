@@ -0,0 +1,32 @@
+package redisuser
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+func removeReadyCondition(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	redisUser := state.ObjAsRedisUser()
+
+	readyCond := meta.FindStatusCondition(*redisUser.Conditions(), cloudcontrolv1beta1.ConditionTypeReady)
+	if readyCond == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Removing Ready condition")
+
+	meta.RemoveStatusCondition(redisUser.Conditions(), cloudcontrolv1beta1.ConditionTypeReady)
+	redisUser.Status.State = cloudcontrolv1beta1.StateDeleting
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating RedisUser status after removing Ready condition", composed.StopWithRequeue, ctx)
+	}
+
+	return composed.StopWithRequeue, nil
+}
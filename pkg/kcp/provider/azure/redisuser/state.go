@@ -0,0 +1,88 @@
+package redisuser
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redisenterprise/armredisenterprise/v3"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
+	azuremanagedredisclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/managedredis/client"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/redisuser/types"
+)
+
+const (
+	// DefaultAccessPolicyName is the only built-in access policy of Azure Managed Redis,
+	// granting full data access. Custom access policies are not supported by AMR.
+	DefaultAccessPolicyName = "default"
+	DefaultDatabaseName     = "default"
+)
+
+type State struct {
+	types.State
+
+	client azuremanagedredisclient.Client
+
+	resourceGroupName string
+	clusterName       string
+
+	accessPolicyAssignment *armredisenterprise.AccessPolicyAssignment
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, redisUser types.State) (*State, error)
+}
+
+type stateFactory struct {
+	skrProvider azureclient.ClientProvider[azuremanagedredisclient.Client]
+}
+
+func NewStateFactory(skrProvider azureclient.ClientProvider[azuremanagedredisclient.Client]) StateFactory {
+	return &stateFactory{
+		skrProvider: skrProvider,
+	}
+}
+
+func (f *stateFactory) NewState(ctx context.Context, redisUser types.State) (*State, error) {
+	clientId := azureconfig.AzureConfig.DefaultCreds.ClientId
+	clientSecret := azureconfig.AzureConfig.DefaultCreds.ClientSecret
+	subscriptionId := redisUser.Scope().Spec.Scope.Azure.SubscriptionId
+	tenantId := redisUser.Scope().Spec.Scope.Azure.TenantId
+
+	c, err := f.skrProvider(ctx, clientId, clientSecret, subscriptionId, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState(redisUser, c)
+}
+
+func newState(redisUser types.State, client azuremanagedredisclient.Client) (*State, error) {
+	state := &State{
+		State:  redisUser,
+		client: client,
+	}
+
+	// the managed redis is not loaded when it's already deleted, and there's no assignment to delete then
+	if amr := redisUser.AzureManagedRedis(); amr != nil && amr.Status.Id != "" {
+		rd, err := azureutil.ParseResourceID(amr.Status.Id)
+		if err != nil {
+			return nil, err
+		}
+		state.resourceGroupName = rd.ResourceGroup
+		state.clusterName = rd.ResourceName
+	}
+
+	return state, nil
+}
+
+func (s *State) hasManagedRedis() bool {
+	return s.clusterName != ""
+}
+
+// accessPolicyAssignmentName is derived from the KCP RedisUser name, that is an uuid, since
+// assignment names are limited to alphanumeric characters
+func (s *State) accessPolicyAssignmentName() string {
+	return strings.ReplaceAll(s.Obj().GetName(), "-", "")
+}
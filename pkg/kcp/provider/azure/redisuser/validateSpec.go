@@ -0,0 +1,44 @@
+package redisuser
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func validateSpec(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	redisUser := state.ObjAsRedisUser()
+
+	var msg string
+	switch {
+	case redisUser.Spec.Redis.Kind != cloudcontrolv1beta1.RedisUserTargetKindAzureManagedRedis:
+		msg = "Only AzureManagedRedis supports users on Azure"
+	case redisUser.Spec.Azure == nil || redisUser.Spec.Azure.ObjectId == "":
+		msg = "Azure objectId is required for AzureManagedRedis users"
+	case !isDefaultAcl(redisUser.Spec.KeyPatterns, "*") || !isDefaultAcl(redisUser.Spec.Commands, "+@all"):
+		msg = "AzureManagedRedis only supports the default access policy, keyPatterns and commands can not be set"
+	default:
+		return nil, ctx
+	}
+
+	redisUser.Status.State = cloudcontrolv1beta1.StateError
+	return composed.UpdateStatus(redisUser).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonInvalidSpec,
+			Message: msg,
+		}).
+		ErrorLogMessage("Error patching KCP RedisUser status with invalid spec").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
+
+// isDefaultAcl returns true if the acl rules are empty or equal to the one rule that grants
+// everything, which is what the default AzureManagedRedis access policy grants
+func isDefaultAcl(rules []string, grantAll string) bool {
+	return len(rules) == 0 || (len(rules) == 1 && rules[0] == grantAll)
+}
@@ -0,0 +1,43 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redisenterprise/armredisenterprise/v3"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func waitAccessPolicyAssignmentAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.accessPolicyAssignment == nil || state.accessPolicyAssignment.Properties == nil {
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	provisioningState := ptr.Deref(state.accessPolicyAssignment.Properties.ProvisioningState, "")
+	switch provisioningState {
+	case armredisenterprise.ProvisioningStateSucceeded:
+		return nil, ctx
+	case armredisenterprise.ProvisioningStateFailed:
+		redisUser := state.ObjAsRedisUser()
+		redisUser.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisUser).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: fmt.Sprintf("Access policy assignment provisioning state is %s", provisioningState),
+			}).
+			ErrorLogMessage("Error updating RedisUser status due failed access policy assignment").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Azure access policy assignment is not ready yet, requeueing with delay")
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
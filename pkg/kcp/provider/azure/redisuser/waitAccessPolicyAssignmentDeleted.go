@@ -0,0 +1,19 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func waitAccessPolicyAssignmentDeleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.accessPolicyAssignment == nil {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Azure access policy assignment is still being deleted, requeueing with delay")
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -242,6 +242,22 @@ func NewPrivateDnsZoneName() string {
 	return "privatelink.redis.cache.windows.net"
 }
 
+// NewFilePrivateDnsZoneName is the recommended private DNS zone name for Azure Files private endpoints
+func NewFilePrivateDnsZoneName() string {
+	if azureconfig.AzureConfig.ClientOptions.Cloud == "AzureChina" {
+		return "privatelink.file.core.chinacloudapi.cn"
+	}
+	return "privatelink.file.core.windows.net"
+}
+
+// NewFileShareHost is the NFS endpoint of the storage account, resolved through the file private DNS zone
+func NewFileShareHost(storageAccountName string) string {
+	if azureconfig.AzureConfig.ClientOptions.Cloud == "AzureChina" {
+		return storageAccountName + ".file.core.chinacloudapi.cn"
+	}
+	return storageAccountName + ".file.core.windows.net"
+}
+
 // NewPrivateDnsZoneGroupResourceId /subscriptions/subId/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/zone1.com
 func NewPrivateDnsZoneGroupResourceId(subscription, resourceGroup, privateDnsZoneInstanceName string) *ResourceDetails {
 	return &ResourceDetails{
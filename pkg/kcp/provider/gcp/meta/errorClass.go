@@ -0,0 +1,63 @@
+package meta
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+)
+
+func init() {
+	util.RegisterErrorClassifier(ClassifyError)
+}
+
+// ClassifyError maps GCP REST and gRPC errors to the util.ErrorClass constants.
+// It returns an empty string for errors not coming from GCP.
+func ClassifyError(err error) string {
+	var googleApiError *googleapi.Error
+	if errors.As(err, &googleApiError) {
+		for _, e := range googleApiError.Errors {
+			switch e.Reason {
+			case "quotaExceeded":
+				return util.ErrorClassQuota
+			case "rateLimitExceeded", "userRateLimitExceeded":
+				return util.ErrorClassThrottled
+			}
+		}
+		if class := util.ErrorClassFromHttpStatus(googleApiError.Code); class != "" {
+			return class
+		}
+	}
+
+	var apiError *apierror.APIError
+	if errors.As(err, &apiError) {
+		if strings.Contains(strings.ToUpper(apiError.Reason()), "RATE_LIMIT") {
+			return util.ErrorClassThrottled
+		}
+		if strings.Contains(strings.ToLower(apiError.GRPCStatus().Message()), "quota") {
+			return util.ErrorClassQuota
+		}
+		switch apiError.GRPCStatus().Code() {
+		case codes.ResourceExhausted:
+			return util.ErrorClassQuota
+		case codes.NotFound:
+			return util.ErrorClassNotFound
+		case codes.AlreadyExists, codes.Aborted:
+			return util.ErrorClassConflict
+		case codes.PermissionDenied, codes.Unauthenticated:
+			return util.ErrorClassForbidden
+		case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+			return util.ErrorClassInvalid
+		case codes.Unavailable, codes.Internal:
+			return util.ErrorClassUnavailable
+		}
+		if class := util.ErrorClassFromHttpStatus(apiError.HTTPCode()); class != "" {
+			return class
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,33 @@
+package meta
+
+import (
+	"testing"
+
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyError(t *testing.T) {
+	grpcErr := func(c codes.Code, msg string) error {
+		err, _ := apierror.FromError(status.Error(c, msg))
+		return err
+	}
+
+	assert.Equal(t, util.ErrorClassNotFound, util.ClassifyError(NewNotFoundError("not found")))
+	assert.Equal(t, util.ErrorClassForbidden, util.ClassifyError(NewNotAuthorizedError("denied")))
+	assert.Equal(t, util.ErrorClassThrottled, util.ClassifyError(NewTooManyRequestsError("slow down")))
+	assert.Equal(t, util.ErrorClassInvalid, util.ClassifyError(NewBadRequestError("bad")))
+	assert.Equal(t, util.ErrorClassUnavailable, util.ClassifyError(NewInternalServerError("oops")))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(&googleapi.Error{
+		Code:   403,
+		Errors: []googleapi.ErrorItem{{Reason: "quotaExceeded"}},
+	}))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(grpcErr(codes.ResourceExhausted, "exhausted")))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(grpcErr(codes.FailedPrecondition, "Quota limit 'RedisInstances' exceeded")))
+	assert.Equal(t, util.ErrorClassInvalid, util.ClassifyError(grpcErr(codes.FailedPrecondition, "wrong state")))
+	assert.Equal(t, util.ErrorClassConflict, util.ClassifyError(grpcErr(codes.AlreadyExists, "exists")))
+}
@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -25,9 +27,13 @@ func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
+		start := time.Now()
+		done := metrics.CloudProviderCallStarted(metrics.CloudProviderGCP)
 		err := invoker(ctx, method, req, reply, cc, opts...)
+		done()
 		region, project := extractFromGrpcContext(ctx)
-		IncrementCallCounter(method, region, project, err)
+		ReportCall(method, region, project, err, time.Since(start))
+		tracing.RecordCloudProviderCall(ctx, metrics.CloudProviderGCP, method, fmt.Sprintf("%d", extractStatusCode(err)), start, err)
 		return err
 	}
 }
@@ -59,7 +65,11 @@ type metricsRoundTripper struct {
 }
 
 func (m *metricsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	done := metrics.CloudProviderCallStarted(metrics.CloudProviderGCP)
 	resp, err := m.base.RoundTrip(req)
+	done()
+	latency := time.Since(start)
 
 	var region, project string
 	if params := req.Header.Get("x-goog-request-params"); params != "" {
@@ -73,7 +83,8 @@ func (m *metricsRoundTripper) RoundTrip(req *http.Request) (*http.Response, erro
 	operation := fmt.Sprintf("%s %s", req.Method, sanitizedPath)
 	apiErr := m.convertToAPIError(resp, err)
 
-	IncrementCallCounter(operation, region, project, apiErr)
+	ReportCall(operation, region, project, apiErr, latency)
+	tracing.RecordCloudProviderCall(req.Context(), metrics.CloudProviderGCP, operation, fmt.Sprintf("%d", extractStatusCode(apiErr)), start, err)
 
 	return resp, err
 }
@@ -218,15 +229,18 @@ func looksLikeID(s string) bool {
 	return false
 }
 
-func IncrementCallCounter(operation, region, project string, err error) {
+// ReportCall records a finished GCP API call with its latency, deriving the
+// response code from the returned error.
+func ReportCall(operation, region, project string, err error, latency time.Duration) {
 	code := extractStatusCode(err)
-	metrics.CloudProviderCallCount.WithLabelValues(
+	metrics.ReportCloudProviderCall(
 		metrics.CloudProviderGCP,
 		operation,
 		fmt.Sprintf("%d", code),
 		region,
 		project,
-	).Inc()
+		latency,
+	)
 }
 
 func extractStatusCode(err error) int {
@@ -31,10 +31,13 @@ func New(stateFactory StateFactory) composed.Action {
 			"gcpNuke",
 			loadNfsBackups,
 			providerResourceStatusDiscovered,
-			deleteNfsBackup,
-			providerResourceStatusDeleting,
-			providerResourceStatusDeleted,
-			checkIfAllProviderResourcesDeleted,
+			composed.If(
+				composed.Not(nuketypes.DryRunPredicate),
+				deleteNfsBackup,
+				providerResourceStatusDeleting,
+				providerResourceStatusDeleted,
+				checkIfAllProviderResourcesDeleted,
+			),
 			// continue to parent action
 			func(ctx context.Context, state composed.State) (error, context.Context) {
 				return nil, ctx
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	sapmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/meta"
 	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	pph "github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -14,9 +16,13 @@ type HTTPClient struct {
 	http.Client
 }
 
-func instrumentCounter(next http.RoundTripper) pph.RoundTripperFunc {
+func instrumentMetrics(next http.RoundTripper) pph.RoundTripperFunc {
 	return func(r *http.Request) (*http.Response, error) {
+		start := time.Now()
+		done := metrics.CloudProviderCallStarted(metrics.CloudProviderOpenStack)
 		resp, err := next.RoundTrip(r)
+		done()
+		latency := time.Since(start)
 		method := "?"
 		ctx := context.Background()
 		if r.URL != nil {
@@ -30,15 +36,15 @@ func instrumentCounter(next http.RoundTripper) pph.RoundTripperFunc {
 				ctx = resp.Request.Context()
 			}
 		}
-		metrics.CloudProviderCallCount.
-			WithLabelValues(
-				"openstack",
-				method,
-				responseCode,
-				sapmeta.GetSapRegion(ctx),
-				fmt.Sprintf("%s/%s", sapmeta.GetSapDomain(ctx), sapmeta.GetSapProject(ctx)),
-			).
-			Inc()
+		metrics.ReportCloudProviderCall(
+			metrics.CloudProviderOpenStack,
+			method,
+			responseCode,
+			sapmeta.GetSapRegion(ctx),
+			fmt.Sprintf("%s/%s", sapmeta.GetSapDomain(ctx), sapmeta.GetSapProject(ctx)),
+			latency,
+		)
+		tracing.RecordCloudProviderCall(ctx, metrics.CloudProviderOpenStack, method, responseCode, start, err)
 		return resp, err
 	}
 }
@@ -50,6 +56,6 @@ func monitoredHttpClient() *http.Client {
 		CheckRedirect: c.CheckRedirect,
 		Jar:           c.Jar,
 		Timeout:       c.Timeout,
-		Transport:     instrumentCounter(transport),
+		Transport:     instrumentMetrics(transport),
 	}
 }
@@ -0,0 +1,30 @@
+package meta
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func init() {
+	util.RegisterErrorClassifier(ClassifyError)
+}
+
+// ClassifyError maps OpenStack response errors to the util.ErrorClass constants.
+// It returns an empty string for errors not coming from OpenStack.
+func ClassifyError(err error) string {
+	// both gophercloud.ErrUnexpectedResponseCode values and pointers implement it
+	var codeErr interface{ GetStatusCode() int }
+	if !errors.As(err, &codeErr) {
+		return ""
+	}
+	statusCode := codeErr.GetStatusCode()
+	// OpenStack reports exceeded quotas either with 413 or with 403 and quota in the message
+	if statusCode == http.StatusRequestEntityTooLarge ||
+		(statusCode == http.StatusForbidden && strings.Contains(strings.ToLower(err.Error()), "quota")) {
+		return util.ErrorClassQuota
+	}
+	return util.ErrorClassFromHttpStatus(statusCode)
+}
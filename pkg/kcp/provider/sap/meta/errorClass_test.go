@@ -0,0 +1,22 @@
+package meta
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	assert.Equal(t, util.ErrorClassNotFound, util.ClassifyError(NewNotFoundError("not found")))
+	assert.Equal(t, util.ErrorClassInvalid, util.ClassifyError(NewBadRequestError("bad")))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(gophercloud.ErrUnexpectedResponseCode{
+		Actual: http.StatusRequestEntityTooLarge,
+	}))
+	assert.Equal(t, util.ErrorClassQuota, util.ClassifyError(gophercloud.ErrUnexpectedResponseCode{
+		Actual: http.StatusForbidden,
+		Body:   []byte(`{"forbidden": {"message": "Quota exceeded for shares"}}`),
+	}))
+}
@@ -10,6 +10,7 @@ import (
 	sapexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/exposedData/client"
 	sapiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/iprange/client"
 	sapnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nfsinstance/client"
+	sapnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nuke/client"
 	sapvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcnetwork/client"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 )
@@ -84,6 +85,16 @@ func (s *server) ShareClientProvider() sapclient.SapClientProvider[sapclient.Sha
 	}
 }
 
+func (s *server) NukeProvider() sapclient.SapClientProvider[sapnukeclient.Client] {
+	return func(ctx context.Context, pp sapclient.ProviderParams) (sapnukeclient.Client, error) {
+		p := s.GetProjectByProviderParams(pp)
+		if p == nil {
+			return nil, fmt.Errorf("no project found for %s", pp.String())
+		}
+		return s.GetProjectByProviderParams(pp), nil
+	}
+}
+
 func (s *server) GetProjectByProviderParams(pp sapclient.ProviderParams) Project {
 	return s.GetProject(pp.DomainName, pp.ProjectName, pp.RegionName)
 }
@@ -5,6 +5,7 @@ import (
 	sapexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/exposedData/client"
 	sapiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/iprange/client"
 	sapnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nfsinstance/client"
+	sapnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nuke/client"
 	sapvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcnetwork/client"
 )
 
@@ -24,6 +25,7 @@ type Providers interface {
 	VpcNetworkProvider() sapclient.SapClientProvider[sapvpcnetworkclient.Client]
 	SnapshotClientProvider() sapclient.SapClientProvider[sapclient.SnapshotClient]
 	ShareClientProvider() sapclient.SapClientProvider[sapclient.ShareClient]
+	NukeProvider() sapclient.SapClientProvider[sapnukeclient.Client]
 }
 
 type Config interface {
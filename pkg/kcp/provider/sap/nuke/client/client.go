@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+)
+
+type Client interface {
+	sapclient.ShareClient
+	sapclient.SnapshotClient
+}
+
+var _ Client = &client{}
+
+type client struct {
+	sapclient.ShareClient
+	sapclient.SnapshotClient
+}
+
+func NewClientProvider() sapclient.SapClientProvider[Client] {
+	return func(ctx context.Context, pp sapclient.ProviderParams) (Client, error) {
+		f := sapclient.NewClientFactory(pp)
+		sh, err := f.ShareClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sn, err := f.SnapshotClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &client{
+			ShareClient:    sh,
+			SnapshotClient: sn,
+		}, nil
+	}
+}
@@ -0,0 +1,27 @@
+package nuke
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	sapconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/config"
+)
+
+func createSapClient(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	pp := sapclient.NewProviderParamsFromConfig(sapconfig.SapConfig).
+		WithDomain(state.Scope().Spec.Scope.OpenStack.DomainName).
+		WithProject(state.Scope().Spec.Scope.OpenStack.TenantName).
+		WithRegion(state.Scope().Spec.Region)
+
+	cli, err := state.sapClientProvider(ctx, pp)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating SAP client", composed.StopWithRequeue, ctx)
+	}
+
+	state.sapClient = cli
+
+	return nil, ctx
+}
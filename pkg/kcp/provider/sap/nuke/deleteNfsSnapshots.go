@@ -0,0 +1,32 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+const snapshotStatusDeleting = "deleting"
+
+func deleteNfsSnapshots(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind != kindSapNfsVolumeSnapshot || rks.Provider != cloudcontrolv1beta1.ProviderOpenStack {
+			continue
+		}
+		for _, obj := range rks.Objects {
+			snapshot := obj.(SapSnapshot)
+			if snapshot.Status == snapshotStatusDeleting {
+				continue
+			}
+			if err := state.sapClient.DeleteSnapshot(ctx, snapshot.ID); err != nil {
+				logger.Error(err, fmt.Sprintf("Error requesting SAP Manila snapshot deletion %s", snapshot.GetId()))
+			}
+		}
+	}
+	return nil, ctx
+}
@@ -0,0 +1,74 @@
+package nuke
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/shares"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadNfsSnapshots loads Manila snapshots of the shares that KCP NfsInstance created in the Scope.
+// Manila snapshots carry no metadata of their own, so they are matched through their share, which
+// is tagged with the scope name. A share can not be deleted while it has snapshots, so the share
+// outlives its snapshots and the match holds until the last snapshot is gone.
+func loadNfsSnapshots(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	allShares, err := state.sapClient.ListShares(ctx, shares.ListOpts{})
+	if err != nil {
+		return loadNfsSnapshotsError(ctx, state, err, "Error listing SAP Manila shares")
+	}
+
+	shareIds := map[string]struct{}{}
+	for _, share := range allShares {
+		if share.Metadata[common.TagScope] == state.Scope().Name {
+			shareIds[share.ID] = struct{}{}
+		}
+	}
+
+	var sapSnapshots []nuketypes.ProviderResourceObject
+	if len(shareIds) > 0 {
+		arr, err := state.sapClient.ListSnapshots(ctx, snapshots.ListOpts{})
+		if err != nil {
+			return loadNfsSnapshotsError(ctx, state, err, "Error listing SAP Manila snapshots")
+		}
+		for _, snapshot := range arr {
+			if _, ok := shareIds[snapshot.ShareID]; ok {
+				sapSnapshots = append(sapSnapshots, SapSnapshot{&snapshot})
+			}
+		}
+	}
+
+	logger.Info("Loaded SAP Manila snapshots", "shareCount", len(shareIds), "snapshotCount", len(sapSnapshots))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     kindSapNfsVolumeSnapshot,
+		Provider: cloudcontrolv1beta1.ProviderOpenStack,
+		Objects:  sapSnapshots,
+	})
+	return nil, ctx
+}
+
+func loadNfsSnapshotsError(ctx context.Context, state *State, err error, msg string) (error, context.Context) {
+	composed.LoggerFromCtx(ctx).Error(err, msg)
+
+	state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+	return composed.PatchStatus(state.ObjAsNuke()).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  "ErrorListingSapNfsVolumeSnapshots",
+			Message: err.Error(),
+		}).
+		ErrorLogMessage("Error patching KCP Nuke status after list SAP Manila snapshots error").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,58 @@
+package nuke
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	sapnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nuke/client"
+)
+
+// kindSapNfsVolumeSnapshot is the nuke ProviderResourceKindState.Kind for Manila snapshots
+// created by SKR SapNfsVolumeSnapshot. Shared by loadNfsSnapshots and deleteNfsSnapshots.
+const kindSapNfsVolumeSnapshot = "SapNfsVolumeSnapshot"
+
+type StateFactory interface {
+	NewState(ctx context.Context, nukeState nuketypes.State) (focal.State, error)
+}
+
+func NewStateFactory(
+	sapClientProvider sapclient.SapClientProvider[sapnukeclient.Client],
+) StateFactory {
+	return stateFactory{
+		sapClientProvider: sapClientProvider,
+	}
+}
+
+type stateFactory struct {
+	sapClientProvider sapclient.SapClientProvider[sapnukeclient.Client]
+}
+
+func (f stateFactory) NewState(ctx context.Context, nukeState nuketypes.State) (focal.State, error) {
+	return &State{
+		State:             nukeState,
+		sapClientProvider: f.sapClientProvider,
+	}, nil
+}
+
+type State struct {
+	nuketypes.State
+	ProviderResources []*nuketypes.ProviderResourceKindState
+
+	sapClientProvider sapclient.SapClientProvider[sapnukeclient.Client]
+	sapClient         sapnukeclient.Client
+}
+
+type SapSnapshot struct {
+	*snapshots.Snapshot
+}
+
+func (s SapSnapshot) GetId() string {
+	return s.ID
+}
+
+func (s SapSnapshot) GetObject() any {
+	return s.Snapshot
+}
@@ -0,0 +1,112 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	redisusertypes "github.com/kyma-project/cloud-manager/pkg/kcp/redisuser/types"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func loadRedis(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(redisusertypes.State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	redisUser := state.ObjAsRedisUser()
+	target := redisUser.Spec.Redis
+
+	var obj client.Object
+	switch target.Kind {
+	case cloudcontrolv1beta1.RedisUserTargetKindRedisInstance:
+		obj = &cloudcontrolv1beta1.RedisInstance{}
+	case cloudcontrolv1beta1.RedisUserTargetKindRedisCluster:
+		obj = &cloudcontrolv1beta1.RedisCluster{}
+	case cloudcontrolv1beta1.RedisUserTargetKindAzureManagedRedis:
+		obj = &cloudcontrolv1beta1.AzureManagedRedis{}
+	default:
+		redisUser.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisUser).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonInvalidSpec,
+				Message: fmt.Sprintf("Unsupported redis kind %s", target.Kind),
+			}).
+			ErrorLogMessage("Error patching KCP RedisUser status with unsupported redis kind").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{
+		Namespace: redisUser.Namespace,
+		Name:      target.Name,
+	}, obj)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading referred redis", composed.StopWithRequeue, ctx)
+	}
+
+	if apierrors.IsNotFound(err) {
+		if composed.MarkedForDeletionPredicate(ctx, state) {
+			// the redis is gone, and the user with it, deletion continues without it
+			return nil, ctx
+		}
+
+		logger.
+			WithValues(
+				"redisKind", target.Kind,
+				"redisName", target.Name,
+			).
+			Info("Referred redis does not exist")
+
+		redisUser.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisUser).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonMissingDependency,
+				Message: fmt.Sprintf("Referred %s %s/%s does not exist", target.Kind, redisUser.Namespace, target.Name),
+			}).
+			ErrorLogMessage("Error patching KCP RedisUser status with missing redis").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	switch x := obj.(type) {
+	case *cloudcontrolv1beta1.RedisInstance:
+		state.SetRedisInstance(x)
+	case *cloudcontrolv1beta1.RedisCluster:
+		state.SetRedisCluster(x)
+	case *cloudcontrolv1beta1.AzureManagedRedis:
+		state.SetAzureManagedRedis(x)
+	}
+
+	return nil, ctx
+}
+
+func waitRedisReady(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(redisusertypes.State)
+
+	var redisState string
+	switch {
+	case state.RedisInstance() != nil:
+		redisState = state.RedisInstance().State()
+	case state.RedisCluster() != nil:
+		redisState = state.RedisCluster().State()
+	case state.AzureManagedRedis() != nil:
+		redisState = state.AzureManagedRedis().State()
+	}
+
+	if redisState == string(cloudcontrolv1beta1.StateReady) {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Waiting for referred redis to become Ready")
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+}
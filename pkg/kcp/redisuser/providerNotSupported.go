@@ -0,0 +1,35 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	redisusertypes "github.com/kyma-project/cloud-manager/pkg/kcp/redisuser/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// providerNotSupported marks the RedisUser as failed on providers whose managed redis
+// offerings have no ACL users, like GCP Memorystore and Alicloud.
+func providerNotSupported(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(redisusertypes.State)
+
+	if composed.MarkedForDeletionPredicate(ctx, state) {
+		return composed.StopAndForget, nil
+	}
+
+	redisUser := state.ObjAsRedisUser()
+	redisUser.Status.State = cloudcontrolv1beta1.StateError
+
+	return composed.UpdateStatus(redisUser).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonInvalidSpec,
+			Message: fmt.Sprintf("RedisUser is not supported on provider %s", state.Scope().Spec.Provider),
+		}).
+		ErrorLogMessage("Error patching KCP RedisUser status with provider not supported").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
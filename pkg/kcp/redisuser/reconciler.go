@@ -0,0 +1,86 @@
+package redisuser
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/common/statewithscope"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	awsredisuser "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/redisuser"
+	azureredisuser "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisuser"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type RedisUserReconciler interface {
+	reconcile.Reconciler
+}
+
+type redisUserReconciler struct {
+	composedStateFactory composed.StateFactory
+	focalStateFactory    focal.StateFactory
+
+	awsStateFactory   awsredisuser.StateFactory
+	azureStateFactory azureredisuser.StateFactory
+}
+
+func NewRedisUserReconciler(
+	composedStateFactory composed.StateFactory,
+	focalStateFactory focal.StateFactory,
+	awsStateFactory awsredisuser.StateFactory,
+	azureStateFactory azureredisuser.StateFactory,
+) RedisUserReconciler {
+	return &redisUserReconciler{
+		composedStateFactory: composedStateFactory,
+		focalStateFactory:    focalStateFactory,
+		awsStateFactory:      awsStateFactory,
+		azureStateFactory:    azureStateFactory,
+	}
+}
+
+func (r *redisUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if Ignore.ShouldIgnoreKey(req) {
+		return ctrl.Result{}, nil
+	}
+
+	state := r.newFocalState(req.NamespacedName)
+	action := r.newAction()
+
+	return composed.Handling().
+		WithMetrics("redisuser", util.RequestObjToString(req)).
+		Handle(action(ctx, state))
+}
+
+func (r *redisUserReconciler) newAction() composed.Action {
+	return composed.ComposeActions(
+		"main",
+		feature.LoadFeatureContextFromObj(&cloudcontrolv1beta1.RedisUser{}),
+		focal.New(),
+		func(ctx context.Context, st composed.State) (error, context.Context) {
+			return composed.ComposeActions(
+				"redisUserCommon",
+				loadRedis,
+				composed.If(
+					composed.Not(composed.MarkedForDeletionPredicate),
+					waitRedisReady,
+				),
+				composed.BuildSwitchAction(
+					"providerSwitch",
+					providerNotSupported,
+					composed.NewCase(statewithscope.AwsProviderPredicate, awsredisuser.New(r.awsStateFactory)),
+					composed.NewCase(statewithscope.AzureProviderPredicate, azureredisuser.New(r.azureStateFactory)),
+				),
+			)(ctx, newState(st.(focal.State)))
+		},
+	)
+}
+
+func (r *redisUserReconciler) newFocalState(name types.NamespacedName) focal.State {
+	return r.focalStateFactory.NewState(
+		r.composedStateFactory.NewState(name, &cloudcontrolv1beta1.RedisUser{}),
+	)
+}
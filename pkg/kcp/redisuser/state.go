@@ -0,0 +1,61 @@
+package redisuser
+
+import (
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/redisuser/types"
+)
+
+type state struct {
+	focal.State
+
+	redisInstance     *cloudcontrolv1beta1.RedisInstance
+	redisCluster      *cloudcontrolv1beta1.RedisCluster
+	azureManagedRedis *cloudcontrolv1beta1.AzureManagedRedis
+}
+
+func (s *state) ObjAsRedisUser() *cloudcontrolv1beta1.RedisUser {
+	return s.Obj().(*cloudcontrolv1beta1.RedisUser)
+}
+
+func (s *state) RedisInstance() *cloudcontrolv1beta1.RedisInstance {
+	return s.redisInstance
+}
+
+func (s *state) SetRedisInstance(r *cloudcontrolv1beta1.RedisInstance) {
+	s.redisInstance = r
+}
+
+func (s *state) RedisCluster() *cloudcontrolv1beta1.RedisCluster {
+	return s.redisCluster
+}
+
+func (s *state) SetRedisCluster(r *cloudcontrolv1beta1.RedisCluster) {
+	s.redisCluster = r
+}
+
+func (s *state) AzureManagedRedis() *cloudcontrolv1beta1.AzureManagedRedis {
+	return s.azureManagedRedis
+}
+
+func (s *state) SetAzureManagedRedis(r *cloudcontrolv1beta1.AzureManagedRedis) {
+	s.azureManagedRedis = r
+}
+
+func (s *state) RedisEndpoint() string {
+	switch {
+	case s.redisInstance != nil:
+		return s.redisInstance.Status.PrimaryEndpoint
+	case s.redisCluster != nil:
+		return s.redisCluster.Status.DiscoveryEndpoint
+	case s.azureManagedRedis != nil && s.azureManagedRedis.Status.PrimaryEndpoint != "":
+		return fmt.Sprintf("%s:%d", s.azureManagedRedis.Status.PrimaryEndpoint, s.azureManagedRedis.Status.Port)
+	}
+	return ""
+}
+
+func newState(focalState focal.State) types.State {
+	return &state{State: focalState}
+}
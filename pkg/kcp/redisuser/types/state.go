@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+)
+
+type State interface {
+	focal.State
+	ObjAsRedisUser() *v1beta1.RedisUser
+
+	// RedisInstance, RedisCluster and AzureManagedRedis return the loaded redis the user
+	// is created in, depending on spec.redis.kind. Only one of them is set at the time.
+	RedisInstance() *v1beta1.RedisInstance
+	SetRedisInstance(r *v1beta1.RedisInstance)
+	RedisCluster() *v1beta1.RedisCluster
+	SetRedisCluster(r *v1beta1.RedisCluster)
+	AzureManagedRedis() *v1beta1.AzureManagedRedis
+	SetAzureManagedRedis(r *v1beta1.AzureManagedRedis)
+
+	// RedisEndpoint returns the host:port of the loaded redis, or empty string if not known yet.
+	RedisEndpoint() string
+}
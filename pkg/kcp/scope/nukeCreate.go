@@ -6,6 +6,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +42,9 @@ func nukeCreate(ctx context.Context, st composed.State) (error, context.Context)
 			Scope: cloudcontrolv1beta1.ScopeRef{
 				Name: state.Name().Name,
 			},
+			DryRun: feature.FFNukeDryRun.Value(
+				feature.ContextBuilderFromCtx(ctx).Provider(string(state.provider)).Build(ctx),
+			),
 		},
 	}
 
@@ -53,7 +57,7 @@ func nukeCreate(ctx context.Context, st composed.State) (error, context.Context)
 		return composed.LogErrorAndReturn(err, "Error creating Nuke", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
 	}
 
-	logger.Info("Nuke created")
+	logger.Info("Nuke created", "dryRun", nuke.Spec.DryRun)
 
 	return composed.StopWithRequeue, ctx
 }
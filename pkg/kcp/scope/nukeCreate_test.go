@@ -0,0 +1,66 @@
+package scope
+
+import (
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNukeCreate(t *testing.T) {
+
+	newNukeCreateState := func() (*State, client.Client) {
+		scope := &cloudcontrolv1beta1.Scope{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-scope", Namespace: "kcp-system"},
+			Spec:       cloudcontrolv1beta1.ScopeSpec{Provider: cloudcontrolv1beta1.ProviderAws},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(commonscheme.KcpScheme).
+			WithObjects(scope).
+			Build()
+		cluster := composed.NewStateCluster(fakeClient, fakeClient, nil, fakeClient.Scheme())
+		state := &State{
+			State:    composed.NewStateFactory(cluster).NewState(types.NamespacedName{Name: scope.Name, Namespace: scope.Namespace}, scope),
+			provider: cloudcontrolv1beta1.ProviderAws,
+		}
+		return state, fakeClient
+	}
+
+	loadNuke := func(t *testing.T, clnt client.Client) *cloudcontrolv1beta1.Nuke {
+		nuke := &cloudcontrolv1beta1.Nuke{}
+		err := clnt.Get(t.Context(), types.NamespacedName{Name: "test-scope", Namespace: "kcp-system"}, nuke)
+		assert.NoError(t, err)
+		return nuke
+	}
+
+	t.Run("Should: create Nuke without dry run by default", func(t *testing.T) {
+		feature.InitializeFromStaticConfig(nil)
+		state, clnt := newNukeCreateState()
+
+		err, _ := nukeCreate(t.Context(), state)
+
+		assert.Equal(t, composed.StopWithRequeue, err)
+		assert.False(t, loadNuke(t, clnt).Spec.DryRun)
+	})
+
+	t.Run("Should: create Nuke with dry run when nukeDryRun flag is enabled", func(t *testing.T) {
+		feature.InitializeFromStaticConfig(abstractions.NewMockedEnvironment(map[string]string{
+			"FF_NUKE_DRY_RUN": "true",
+		}))
+		defer feature.InitializeFromStaticConfig(nil)
+		state, clnt := newNukeCreateState()
+
+		err, _ := nukeCreate(t.Context(), state)
+
+		assert.Equal(t, composed.StopWithRequeue, err)
+		assert.True(t, loadNuke(t, clnt).Spec.DryRun)
+	})
+}
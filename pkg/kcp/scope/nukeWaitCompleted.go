@@ -5,6 +5,7 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/statewithscope"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/api/meta"
 )
 
@@ -23,6 +24,12 @@ func nukeWaitCompleted(ctx context.Context, st composed.State) (error, context.C
 		return nil, ctx
 	}
 
+	// a dry run Nuke deleted nothing, the Scope is kept until an operator
+	// reviews the Nuke status and sets spec.dryRun to false
+	if state.nuke.Spec.DryRun || state.nuke.Status.State == cloudcontrolv1beta1.NukeStateDryRunCompleted {
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
+	}
+
 	readyCond := meta.FindStatusCondition(state.nuke.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
 	if readyCond != nil {
 		return nil, ctx
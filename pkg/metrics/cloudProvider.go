@@ -1,14 +1,18 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 const (
-	CloudProviderAWS   = "aws"
-	CloudProviderGCP   = "gcp"
-	CloudProviderAzure = "azure"
+	CloudProviderAWS       = "aws"
+	CloudProviderGCP       = "gcp"
+	CloudProviderAzure     = "azure"
+	CloudProviderOpenStack = "openstack"
+	CloudProviderAlicloud  = "alicloud"
 )
 
 var (
@@ -16,10 +20,43 @@ var (
 		Name: "cloud_manager_cloud_provider_api_call_total",
 		Help: "Total number of cloud provider API calls per provider, method, response code, and region",
 	}, []string{"provider", "method", "response_code", "region", "subscription"})
+
+	// CloudProviderCallDuration measures the latency of a single cloud provider API call.
+	// The subscription label of CloudProviderCallCount is intentionally left out, since it
+	// is unique per SKR and multiplied by the histogram buckets it would explode cardinality.
+	CloudProviderCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cloud_manager_cloud_provider_api_call_duration_seconds",
+		Help:    "Duration of cloud provider API calls per provider, method, response code, and region",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"provider", "method", "response_code", "region"})
+
+	// CloudProviderCallInFlight is the number of cloud provider API calls currently awaiting a response.
+	CloudProviderCallInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_cloud_provider_api_call_in_flight",
+		Help: "Number of cloud provider API calls currently in flight per provider",
+	}, []string{"provider"})
 )
 
 func init() {
 	metrics.Registry.MustRegister(
 		CloudProviderCallCount,
+		CloudProviderCallDuration,
+		CloudProviderCallInFlight,
 	)
 }
+
+// CloudProviderCallStarted increments the in-flight gauge of the given provider and returns
+// the function that decrements it, to be called once the response is received.
+func CloudProviderCallStarted(provider string) func() {
+	gauge := CloudProviderCallInFlight.WithLabelValues(provider)
+	gauge.Inc()
+	return gauge.Dec
+}
+
+// ReportCloudProviderCall records one finished cloud provider API call in both the
+// CloudProviderCallCount counter and the CloudProviderCallDuration histogram, so the
+// provider hooks can not report one without the other.
+func ReportCloudProviderCall(provider, method, responseCode, region, subscription string, latency time.Duration) {
+	CloudProviderCallCount.WithLabelValues(provider, method, responseCode, region, subscription).Inc()
+	CloudProviderCallDuration.WithLabelValues(provider, method, responseCode, region).Observe(latency.Seconds())
+}
@@ -2,11 +2,13 @@ package metrics
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	sdkmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	smithymiddleware "github.com/aws/smithy-go/middleware"
 	"github.com/aws/smithy-go/transport/http"
 	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	"time"
 )
 
@@ -20,13 +22,14 @@ type awsRequestMetricTuple struct {
 }
 
 func awsReportMetrics(metrics *awsRequestMetricTuple) {
-	CloudProviderCallCount.WithLabelValues(
+	ReportCloudProviderCall(
 		CloudProviderAWS,
 		fmt.Sprintf("%s/%s", metrics.ServiceName, metrics.OperationName),
 		fmt.Sprintf("%d", metrics.ResponseCode),
 		metrics.Region,
 		metrics.Subscription,
-	).Inc()
+		metrics.Latency,
+	)
 }
 
 func AwsReportMetricsMiddleware() smithymiddleware.DeserializeMiddleware {
@@ -37,18 +40,24 @@ func AwsReportMetricsMiddleware() smithymiddleware.DeserializeMiddleware {
 		out smithymiddleware.DeserializeOutput, metadata smithymiddleware.Metadata, err error,
 	) {
 		requestMadeTime := time.Now()
+		done := CloudProviderCallStarted(CloudProviderAWS)
 		out, metadata, err = next.HandleDeserialize(ctx, in)
-		if err != nil {
-			return out, metadata, err
-		}
+		done()
+		latency := time.Since(requestMadeTime)
+		operation := fmt.Sprintf("%s/%s", sdkmiddleware.GetServiceID(ctx), sdkmiddleware.GetOperationName(ctx))
 
+		//Failed, throttled and timed out calls are reported too, with the status code of the
+		//response error, or -1 when no response was received
 		responseStatusCode := -1
 		switch resp := out.RawResponse.(type) {
 		case *http.Response:
 			responseStatusCode = resp.StatusCode
 		}
+		var respErr *http.ResponseError
+		if err != nil && errors.As(err, &respErr) {
+			responseStatusCode = respErr.HTTPStatusCode()
+		}
 
-		latency := time.Since(requestMadeTime)
 		metrics := awsRequestMetricTuple{
 			ServiceName:   sdkmiddleware.GetServiceID(ctx),
 			OperationName: sdkmiddleware.GetOperationName(ctx),
@@ -58,8 +67,9 @@ func AwsReportMetricsMiddleware() smithymiddleware.DeserializeMiddleware {
 			Subscription:  awsmeta.GetAwsAccountId(ctx),
 		}
 		awsReportMetrics(&metrics)
+		tracing.RecordCloudProviderCall(ctx, CloudProviderAWS, operation, fmt.Sprintf("%d", responseStatusCode), requestMadeTime, err)
 
-		return out, metadata, nil
+		return out, metadata, err
 	})
 
 	return reportRequestMetrics
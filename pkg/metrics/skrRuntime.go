@@ -25,10 +25,10 @@ var (
 	}, []string{"sleeve"})
 
 	// SkrLooperGateInFlight is the number of SKRs with a live manager (gate claim
-	// held) right now. It must never exceed NotificationConcurrency + CyclicConcurrency.
+	// held) right now. It must never exceed NotificationConcurrency + CyclicConcurrency + PriorityConcurrency.
 	SkrLooperGateInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
 		Name: "cloud_manager_skr_looper_gate_in_flight",
-		Help: "Number of SKRs with a live manager (gate claim held) right now; must never exceed NotificationConcurrency + CyclicConcurrency",
+		Help: "Number of SKRs with a live manager (gate claim held) right now; must never exceed NotificationConcurrency + CyclicConcurrency + PriorityConcurrency",
 	})
 
 	// SkrLooperNotificationReceivedTotal counts runtime-watcher notifications that
@@ -72,6 +72,50 @@ var (
 		Help:    "Total duration of one SKR connect (pre-amble + Start) per kyma name and timeout outcome",
 		Buckets: []float64{1, 2, 5, 10, 20, 30, 60, 120, 300},
 	}, []string{"kyma", "timeout"})
+
+	// SkrLooperShardMembers is the number of live looper replicas this replica currently
+	// sees through the shard membership leases. Replicas disagreeing on this value for
+	// longer than a renew interval indicates lease renewal problems.
+	SkrLooperShardMembers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_looper_shard_members",
+		Help: "Number of live SKR looper replicas seen through the shard membership leases",
+	})
+
+	// SkrLooperShardOwnedCount is the number of active SKRs owned by this replica's shard.
+	SkrLooperShardOwnedCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_looper_shard_owned_count",
+		Help: "Number of active SKRs owned by this replica's shard",
+	})
+
+	// SkrLooperPriorityCount is the number of SKRs in the priority lane, either for their
+	// broker plan or because their last connect saw objects being deleted or in Error state.
+	SkrLooperPriorityCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_looper_priority_count",
+		Help: "Number of SKRs in the SKR looper priority lane",
+	})
+
+	// SkrLooperFailingSkrCount is the number of SKRs looped by this replica whose last run of
+	// the controller saw objects in Error state or got a reconcile error.
+	// Labels: controller (lowercase kind.group of the reconciled kind).
+	SkrLooperFailingSkrCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_looper_failing_skr_count",
+		Help: "Number of SKRs whose last run of the controller has objects in Error state or reconcile errors",
+	}, []string{"controller"})
+
+	// SkrLooperTimedOutSkrCount is the number of SKRs looped by this replica whose last run hit
+	// SkrWorkerTimeout.
+	SkrLooperTimedOutSkrCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_looper_timed_out_skr_count",
+		Help: "Number of SKRs whose last run hit the SKR worker timeout",
+	})
+
+	// SkrLooperNotificationForwardedTotal counts notifications received by this replica for
+	// an SKR owned by another shard and forwarded to the owning replica.
+	// Labels: result (success/error).
+	SkrLooperNotificationForwardedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloud_manager_skr_looper_notification_forwarded_total",
+		Help: "Total runtime-watcher notifications forwarded to the replica owning the SKR shard, per result",
+	}, []string{"result"})
 )
 
 func init() {
@@ -85,5 +129,11 @@ func init() {
 		SkrLooperNotificationRateLimitedTotal,
 		SkrLooperConnectPhaseSeconds,
 		SkrLooperConnectTotalSeconds,
+		SkrLooperShardMembers,
+		SkrLooperShardOwnedCount,
+		SkrLooperNotificationForwardedTotal,
+		SkrLooperPriorityCount,
+		SkrLooperFailingSkrCount,
+		SkrLooperTimedOutSkrCount,
 	)
 }
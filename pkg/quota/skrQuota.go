@@ -1,12 +1,16 @@
 package quota
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/kyma-project/cloud-manager/pkg/config"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"strings"
+	"sync"
 )
 
 func InitConfig(cfg config.Config) {
@@ -18,74 +22,256 @@ func InitConfig(cfg config.Config) {
 	)
 }
 
+const (
+	// QuotaTotalCount limits the number of objects of a kind in the SKR
+	QuotaTotalCount = "totalCount"
+	// QuotaTotalCapacityGb limits the sum of the volume capacities in GB of a kind in the SKR
+	QuotaTotalCapacityGb = "totalCapacityGb"
+	// QuotaTotalMemoryGb limits the sum of the Redis memory in GB, as defined by the tier, of a kind in the SKR
+	QuotaTotalMemoryGb = "totalMemoryGb"
+)
+
 type SkrQuotaIntf interface {
 	TotalCountForObj(obj runtime.Object, scheme *runtime.Scheme, skr string) int
+	LimitForObj(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string) int
+	LimitForSubject(obj runtime.Object, scheme *runtime.Scheme, subject Subject, quotaName string) int
 	Override(obj runtime.Object, scheme *runtime.Scheme, skr string, value int)
+	OverrideLimit(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string, value int)
+}
+
+// Subject identifies the SKR the quota is evaluated for with all the dimensions
+// the quota overrides can be selected by.
+type Subject struct {
+	Kyma          string
+	SubAccount    string
+	GlobalAccount string
+	BrokerPlan    string
+	Provider      string
+}
+
+// SubjectFromCtx returns the Subject for the given kyma with other dimensions read
+// from the feature context, that the SKR looper loads from the Scope.
+func SubjectFromCtx(ctx context.Context, kyma string) Subject {
+	result := Subject{Kyma: kyma}
+	if feature.ContextFromCtx(ctx) == nil {
+		return result
+	}
+	reader := feature.NewContextReaderFromCtx(ctx)
+	result.SubAccount = reader.SubAccount()
+	result.GlobalAccount = reader.GlobalAccount()
+	result.BrokerPlan = reader.BrokerPlan()
+	result.Provider = reader.Provider()
+	return result
 }
 
 func DefaultSkrQuota() SkrQuotaIntf {
-	return &skrQuotaConfig{
+	q := &skrQuotaConfig{
 		Defaults: map[string]int{
 			// sigs.k8s.io/controller-runtime@v0.16.3/pkg/builder/controller.go#getControllerName
 			// quota names are in the form `[lower(Kind)].[Group]/[quotaName]`
-			"iprange.cloud-resources.kyma-project.io/totalCount":      1,
-			"awsnfsvolume.cloud-resources.kyma-project.io/totalCount": 5,
+			"iprange.cloud-resources.kyma-project.io/totalCount":           1,
+			"awsnfsvolume.cloud-resources.kyma-project.io/totalCount":      5,
+			"azurenfsvolume.cloud-resources.kyma-project.io/totalCount":    10,
+			"gcpnfsvolume.cloud-resources.kyma-project.io/totalCount":      10,
+			"sapnfsvolume.cloud-resources.kyma-project.io/totalCount":      10,
+			"alicloudnfsvolume.cloud-resources.kyma-project.io/totalCount": 10,
+
+			"awsredisinstance.cloud-resources.kyma-project.io/totalCount":      10,
+			"awsrediscluster.cloud-resources.kyma-project.io/totalCount":       10,
+			"azureredisinstance.cloud-resources.kyma-project.io/totalCount":    10,
+			"azurerediscluster.cloud-resources.kyma-project.io/totalCount":     10,
+			"azuremanagedredis.cloud-resources.kyma-project.io/totalCount":     10,
+			"gcpredisinstance.cloud-resources.kyma-project.io/totalCount":      10,
+			"gcprediscluster.cloud-resources.kyma-project.io/totalCount":       10,
+			"alicloudredisinstance.cloud-resources.kyma-project.io/totalCount": 10,
+			"alicloudrediscluster.cloud-resources.kyma-project.io/totalCount":  10,
+			"redisuser.cloud-resources.kyma-project.io/totalCount":             50,
+
+			"awsvpcpeering.cloud-resources.kyma-project.io/totalCount":   10,
+			"azurevpcpeering.cloud-resources.kyma-project.io/totalCount": 10,
+			"gcpvpcpeering.cloud-resources.kyma-project.io/totalCount":   10,
+
+			// backups are created by schedules and limited by their retention, so only schedules are limited by default
+			"awsnfsbackupschedule.cloud-resources.kyma-project.io/totalCount":         10,
+			"azurerwxbackupschedule.cloud-resources.kyma-project.io/totalCount":       10,
+			"gcpnfsbackupschedule.cloud-resources.kyma-project.io/totalCount":         10,
+			"sapnfsvolumesnapshotschedule.cloud-resources.kyma-project.io/totalCount": 10,
 		},
 		Overrides: map[string]skrQuotaSpec{},
 	}
+	q.AfterConfigLoaded()
+	return q
 }
 
+// skrQuotaConfig is bound to the config and its exported fields are the decoding target.
+// Overrides are matched in the following precedence order, the first one defining the
+// quota wins: kyma, subaccount, global account, broker plan, provider, and finally defaults.
+// Since config is hot-reloaded while reconcilers are reading it, after each load the
+// decoded values are moved into the active snapshot guarded by the mutex, and the exported
+// fields are cleared, so the next load does not inherit the entries removed from the file.
 type skrQuotaConfig struct {
-	Defaults  skrQuotaSpec            `json:"defaults,omitempty" yaml:"defaults,omitempty"`
-	Overrides map[string]skrQuotaSpec `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+	Defaults       skrQuotaSpec            `json:"defaults,omitempty" yaml:"defaults,omitempty"`
+	Overrides      map[string]skrQuotaSpec `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+	SubAccounts    map[string]skrQuotaSpec `json:"subAccounts,omitempty" yaml:"subAccounts,omitempty"`
+	GlobalAccounts map[string]skrQuotaSpec `json:"globalAccounts,omitempty" yaml:"globalAccounts,omitempty"`
+	BrokerPlans    map[string]skrQuotaSpec `json:"brokerPlans,omitempty" yaml:"brokerPlans,omitempty"`
+	Providers      map[string]skrQuotaSpec `json:"providers,omitempty" yaml:"providers,omitempty"`
+
+	m      sync.RWMutex
+	active skrQuotaValues
+}
+
+type skrQuotaValues struct {
+	defaults       skrQuotaSpec
+	overrides      map[string]skrQuotaSpec
+	subAccounts    map[string]skrQuotaSpec
+	globalAccounts map[string]skrQuotaSpec
+	brokerPlans    map[string]skrQuotaSpec
+	providers      map[string]skrQuotaSpec
 }
 
 type skrQuotaSpec map[string]int
 
 var SkrQuota SkrQuotaIntf = DefaultSkrQuota()
 
-func (q *skrQuotaConfig) TotalCountForObj(obj runtime.Object, scheme *runtime.Scheme, skr string) (result int) {
-	result = util.MaxInt
+func (q *skrQuotaConfig) AfterConfigLoaded() {
+	q.m.Lock()
+	defer q.m.Unlock()
+	q.active = skrQuotaValues{
+		defaults:       copySpec(q.Defaults),
+		overrides:      copySpecMap(q.Overrides),
+		subAccounts:    copySpecMap(q.SubAccounts),
+		globalAccounts: copySpecMap(q.GlobalAccounts),
+		brokerPlans:    copySpecMap(q.BrokerPlans),
+		providers:      copySpecMap(q.Providers),
+	}
+	q.Defaults = nil
+	q.Overrides = nil
+	q.SubAccounts = nil
+	q.GlobalAccounts = nil
+	q.BrokerPlans = nil
+	q.Providers = nil
+}
+
+// MarshalJSON marshals the active snapshot, so the config can use it as default object.
+func (q *skrQuotaConfig) MarshalJSON() ([]byte, error) {
+	q.m.RLock()
+	defer q.m.RUnlock()
+	return json.Marshal(struct {
+		Defaults       skrQuotaSpec            `json:"defaults,omitempty"`
+		Overrides      map[string]skrQuotaSpec `json:"overrides,omitempty"`
+		SubAccounts    map[string]skrQuotaSpec `json:"subAccounts,omitempty"`
+		GlobalAccounts map[string]skrQuotaSpec `json:"globalAccounts,omitempty"`
+		BrokerPlans    map[string]skrQuotaSpec `json:"brokerPlans,omitempty"`
+		Providers      map[string]skrQuotaSpec `json:"providers,omitempty"`
+	}{
+		Defaults:       q.active.defaults,
+		Overrides:      q.active.overrides,
+		SubAccounts:    q.active.subAccounts,
+		GlobalAccounts: q.active.globalAccounts,
+		BrokerPlans:    q.active.brokerPlans,
+		Providers:      q.active.providers,
+	})
+}
+
+func copySpec(in skrQuotaSpec) skrQuotaSpec {
+	result := make(skrQuotaSpec, len(in))
+	for k, v := range in {
+		result[k] = v
+	}
+	return result
+}
+
+func copySpecMap(in map[string]skrQuotaSpec) map[string]skrQuotaSpec {
+	result := make(map[string]skrQuotaSpec, len(in))
+	for k, v := range in {
+		result[k] = copySpec(v)
+	}
+	return result
+}
+
+func quotaKey(obj runtime.Object, scheme *runtime.Scheme, quotaName string) (string, error) {
 	gvk, err := apiutil.GVKForObject(obj, scheme)
 	if err != nil {
-		return
+		return "", err
+	}
+	return fmt.Sprintf("%s.%s/%s", strings.ToLower(gvk.Kind), gvk.Group, quotaName), nil
+}
+
+func (q *skrQuotaConfig) TotalCountForObj(obj runtime.Object, scheme *runtime.Scheme, skr string) int {
+	return q.LimitForObj(obj, scheme, skr, QuotaTotalCount)
+}
+
+// LimitForObj returns the value of the named quota for the kind of the given object in the given SKR,
+// or util.MaxInt if the quota is not configured, meaning the kind is not limited by it.
+func (q *skrQuotaConfig) LimitForObj(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string) int {
+	return q.LimitForSubject(obj, scheme, Subject{Kyma: skr}, quotaName)
+}
+
+// LimitForSubject returns the value of the named quota for the kind of the given object
+// resolved from the overrides matching the subject in their precedence order, or
+// util.MaxInt if the quota is not configured, meaning the kind is not limited by it.
+func (q *skrQuotaConfig) LimitForSubject(obj runtime.Object, scheme *runtime.Scheme, subject Subject, quotaName string) int {
+	name, err := quotaKey(obj, scheme, quotaName)
+	if err != nil {
+		return util.MaxInt
 	}
-	name := fmt.Sprintf("%s.%s/totalCount", strings.ToLower(gvk.Kind), gvk.Group)
-	if q.Overrides != nil {
-		skrSpec, ok := q.Overrides[skr]
-		if ok {
-			val, ok := skrSpec[name]
-			if ok {
-				result = val
-				return
-			}
+
+	q.m.RLock()
+	defer q.m.RUnlock()
+
+	for _, x := range []struct {
+		overrides map[string]skrQuotaSpec
+		key       string
+	}{
+		{q.active.overrides, subject.Kyma},
+		{q.active.subAccounts, subject.SubAccount},
+		{q.active.globalAccounts, subject.GlobalAccount},
+		{q.active.brokerPlans, subject.BrokerPlan},
+		{q.active.providers, subject.Provider},
+	} {
+		if len(x.key) == 0 {
+			continue
+		}
+		if val, ok := x.overrides[x.key][name]; ok {
+			return val
 		}
 	}
-	val, ok := q.Defaults[name]
-	if ok {
-		result = val
+
+	if val, ok := q.active.defaults[name]; ok {
+		return val
 	}
-	return
+	return util.MaxInt
 }
 
 func (q *skrQuotaConfig) Override(obj runtime.Object, scheme *runtime.Scheme, skr string, value int) {
-	gvk, err := apiutil.GVKForObject(obj, scheme)
+	q.OverrideLimit(obj, scheme, skr, QuotaTotalCount, value)
+}
+
+func (q *skrQuotaConfig) OverrideLimit(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string, value int) {
+	name, err := quotaKey(obj, scheme, quotaName)
 	if err != nil {
 		return
 	}
-	name := fmt.Sprintf("%s.%s/totalCount", strings.ToLower(gvk.Kind), gvk.Group)
+
+	q.m.Lock()
+	defer q.m.Unlock()
+
 	if len(skr) > 0 {
-		if q.Overrides == nil {
-			q.Overrides = map[string]skrQuotaSpec{}
+		if q.active.overrides == nil {
+			q.active.overrides = map[string]skrQuotaSpec{}
 		}
-		spec, ok := q.Overrides[skr]
+		spec, ok := q.active.overrides[skr]
 		if !ok {
-			spec := skrQuotaSpec{}
-			q.Overrides[skr] = spec
+			spec = skrQuotaSpec{}
+			q.active.overrides[skr] = spec
 		}
 		spec[name] = value
 		return
 	}
 
-	q.Defaults[name] = value
+	if q.active.defaults == nil {
+		q.active.defaults = skrQuotaSpec{}
+	}
+	q.active.defaults[name] = value
 }
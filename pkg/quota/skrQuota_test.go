@@ -1,13 +1,17 @@
 package quota
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
 	"github.com/kyma-project/cloud-manager/pkg/config"
+	"github.com/kyma-project/cloud-manager/pkg/util"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestSkrConfig(t *testing.T) {
@@ -41,4 +45,84 @@ func TestSkrConfig(t *testing.T) {
 	SkrQuota.Override(&cloudresourcesv1beta1.IpRange{}, commonscheme.SkrScheme, "skr123", 200)
 	assert.Equal(t, 100, SkrQuota.TotalCountForObj(&cloudresourcesv1beta1.IpRange{}, commonscheme.SkrScheme, "anyskr"))
 	assert.Equal(t, 200, SkrQuota.TotalCountForObj(&cloudresourcesv1beta1.IpRange{}, commonscheme.SkrScheme, "skr123"))
+
+	// capacity quotas
+	assert.Equal(t, 5000, SkrQuota.LimitForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "anyskr", QuotaTotalCapacityGb))
+	assert.Equal(t, 10000, SkrQuota.LimitForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "skr123", QuotaTotalCapacityGb))
+	assert.Equal(t, util.MaxInt, SkrQuota.LimitForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "anyskr", QuotaTotalMemoryGb))
+
+	// when capacity override is set for skr without overrides in config
+	SkrQuota.OverrideLimit(&cloudresourcesv1beta1.GcpRedisInstance{}, commonscheme.SkrScheme, "skr456", QuotaTotalMemoryGb, 50)
+	assert.Equal(t, 50, SkrQuota.LimitForObj(&cloudresourcesv1beta1.GcpRedisInstance{}, commonscheme.SkrScheme, "skr456", QuotaTotalMemoryGb))
+	assert.Equal(t, util.MaxInt, SkrQuota.LimitForObj(&cloudresourcesv1beta1.GcpRedisInstance{}, commonscheme.SkrScheme, "anyskr", QuotaTotalMemoryGb))
+}
+
+func TestSkrConfigOverrideSelectors(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "resourceQuotaSkr.yaml")
+	q := DefaultSkrQuota()
+	cfg := config.NewConfig(abstractions.NewMockedEnvironment(nil))
+	cfg.Path("resourceQuota.skr",
+		config.SourceFile(file),
+		config.DefaultObj(q),
+		config.Bind(q),
+	)
+
+	assert.NoError(t, os.WriteFile(file, []byte(`
+overrides:
+  kyma1:
+    awsnfsvolume.cloud-resources.kyma-project.io/totalCount: 1
+subAccounts:
+  sa1:
+    awsnfsvolume.cloud-resources.kyma-project.io/totalCount: 2
+globalAccounts:
+  ga1:
+    awsnfsvolume.cloud-resources.kyma-project.io/totalCount: 3
+    gcpnfsvolume.cloud-resources.kyma-project.io/totalCount: 30
+brokerPlans:
+  aws:
+    awsnfsvolume.cloud-resources.kyma-project.io/totalCount: 4
+providers:
+  aws:
+    awsnfsvolume.cloud-resources.kyma-project.io/totalCount: 5
+    awsredisinstance.cloud-resources.kyma-project.io/totalCount: 50
+`), 0644))
+	cfg.Read()
+
+	limit := func(obj runtime.Object, subject Subject) int {
+		return q.LimitForSubject(obj, commonscheme.SkrScheme, subject, QuotaTotalCount)
+	}
+	nfs := &cloudresourcesv1beta1.AwsNfsVolume{}
+
+	// precedence: kyma, subaccount, global account, broker plan, provider, defaults
+	full := Subject{Kyma: "kyma1", SubAccount: "sa1", GlobalAccount: "ga1", BrokerPlan: "aws", Provider: "aws"}
+	assert.Equal(t, 1, limit(nfs, full))
+	full.Kyma = "kyma2"
+	assert.Equal(t, 2, limit(nfs, full))
+	full.SubAccount = "sa2"
+	assert.Equal(t, 3, limit(nfs, full))
+	full.GlobalAccount = "ga2"
+	assert.Equal(t, 4, limit(nfs, full))
+	full.BrokerPlan = "azure"
+	assert.Equal(t, 5, limit(nfs, full))
+	full.Provider = "azure"
+	assert.Equal(t, 5, limit(nfs, full)) // built-in default
+
+	// each quota is resolved independently
+	subject := Subject{Kyma: "kyma1", GlobalAccount: "ga1", Provider: "aws"}
+	assert.Equal(t, 30, limit(&cloudresourcesv1beta1.GcpNfsVolume{}, subject))
+	assert.Equal(t, 50, limit(&cloudresourcesv1beta1.AwsRedisInstance{}, subject))
+	assert.Equal(t, 1, limit(&cloudresourcesv1beta1.IpRange{}, subject))
+
+	// when file is changed, removed overrides are not kept
+	assert.NoError(t, os.WriteFile(file, []byte(`
+globalAccounts:
+  ga1:
+    gcpnfsvolume.cloud-resources.kyma-project.io/totalCount: 40
+`), 0644))
+	cfg.Read()
+
+	assert.Equal(t, 5, limit(nfs, Subject{Kyma: "kyma1", SubAccount: "sa1", GlobalAccount: "ga1"}))
+	assert.Equal(t, 40, limit(&cloudresourcesv1beta1.GcpNfsVolume{}, subject))
+	assert.Equal(t, 10, limit(&cloudresourcesv1beta1.AwsRedisInstance{}, subject))
 }
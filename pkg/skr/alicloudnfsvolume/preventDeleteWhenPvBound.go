@@ -0,0 +1,60 @@
+package alicloudnfsvolume
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// preventDeleteWhenPvBound keeps the NAS file system while its PersistentVolume is still
+// bound to a claim. The PVC created by AlicloudNfsVolume is deleted before this action runs,
+// so a Bound PV means some other workload claimed it.
+func preventDeleteWhenPvBound(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if !composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, nil
+	}
+
+	if state.Volume == nil || !state.Volume.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+
+	nfsVolume := state.ObjAsAlicloudNfsVolume()
+
+	if state.Volume.Status.Phase == corev1.VolumeBound {
+		errCond := meta.FindStatusCondition(nfsVolume.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+		if errCond != nil && errCond.Reason == cloudresourcesv1beta1.ConditionReasonPVNotReadyForDeletion {
+			return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+		}
+		nfsVolume.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(nfsVolume).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonPVNotReadyForDeletion,
+				Message: fmt.Sprintf("Deletion is blocked while PersistentVolume %s is bound to a PersistentVolumeClaim", state.Volume.Name),
+			}).
+			ErrorLogMessage("Error updating AlicloudNfsVolume status with PersistentVolume bound condition").
+			SuccessLogMsg("AlicloudNfsVolume deletion blocked by bound PersistentVolume").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	if meta.FindStatusCondition(nfsVolume.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError) != nil {
+		nfsVolume.Status.State = cloudresourcesv1beta1.StateDeleting
+		return composed.UpdateStatus(nfsVolume).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			ErrorLogMessage("Error removing Error condition from AlicloudNfsVolume after PersistentVolume got unbound").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
+	return nil, nil
+}
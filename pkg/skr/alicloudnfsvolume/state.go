@@ -0,0 +1,149 @@
+package alicloudnfsvolume
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsvolume"
+	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	composed.State
+	KymaRef    klog.ObjectRef
+	KcpCluster composed.StateCluster
+
+	SkrIpRange     *cloudresourcesv1beta1.IpRange
+	KcpNfsInstance *cloudcontrolv1beta1.NfsInstance
+	Volume         *corev1.PersistentVolume
+	PVC            *corev1.PersistentVolumeClaim
+}
+
+func newStateFactory(
+	baseStateFactory composed.StateFactory,
+	scopeProvider scopeprovider.ScopeProvider,
+	kcpCluster composed.StateCluster,
+) *stateFactory {
+	return &stateFactory{
+		baseStateFactory: baseStateFactory,
+		scopeProvider:    scopeProvider,
+		kcpCluster:       kcpCluster,
+	}
+}
+
+type stateFactory struct {
+	baseStateFactory composed.StateFactory
+	scopeProvider    scopeprovider.ScopeProvider
+	kcpCluster       composed.StateCluster
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	kymaRef, err := f.scopeProvider.GetScope(ctx, req.NamespacedName)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:      f.baseStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.AlicloudNfsVolume{}),
+		KymaRef:    kymaRef,
+		KcpCluster: f.kcpCluster,
+	}, nil
+}
+
+func (s *State) ObjAsAlicloudNfsVolume() *cloudresourcesv1beta1.AlicloudNfsVolume {
+	return s.Obj().(*cloudresourcesv1beta1.AlicloudNfsVolume)
+}
+
+func (s *State) GetSkrIpRange() *cloudresourcesv1beta1.IpRange {
+	return s.SkrIpRange
+}
+
+func (s *State) SetSkrIpRange(skrIpRange *cloudresourcesv1beta1.IpRange) {
+	s.SkrIpRange = skrIpRange
+}
+
+func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
+	return s.ObjAsAlicloudNfsVolume()
+}
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpNfsInstance != nil
+}
+
+// nfsvolume.State implementation ---------------------------------------------
+
+var _ nfsvolume.State = &State{}
+
+func (s *State) ObjAsObjWithConditionsAndState() composed.ObjWithConditionsAndState {
+	return s.ObjAsAlicloudNfsVolume()
+}
+
+func (s *State) GetKcpNfsInstance() *cloudcontrolv1beta1.NfsInstance {
+	return s.KcpNfsInstance
+}
+
+func (s *State) GetVolume() *corev1.PersistentVolume {
+	return s.Volume
+}
+
+func (s *State) SetVolume(volume *corev1.PersistentVolume) {
+	s.Volume = volume
+}
+
+func (s *State) GetPVC() *corev1.PersistentVolumeClaim {
+	return s.PVC
+}
+
+func (s *State) SetPVC(pvc *corev1.PersistentVolumeClaim) {
+	s.PVC = pvc
+}
+
+func (s *State) GetVolumeId() string {
+	return s.ObjAsAlicloudNfsVolume().Status.Id
+}
+
+func (s *State) GetVolumeName() string {
+	return getVolumeName(s.ObjAsAlicloudNfsVolume())
+}
+
+func (s *State) GetVolumeLabels() map[string]string {
+	return getVolumeLabels(s.ObjAsAlicloudNfsVolume())
+}
+
+func (s *State) GetVolumeAnnotations() map[string]string {
+	return getVolumeAnnotations(s.ObjAsAlicloudNfsVolume())
+}
+
+func (s *State) GetVolumeClaimName() string {
+	return getVolumeClaimName(s.ObjAsAlicloudNfsVolume())
+}
+
+func (s *State) GetVolumeClaimLabels() map[string]string {
+	return getVolumeClaimLabels(s.ObjAsAlicloudNfsVolume())
+}
+
+func (s *State) GetVolumeClaimAnnotations() map[string]string {
+	return getVolumeClaimAnnotations(s.ObjAsAlicloudNfsVolume())
+}
+
+func (s *State) GetCapacity() resource.Quantity {
+	return *alicloudNfsVolumeCapacityToResourceQuantity(s.ObjAsAlicloudNfsVolume())
+}
+
+func (s *State) GetNfsServer() string {
+	return s.ObjAsAlicloudNfsVolume().Status.Server
+}
+
+func (s *State) GetNfsPath() string {
+	return s.ObjAsAlicloudNfsVolume().Status.Path
+}
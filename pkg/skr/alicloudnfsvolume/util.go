@@ -0,0 +1,86 @@
+package alicloudnfsvolume
+
+import (
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func getVolumeName(alicloudVol *cloudresourcesv1beta1.AlicloudNfsVolume) string {
+	if alicloudVol.Spec.PersistentVolume != nil &&
+		len(alicloudVol.Spec.PersistentVolume.Name) > 0 {
+		return alicloudVol.Spec.PersistentVolume.Name
+	}
+
+	return alicloudVol.Status.Id
+}
+
+func getVolumeLabels(alicloudVol *cloudresourcesv1beta1.AlicloudNfsVolume) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if alicloudVol.Spec.PersistentVolume != nil {
+		for labelName, labelValue := range alicloudVol.Spec.PersistentVolume.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolName, alicloudVol.Name)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolNS, alicloudVol.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+	pvLabels := labelsBuilder.Build()
+
+	return pvLabels
+}
+
+func getVolumeAnnotations(alicloudVol *cloudresourcesv1beta1.AlicloudNfsVolume) map[string]string {
+	if alicloudVol.Spec.PersistentVolume == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, alicloudVol.Spec.PersistentVolume.Annotations)
+	return result
+}
+
+func getVolumeClaimName(alicloudVol *cloudresourcesv1beta1.AlicloudNfsVolume) string {
+	if alicloudVol.Spec.PersistentVolumeClaim != nil &&
+		len(alicloudVol.Spec.PersistentVolumeClaim.Name) > 0 {
+		return alicloudVol.Spec.PersistentVolumeClaim.Name
+	}
+
+	return alicloudVol.Name
+}
+
+func getVolumeClaimLabels(alicloudVol *cloudresourcesv1beta1.AlicloudNfsVolume) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if alicloudVol.Spec.PersistentVolumeClaim != nil {
+		for labelName, labelValue := range alicloudVol.Spec.PersistentVolumeClaim.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolName, alicloudVol.Name)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolNS, alicloudVol.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelStorageCapacity, alicloudNfsVolumeCapacityToResourceQuantity(alicloudVol).String())
+
+	pvcLabels := labelsBuilder.Build()
+	return pvcLabels
+}
+
+func getVolumeClaimAnnotations(alicloudVol *cloudresourcesv1beta1.AlicloudNfsVolume) map[string]string {
+	if alicloudVol.Spec.PersistentVolumeClaim == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, alicloudVol.Spec.PersistentVolumeClaim.Annotations)
+	return result
+}
+
+func alicloudNfsVolumeCapacityToResourceQuantity(alicloudVol *cloudresourcesv1beta1.AlicloudNfsVolume) *resource.Quantity {
+	return resource.NewQuantity(int64(alicloudVol.Spec.CapacityGb)*1024*1024*1024, resource.BinarySI)
+}
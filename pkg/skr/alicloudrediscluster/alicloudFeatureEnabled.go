@@ -0,0 +1,14 @@
+package alicloudrediscluster
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+)
+
+// alicloudFeatureEnabled evaluates the alicloud feature flag. AlicloudRedisCluster is only
+// active on alicloud SKRs, so the provider is set explicitly for the flag evaluation.
+func alicloudFeatureEnabled(ctx context.Context, _ composed.State) bool {
+	return feature.Alicloud.Value(feature.ContextBuilderFromCtx(ctx).Provider("alicloud").Build(ctx))
+}
@@ -0,0 +1,5 @@
+package alicloudrediscluster
+
+import "github.com/kyma-project/cloud-manager/pkg/common/ignorant"
+
+var Ignore = ignorant.New()
@@ -0,0 +1,77 @@
+package alicloudrediscluster
+
+import (
+	"context"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyKcpRedisCluster(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	alicloudRedisCluster := state.ObjAsAlicloudRedisCluster()
+
+	if !meta.IsStatusConditionTrue(alicloudRedisCluster.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady) {
+		return nil, ctx
+	}
+
+	if state.KcpRedisCluster == nil {
+		return nil, ctx
+	}
+
+	instanceClass, err := RedisTierToInstanceClassConverter(alicloudRedisCluster.Spec.RedisTier, alicloudRedisCluster.Spec.ShardCount)
+
+	if err != nil {
+		errMsg := "Failed to map redisTier to instance class"
+		logger.Error(err, errMsg, "redisTier", alicloudRedisCluster.Spec.RedisTier)
+		alicloudRedisCluster.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(alicloudRedisCluster).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AlicloudRedisCluster status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR alicloudRedisCluster status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	instanceClassChanged := state.KcpRedisCluster.Spec.Instance.Alicloud.InstanceClass != instanceClass
+	shardCountChanged := state.KcpRedisCluster.Spec.Instance.Alicloud.ShardCount != alicloudRedisCluster.Spec.ShardCount
+
+	if !instanceClassChanged && !shardCountChanged {
+		return nil, ctx
+	}
+
+	state.KcpRedisCluster.Spec.Instance.Alicloud.InstanceClass = instanceClass
+	state.KcpRedisCluster.Spec.Instance.Alicloud.ShardCount = alicloudRedisCluster.Spec.ShardCount
+
+	logger.Info("Detected modified Redis configuration, updating KCP Redis")
+	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpRedisCluster)
+
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP RedisCluster", composed.StopWithRequeue, ctx)
+	}
+
+	alicloudRedisCluster.Status.State = cloudresourcesv1beta1.StateUpdating
+	return composed.UpdateStatus(alicloudRedisCluster).
+		SetCondition(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeProcessing,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionTypeProcessing,
+			Message: "Processing the resource modification",
+		}).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+		ErrorLogMessage("Error setting Updating state on AlicloudRedisCluster").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
@@ -0,0 +1,24 @@
+package alicloudrediscluster
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// QuotaUsage returns the memory, as defined by the tier of the shard times the shard count, the cluster
+// makes of the SKR quota. Read-only replicas hold copies of the same data and are not counted.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.AlicloudRedisCluster)
+	if !ok {
+		return nil
+	}
+	shardMemoryGb, ok := alicloudRedisClusterTierToShardMemoryGbMap[redis.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: shardMemoryGb * int(redis.Spec.ShardCount),
+	}
+}
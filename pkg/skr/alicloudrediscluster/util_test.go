@@ -0,0 +1,44 @@
+package alicloudrediscluster
+
+import (
+	"fmt"
+	"testing"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+type converterTestCase struct {
+	InputRedisTier        cloudresourcesv1beta1.AlicloudRedisClusterTier
+	InputShardCount       int32
+	ExpectedInstanceClass string
+}
+
+func TestRedisTierToInstanceClassConverter(t *testing.T) {
+
+	t.Run("RedisTierToInstanceClassConverter", func(t *testing.T) {
+
+		testCases := []converterTestCase{
+			{cloudresourcesv1beta1.AlicloudRedisClusterTierC3, 2, "redis.logic.sharding.4g.2db.0rodb.4proxy.default"},
+			{cloudresourcesv1beta1.AlicloudRedisClusterTierC4, 4, "redis.logic.sharding.8g.4db.0rodb.4proxy.default"},
+			{cloudresourcesv1beta1.AlicloudRedisClusterTierC5, 8, "redis.logic.sharding.16g.8db.0rodb.8proxy.default"},
+			{cloudresourcesv1beta1.AlicloudRedisClusterTierC7, 16, "redis.logic.sharding.64g.16db.0rodb.16proxy.default"},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("should return expected result for input (%s, %d)", testCase.InputRedisTier, testCase.InputShardCount), func(t *testing.T) {
+				instanceClass, err := RedisTierToInstanceClassConverter(testCase.InputRedisTier, testCase.InputShardCount)
+
+				assert.Equal(t, testCase.ExpectedInstanceClass, instanceClass, "resulting instance class does not match expected")
+				assert.Nil(t, err, "expected nil error, got an error")
+			})
+		}
+
+		t.Run("should return error for unknown input", func(t *testing.T) {
+			instanceClass, err := RedisTierToInstanceClassConverter("unknown", 2)
+
+			assert.NotNil(t, err, "expected defined error, got nil")
+			assert.Equal(t, "", instanceClass, "expected instance class to have zero value")
+		})
+	})
+}
@@ -0,0 +1,76 @@
+package alicloudredisinstance
+
+import (
+	"context"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyKcpRedisInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	alicloudRedisInstance := state.ObjAsAlicloudRedisInstance()
+
+	if !meta.IsStatusConditionTrue(alicloudRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady) {
+		return nil, ctx
+	}
+
+	if state.KcpRedisInstance == nil {
+		return nil, ctx
+	}
+
+	instanceClass, readOnlyCount, err := RedisTierToInstanceClassConverter(alicloudRedisInstance.Spec.RedisTier)
+
+	if err != nil {
+		errMsg := "Failed to map redisTier to instance class"
+		logger.Error(err, errMsg, "redisTier", alicloudRedisInstance.Spec.RedisTier)
+		alicloudRedisInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(alicloudRedisInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AlicloudRedisInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR alicloudRedisInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	instanceClassChanged := state.KcpRedisInstance.Spec.Instance.Alicloud.InstanceClass != instanceClass
+	readOnlyCountChanged := state.KcpRedisInstance.Spec.Instance.Alicloud.ReadOnlyCount != readOnlyCount
+
+	if !instanceClassChanged && !readOnlyCountChanged {
+		return nil, ctx
+	}
+
+	state.KcpRedisInstance.Spec.Instance.Alicloud.InstanceClass = instanceClass
+	state.KcpRedisInstance.Spec.Instance.Alicloud.ReadOnlyCount = readOnlyCount
+	logger.Info("Detected modified Redis instance class")
+	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpRedisInstance)
+
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP RedisInstance", composed.StopWithRequeue, ctx)
+	}
+
+	alicloudRedisInstance.Status.State = cloudresourcesv1beta1.StateUpdating
+	return composed.UpdateStatus(alicloudRedisInstance).
+		SetCondition(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeProcessing,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionTypeProcessing,
+			Message: "Processing the resource modification",
+		}).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+		ErrorLogMessage("Error setting Updating state on AlicloudRedisInstance").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
@@ -0,0 +1,38 @@
+package alicloudredisinstance
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// alicloudRedisTierToMemoryGbMap is the memory of the instance class of each tier, in GB
+var alicloudRedisTierToMemoryGbMap = map[cloudresourcesv1beta1.AlicloudRedisTier]int{
+	cloudresourcesv1beta1.AlicloudRedisTierS1: 1,
+	cloudresourcesv1beta1.AlicloudRedisTierS2: 2,
+	cloudresourcesv1beta1.AlicloudRedisTierS3: 4,
+	cloudresourcesv1beta1.AlicloudRedisTierS4: 8,
+	cloudresourcesv1beta1.AlicloudRedisTierS5: 16,
+
+	cloudresourcesv1beta1.AlicloudRedisTierP1: 4,
+	cloudresourcesv1beta1.AlicloudRedisTierP2: 8,
+	cloudresourcesv1beta1.AlicloudRedisTierP3: 16,
+	cloudresourcesv1beta1.AlicloudRedisTierP4: 32,
+	cloudresourcesv1beta1.AlicloudRedisTierP5: 64,
+}
+
+// QuotaUsage returns the memory, as defined by the tier, the instance makes of the SKR quota.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.AlicloudRedisInstance)
+	if !ok {
+		return nil
+	}
+	memoryGb, ok := alicloudRedisTierToMemoryGbMap[redis.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: memoryGb,
+	}
+}
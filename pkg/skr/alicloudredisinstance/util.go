@@ -0,0 +1,122 @@
+package alicloudredisinstance
+
+import (
+	"errors"
+	"maps"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func getAuthSecretName(alicloudRedis *cloudresourcesv1beta1.AlicloudRedisInstance) string {
+	if alicloudRedis.Spec.AuthSecret != nil && len(alicloudRedis.Spec.AuthSecret.Name) > 0 {
+		return alicloudRedis.Spec.AuthSecret.Name
+	}
+
+	return alicloudRedis.Name
+}
+
+func getAuthSecretLabels(alicloudRedis *cloudresourcesv1beta1.AlicloudRedisInstance) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if alicloudRedis.Spec.AuthSecret != nil {
+		for labelName, labelValue := range alicloudRedis.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelRedisInstanceStatusId, alicloudRedis.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelRedisInstanceNamespace, alicloudRedis.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+	pvLabels := labelsBuilder.Build()
+
+	return pvLabels
+}
+
+func getAuthSecretAnnotations(alicloudRedis *cloudresourcesv1beta1.AlicloudRedisInstance) map[string]string {
+	if alicloudRedis.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, alicloudRedis.Spec.AuthSecret.Annotations)
+	return result
+}
+
+func getAuthSecretBaseData(kcpRedis *cloudcontrolv1beta1.RedisInstance) map[string][]byte {
+	result := map[string][]byte{}
+
+	if len(kcpRedis.Status.PrimaryEndpoint) > 0 {
+		result["primaryEndpoint"] = []byte(kcpRedis.Status.PrimaryEndpoint)
+
+		splitEndpoint := strings.Split(kcpRedis.Status.PrimaryEndpoint, ":")
+		if len(splitEndpoint) >= 2 {
+			host := splitEndpoint[0]
+			port := splitEndpoint[1]
+			result["host"] = []byte(host)
+			result["port"] = []byte(port)
+		}
+	}
+
+	if len(kcpRedis.Status.ReadEndpoint) > 0 {
+		result["readEndpoint"] = []byte(kcpRedis.Status.ReadEndpoint)
+
+		splitReadEndpoint := strings.Split(kcpRedis.Status.ReadEndpoint, ":")
+		if len(splitReadEndpoint) >= 2 {
+			readHost := splitReadEndpoint[0]
+			readPort := splitReadEndpoint[1]
+			result["readHost"] = []byte(readHost)
+			result["readPort"] = []byte(readPort)
+		}
+	}
+
+	if len(kcpRedis.Status.AuthString) > 0 {
+		result["authString"] = []byte(kcpRedis.Status.AuthString)
+	}
+
+	if len(kcpRedis.Status.CaCert) > 0 {
+		result["CaCert.pem"] = []byte(kcpRedis.Status.CaCert)
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
+
+type alicloudRedisTierValue struct {
+	InstanceClass string
+	ReadOnlyCount int32
+}
+
+var alicloudRedisTierToInstanceClassMap = map[cloudresourcesv1beta1.AlicloudRedisTier]alicloudRedisTierValue{
+	cloudresourcesv1beta1.AlicloudRedisTierS1: {"redis.master.small.default", 0},
+	cloudresourcesv1beta1.AlicloudRedisTierS2: {"redis.master.mid.default", 0},
+	cloudresourcesv1beta1.AlicloudRedisTierS3: {"redis.master.stand.default", 0},
+	cloudresourcesv1beta1.AlicloudRedisTierS4: {"redis.master.large.default", 0},
+	cloudresourcesv1beta1.AlicloudRedisTierS5: {"redis.master.2xlarge.default", 0},
+
+	cloudresourcesv1beta1.AlicloudRedisTierP1: {"redis.amber.master.stand.multithread", 1},
+	cloudresourcesv1beta1.AlicloudRedisTierP2: {"redis.amber.master.large.multithread", 1},
+	cloudresourcesv1beta1.AlicloudRedisTierP3: {"redis.amber.master.2xlarge.multithread", 1},
+	cloudresourcesv1beta1.AlicloudRedisTierP4: {"redis.amber.master.4xlarge.multithread", 1},
+	cloudresourcesv1beta1.AlicloudRedisTierP5: {"redis.amber.master.8xlarge.multithread", 1},
+}
+
+func RedisTierToInstanceClassConverter(redisTier cloudresourcesv1beta1.AlicloudRedisTier) (string, int32, error) {
+	value, exists := alicloudRedisTierToInstanceClassMap[redisTier]
+
+	if !exists {
+		return "", 0, errors.New("unknown alicloud redis tier")
+	}
+
+	return value.InstanceClass, value.ReadOnlyCount, nil
+}
@@ -0,0 +1,47 @@
+package alicloudredisinstance
+
+import (
+	"fmt"
+	"testing"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+type converterTestCase struct {
+	InputRedisTier        cloudresourcesv1beta1.AlicloudRedisTier
+	ExpectedInstanceClass string
+	ExpectedReadOnlyCount int32
+}
+
+func TestRedisTierToInstanceClassConverter(t *testing.T) {
+
+	t.Run("RedisTierToInstanceClassConverter", func(t *testing.T) {
+
+		testCases := []converterTestCase{
+			{cloudresourcesv1beta1.AlicloudRedisTierS1, "redis.master.small.default", 0},
+			{cloudresourcesv1beta1.AlicloudRedisTierS3, "redis.master.stand.default", 0},
+			{cloudresourcesv1beta1.AlicloudRedisTierS5, "redis.master.2xlarge.default", 0},
+			{cloudresourcesv1beta1.AlicloudRedisTierP1, "redis.amber.master.stand.multithread", 1},
+			{cloudresourcesv1beta1.AlicloudRedisTierP5, "redis.amber.master.8xlarge.multithread", 1},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("should return expected result for input (%s)", testCase.InputRedisTier), func(t *testing.T) {
+				instanceClass, readOnlyCount, err := RedisTierToInstanceClassConverter(testCase.InputRedisTier)
+
+				assert.Equal(t, testCase.ExpectedInstanceClass, instanceClass, "resulting instance class does not match expected")
+				assert.Equal(t, testCase.ExpectedReadOnlyCount, readOnlyCount, "resulting read only count does not match expected")
+				assert.Nil(t, err, "expected nil error, got an error")
+			})
+		}
+
+		t.Run("should return error for unknown input", func(t *testing.T) {
+			instanceClass, readOnlyCount, err := RedisTierToInstanceClassConverter("unknown")
+
+			assert.NotNil(t, err, "expected defined error, got nil")
+			assert.Equal(t, "", instanceClass, "expected instance class to have zero value")
+			assert.Equal(t, int32(0), readOnlyCount, "expected read only count to have zero value")
+		})
+	})
+}
@@ -7,6 +7,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -55,6 +56,7 @@ func createKcpNfsInstance(ctx context.Context, st composed.State) (error, contex
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpNfsInstance)
 	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpNfsInstance)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating KCP NfsInstance", composed.StopWithRequeue, ctx)
@@ -0,0 +1,19 @@
+package awsnfsvolume
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// QuotaUsage returns the capacity the volume makes of the SKR quota.
+func QuotaUsage(obj client.Object) map[string]int {
+	vol, ok := obj.(*cloudresourcesv1beta1.AwsNfsVolume)
+	if !ok {
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalCapacityGb: int(vol.Spec.Capacity.ScaledValue(resource.Giga)),
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsvolume"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -60,32 +62,33 @@ func (r *reconciler) newAction() composed.Action {
 		composed.LoadObj,
 		composed.ComposeActions(
 			"crAwsNfsVolumeValidateSpec",
-			validatePersistentVolume, validatePersistentVolumeClaim,
+			nfsvolume.ValidatePersistentVolume, nfsvolume.ValidatePersistentVolumeClaim,
 		),
 		defaultiprange.New(),
 
-		loadVolume,
-		sanitizeReleasedVolume,
-		loadPersistentVolumeClaim,
+		nfsvolume.LoadVolume,
+		nfsvolume.SanitizeReleasedVolume,
+		nfsvolume.LoadPersistentVolumeClaim,
 		addFinalizer,
 		updateId,
 		loadKcpNfsInstance,
+		quotacheck.New(QuotaUsage),
 		createKcpNfsInstance,
 		updateStatus,
-		createVolume,
-		createPersistentVolumeClaim,
+		nfsvolume.CreateVolume,
+		nfsvolume.CreatePersistentVolumeClaim,
 		requeueWaitKcpStatus,
 		stopIfNotBeingDeleted,
 
 		// this below executes only when marked for deletion
 
-		removePersistenceVolumeClaimFinalizer,
-		deletePVC,
-		waitPVCDeleted,
+		nfsvolume.RemovePersistentVolumeClaimFinalizer,
+		nfsvolume.DeletePVC,
+		nfsvolume.WaitPVCDeleted,
 
-		removePersistenceVolumeFinalizer,
-		deletePv,
-		waitPvDeleted,
+		nfsvolume.RemovePersistentVolumeFinalizer,
+		nfsvolume.DeletePv,
+		nfsvolume.WaitPvDeleted,
 
 		deleteKcpNfsInstance,
 		waitKcpNfsInstanceDeleted,
@@ -7,8 +7,10 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsvolume"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -69,3 +71,79 @@ func (s *State) SetSkrIpRange(skrIpRange *cloudresourcesv1beta1.IpRange) {
 func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
 	return s.ObjAsAwsNfsVolume()
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpNfsInstance != nil
+}
+
+// nfsvolume.State implementation ---------------------------------------------
+
+var _ nfsvolume.State = &State{}
+
+func (s *State) ObjAsObjWithConditionsAndState() composed.ObjWithConditionsAndState {
+	return s.ObjAsAwsNfsVolume()
+}
+
+func (s *State) GetKcpNfsInstance() *cloudcontrolv1beta1.NfsInstance {
+	return s.KcpNfsInstance
+}
+
+func (s *State) GetVolume() *corev1.PersistentVolume {
+	return s.Volume
+}
+
+func (s *State) SetVolume(volume *corev1.PersistentVolume) {
+	s.Volume = volume
+}
+
+func (s *State) GetPVC() *corev1.PersistentVolumeClaim {
+	return s.PVC
+}
+
+func (s *State) SetPVC(pvc *corev1.PersistentVolumeClaim) {
+	s.PVC = pvc
+}
+
+func (s *State) GetVolumeId() string {
+	return s.ObjAsAwsNfsVolume().Status.Id
+}
+
+func (s *State) GetVolumeName() string {
+	return getVolumeName(s.ObjAsAwsNfsVolume())
+}
+
+func (s *State) GetVolumeLabels() map[string]string {
+	return getVolumeLabels(s.ObjAsAwsNfsVolume())
+}
+
+func (s *State) GetVolumeAnnotations() map[string]string {
+	return getVolumeAnnotations(s.ObjAsAwsNfsVolume())
+}
+
+func (s *State) GetVolumeClaimName() string {
+	return getVolumeClaimName(s.ObjAsAwsNfsVolume())
+}
+
+func (s *State) GetVolumeClaimLabels() map[string]string {
+	return getVolumeClaimLabels(s.ObjAsAwsNfsVolume())
+}
+
+func (s *State) GetVolumeClaimAnnotations() map[string]string {
+	return getVolumeClaimAnnotations(s.ObjAsAwsNfsVolume())
+}
+
+func (s *State) GetCapacity() resource.Quantity {
+	return s.ObjAsAwsNfsVolume().Spec.Capacity
+}
+
+func (s *State) GetNfsServer() string {
+	return s.ObjAsAwsNfsVolume().Status.Server
+}
+
+func (s *State) GetNfsPath() string {
+	return "/"
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/backuphooks"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"github.com/kyma-project/cloud-manager/pkg/util"
@@ -43,11 +45,13 @@ func (f *reconcilerFactory) New(args skrruntime.ReconcilerArguments) reconcile.R
 			f.awsClientProvider,
 			f.env,
 		),
+		executor: backuphooks.NewExecutor(args.SkrCluster.GetConfig()),
 	}
 }
 
 type reconciler struct {
-	factory *stateFactory
+	factory  *stateFactory
+	executor backuphooks.Executor
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -72,6 +76,7 @@ func (r *reconciler) newAction() composed.Action {
 		"AwsNfsVolumeBackupMain",
 		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.AwsNfsVolumeBackup{}),
 		commonscope.New(),
+		backuphooks.NewResume(r.executor),
 		shortCircuitCompleted,
 		markFailed,
 		addFinalizer,
@@ -83,6 +88,8 @@ func (r *reconciler) newAction() composed.Action {
 		loadLocalVault,
 		loadAwsBackupJob,
 		loadLocalAwsBackup,
+		quotacheck.New(nil),
+		backuphooks.New(r.executor),
 		createAwsBackup,
 		composed.If(
 			RemoteBackupPredicate,
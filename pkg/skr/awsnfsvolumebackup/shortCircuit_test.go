@@ -9,7 +9,9 @@ import (
 	"github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/backuphooks"
 	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -135,6 +137,65 @@ func (s *shortCircuitSuite) TestWhenBackupIsFailed() {
 	s.Equal(v1beta1.StateFailed, fromK8s.Status.State)
 }
 
+type fakeHookExecutor struct {
+	commands [][]string
+}
+
+func (e *fakeHookExecutor) Exec(_ context.Context, _ *corev1.Pod, _ string, command []string) (string, error) {
+	e.commands = append(e.commands, command)
+	return "", nil
+}
+
+func (s *shortCircuitSuite) TestWhenBackupIsFailedWorkloadIsResumed() {
+
+	obj := awsNfsVolumeBackup.DeepCopy()
+	obj.Status.State = v1beta1.StateFailed
+	obj.Spec.Hooks = &v1beta1.BackupHooks{
+		PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+		Pre:         &v1beta1.BackupHook{Command: []string{"fsfreeze", "--freeze", "/data"}},
+		Post:        &v1beta1.BackupHook{Command: []string{"fsfreeze", "--unfreeze", "/data"}},
+	}
+	obj.Status.Hooks = &v1beta1.BackupHooksStatus{
+		Pre: &v1beta1.BackupHookStatus{State: v1beta1.BackupHookSucceeded},
+	}
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: obj.Namespace, Labels: map[string]string{"app": "db", v1beta1.LabelBackupHooks: "true"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "postgres"}}},
+	}
+	s.Nil(factory.skrCluster.K8sClient().Create(ctx, pod))
+	pod.Status.Phase = corev1.PodRunning
+	s.Nil(factory.skrCluster.K8sClient().Status().Update(ctx, pod))
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+
+	executor := &fakeHookExecutor{}
+	err, _ = composed.ComposeActions("test",
+		backuphooks.NewResume(executor),
+		shortCircuitCompleted,
+	)(ctx, state)
+
+	//validate the workload is resumed before the flow is short-circuited
+	s.Equal(composed.StopAndForget, err)
+	s.Equal([][]string{{"fsfreeze", "--unfreeze", "/data"}}, executor.commands)
+
+	fromK8s := &v1beta1.AwsNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(ctx,
+		types.NamespacedName{Name: obj.Name,
+			Namespace: obj.Namespace},
+		fromK8s)
+	s.Nil(err)
+
+	s.Equal(v1beta1.StateFailed, fromK8s.Status.State)
+	s.NotNil(fromK8s.Status.Hooks.Post)
+}
+
 func (s *shortCircuitSuite) TestWhenBackupIsCreating() {
 
 	obj := awsNfsVolumeBackup.DeepCopy()
@@ -17,6 +17,7 @@ import (
 	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
 	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
 	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
+	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -156,3 +157,16 @@ func StopAndRequeueForCapacityAction() composed.Action {
 		return stopAndRequeueForCapacity(), nil
 	}
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef()
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.backupJob != nil || s.recoveryPoint != nil
+}
+
+func (s *State) IsBackupTriggered() bool {
+	backup := s.ObjAsAwsNfsVolumeBackup()
+	return backup.Status.Id != "" || backup.Status.JobId != ""
+}
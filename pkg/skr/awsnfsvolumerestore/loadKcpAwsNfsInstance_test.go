@@ -0,0 +1,99 @@
+package awsnfsvolumerestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type loadKcpAwsNfsInstanceSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *loadKcpAwsNfsInstanceSuite) SetupTest() {
+	s.ctx = log.IntoContext(context.Background(), logr.Discard())
+}
+
+func (s *loadKcpAwsNfsInstanceSuite) TestLoadKcpAwsNfsInstanceWithoutDestination() {
+
+	obj := awsNfsVolumeRestore.DeepCopy()
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.skrAwsNfsVolume = awsNfsVolume.DeepCopy()
+
+	//Call loadKcpAwsNfsInstance
+	err, _ctx := loadKcpAwsNfsInstance(ctx, state)
+	s.Nil(err)
+	s.Nil(_ctx)
+	s.Nil(state.kcpAwsNfsInstance)
+}
+
+func (s *loadKcpAwsNfsInstanceSuite) TestLoadKcpAwsNfsInstanceWhenExists() {
+
+	obj := awsNfsVolumeRestore.DeepCopy()
+	obj.Spec.Destination = &cloudresourcesv1beta1.AwsNfsVolumeRestoreDestination{
+		Volume: cloudresourcesv1beta1.VolumeRef{Name: awsNfsVolume.Name},
+	}
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.skrAwsNfsVolume = awsNfsVolume.DeepCopy()
+
+	//create the NfsInstance in KCP
+	err = factory.kcpCluster.K8sClient().Create(ctx, &cloudcontrolv1beta1.NfsInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      awsNfsVolume.Status.Id,
+			Namespace: scope.Namespace,
+		},
+	})
+	s.Nil(err)
+
+	//Call loadKcpAwsNfsInstance
+	err, _ctx := loadKcpAwsNfsInstance(ctx, state)
+	s.Nil(err)
+	s.Nil(_ctx)
+	s.NotNil(state.kcpAwsNfsInstance)
+}
+
+func (s *loadKcpAwsNfsInstanceSuite) TestLoadKcpAwsNfsInstanceWhenNotExists() {
+
+	obj := awsNfsVolumeRestore.DeepCopy()
+	obj.Spec.Destination = &cloudresourcesv1beta1.AwsNfsVolumeRestoreDestination{
+		Volume: cloudresourcesv1beta1.VolumeRef{Name: awsNfsVolume.Name},
+	}
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.skrAwsNfsVolume = awsNfsVolume.DeepCopy()
+
+	//Call loadKcpAwsNfsInstance
+	err, _ctx := loadKcpAwsNfsInstance(ctx, state)
+	s.Equal(composed.StopAndForget, err)
+	s.Equal(ctx, _ctx)
+	s.Equal(cloudresourcesv1beta1.JobStateError, state.ObjAsAwsNfsVolumeRestore().Status.State)
+}
+
+func TestLoadKcpAwsNfsInstance(t *testing.T) {
+	suite.Run(t, new(loadKcpAwsNfsInstanceSuite))
+}
@@ -18,6 +18,9 @@ func loadSkrAwsNfsVolume(ctx context.Context, st composed.State) (error, context
 		return nil, nil
 	}
 	name := state.skrAwsNfsVolumeBackup.Spec.Source.Volume.ToNamespacedName(state.Obj().GetNamespace())
+	if restore.Spec.Destination != nil {
+		name = restore.Spec.Destination.Volume.ToNamespacedName(state.Obj().GetNamespace())
+	}
 
 	skrAwsNfsVolume := &cloudresourcesv1beta1.AwsNfsVolume{}
 	err := state.Cluster().K8sClient().Get(
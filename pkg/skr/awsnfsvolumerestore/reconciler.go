@@ -76,6 +76,7 @@ func (r *reconciler) newAction() composed.Action {
 				stopIfBackupNotReady,
 				loadSkrAwsNfsVolume,
 				stopIfVolumeNotReady,
+				loadKcpAwsNfsInstance,
 				setIdempotencyToken,
 				createAwsClient,
 				startAwsRestore,
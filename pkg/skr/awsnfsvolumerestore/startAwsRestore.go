@@ -52,6 +52,9 @@ func startAwsRestore(ctx context.Context, st composed.State) (error, context.Con
 			Run(ctx, state)
 	}
 	restoreMetadataOut.RestoreMetadata["newFileSystem"] = "false"
+	if restore.Spec.Destination != nil {
+		restoreMetadataOut.RestoreMetadata["file-system-id"] = state.kcpAwsNfsInstance.Status.Id
+	}
 
 	//Create a Restore Job
 	restoreJobOutput, err := state.awsClient.StartRestoreJob(ctx, &client.StartRestoreJobInput{
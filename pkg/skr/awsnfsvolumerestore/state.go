@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
@@ -23,6 +24,7 @@ type State struct {
 	awsClient             restoreclient.Client
 	skrAwsNfsVolume       *cloudresourcesv1beta1.AwsNfsVolume
 	skrAwsNfsVolumeBackup *cloudresourcesv1beta1.AwsNfsVolumeBackup
+	kcpAwsNfsInstance     *cloudcontrolv1beta1.NfsInstance
 }
 
 func newStateFactory(
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -81,6 +82,7 @@ func createKcpRedisCluster(ctx context.Context, st composed.State) (error, conte
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpRedisCluster)
 	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpRedisCluster)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating KCP RedisCluster", composed.StopWithRequeue, ctx)
@@ -0,0 +1,38 @@
+package awsrediscluster
+
+import (
+	"math"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// awsRedisClusterTierToMemoryGbMap is the memory of the cache node type of each tier, in GiB
+var awsRedisClusterTierToMemoryGbMap = map[cloudresourcesv1beta1.AwsRedisClusterTier]float64{
+	cloudresourcesv1beta1.AwsRedisTierC1: 1.37,
+	cloudresourcesv1beta1.AwsRedisTierC2: 3.09,
+	cloudresourcesv1beta1.AwsRedisTierC3: 6.38,
+	cloudresourcesv1beta1.AwsRedisTierC4: 12.93,
+	cloudresourcesv1beta1.AwsRedisTierC5: 26.04,
+	cloudresourcesv1beta1.AwsRedisTierC6: 52.26,
+	cloudresourcesv1beta1.AwsRedisTierC7: 103.68,
+	cloudresourcesv1beta1.AwsRedisTierC8: 209.55,
+}
+
+// QuotaUsage returns the memory, as defined by the tier of the shard times the shard count and
+// rounded up, the cluster makes of the SKR quota. Replicas hold copies of the same data and are not counted.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.AwsRedisCluster)
+	if !ok {
+		return nil
+	}
+	memoryGb, ok := awsRedisClusterTierToMemoryGbMap[redis.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: int(math.Ceil(memoryGb * float64(max(1, redis.Spec.ShardCount)))),
+	}
+}
@@ -0,0 +1,36 @@
+package awsrediscluster
+
+import (
+	"testing"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaUsage(t *testing.T) {
+
+	t.Run("memory of all shards is rounded up", func(t *testing.T) {
+		redis := &cloudresourcesv1beta1.AwsRedisCluster{
+			Spec: cloudresourcesv1beta1.AwsRedisClusterSpec{
+				RedisTier:  cloudresourcesv1beta1.AwsRedisTierC1,
+				ShardCount: 3,
+			},
+		}
+		assert.Equal(t, map[string]int{quota.QuotaTotalMemoryGb: 5}, QuotaUsage(redis))
+	})
+
+	t.Run("unknown tier makes no usage", func(t *testing.T) {
+		redis := &cloudresourcesv1beta1.AwsRedisCluster{
+			Spec: cloudresourcesv1beta1.AwsRedisClusterSpec{
+				RedisTier:  "X1",
+				ShardCount: 3,
+			},
+		}
+		assert.Nil(t, QuotaUsage(redis))
+	})
+
+	t.Run("other kinds make no usage", func(t *testing.T) {
+		assert.Nil(t, QuotaUsage(&cloudresourcesv1beta1.AwsRedisInstance{}))
+	})
+}
@@ -112,3 +112,11 @@ func (s *State) ShouldModifyKcp() bool {
 		isShardCountDifferent ||
 		isReplicaCountDifferent
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpRedisCluster != nil
+}
@@ -0,0 +1,44 @@
+package awsredisinstance
+
+import (
+	"math"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// awsRedisTierToMemoryGbMap is the memory of the cache node type of each tier, in GiB
+var awsRedisTierToMemoryGbMap = map[cloudresourcesv1beta1.AwsRedisTier]float64{
+	cloudresourcesv1beta1.AwsRedisTierS1: 1.37,
+	cloudresourcesv1beta1.AwsRedisTierS2: 3.09,
+	cloudresourcesv1beta1.AwsRedisTierS3: 6.38,
+	cloudresourcesv1beta1.AwsRedisTierS4: 12.93,
+	cloudresourcesv1beta1.AwsRedisTierS5: 26.04,
+	cloudresourcesv1beta1.AwsRedisTierS6: 52.26,
+	cloudresourcesv1beta1.AwsRedisTierS7: 103.68,
+	cloudresourcesv1beta1.AwsRedisTierS8: 209.55,
+
+	cloudresourcesv1beta1.AwsRedisTierP1: 6.38,
+	cloudresourcesv1beta1.AwsRedisTierP2: 12.93,
+	cloudresourcesv1beta1.AwsRedisTierP3: 26.04,
+	cloudresourcesv1beta1.AwsRedisTierP4: 52.26,
+	cloudresourcesv1beta1.AwsRedisTierP5: 103.68,
+	cloudresourcesv1beta1.AwsRedisTierP6: 209.55,
+}
+
+// QuotaUsage returns the memory, as defined by the tier and rounded up, the instance makes of the SKR quota.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.AwsRedisInstance)
+	if !ok {
+		return nil
+	}
+	memoryGb, ok := awsRedisTierToMemoryGbMap[redis.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: int(math.Ceil(memoryGb)),
+	}
+}
@@ -108,3 +108,11 @@ func (s *State) ShouldModifyKcp() bool {
 		arePreferredMaintenanceWindowDifferent ||
 		isEngineVersionDifferent
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpRedisInstance != nil
+}
@@ -6,6 +6,7 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -54,6 +55,7 @@ func createKcpRemoteNetwork(ctx context.Context, st composed.State) (error, cont
 
 	logger.Info("Remote network reference created")
 
+	tracing.InjectIntoObj(ctx, remoteNetwork)
 	err := state.KcpCluster.K8sClient().Create(ctx, remoteNetwork)
 
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -61,6 +62,7 @@ func createKcpVpcPeering(ctx context.Context, st composed.State) (error, context
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpVpcPeering)
 	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpVpcPeering)
 
 	if err != nil {
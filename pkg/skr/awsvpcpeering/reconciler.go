@@ -6,6 +6,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -54,6 +55,7 @@ func (r *reconciler) newAction() composed.Action {
 		addFinalizer,
 		updateId,
 		loadKcpRemoteNetwork,
+		quotacheck.New(nil),
 		createKcpRemoteNetwork,
 		waitNetworkReady,
 		loadKcpAwsVpcPeering,
@@ -53,3 +53,11 @@ func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State,
 func (s *State) ObjAsAwsVpcPeering() *cloudresourcesv1beta1.AwsVpcPeering {
 	return s.Obj().(*cloudresourcesv1beta1.AwsVpcPeering)
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.RemoteNetwork != nil || s.KcpVpcPeering != nil
+}
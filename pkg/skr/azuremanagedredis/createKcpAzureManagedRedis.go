@@ -10,6 +10,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 )
 
 // createKcpAzureManagedRedis materialises the SKR AzureManagedRedis as a
@@ -76,6 +77,7 @@ func createKcpAzureManagedRedis(ctx context.Context, st composed.State) (error,
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpAzureManagedRedis)
 	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpAzureManagedRedis)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating KCP AzureManagedRedis", composed.StopWithRequeue, ctx)
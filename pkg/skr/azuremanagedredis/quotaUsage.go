@@ -0,0 +1,44 @@
+package azuremanagedredis
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// tierToMemoryGb is the memory of the AMR SKU of each tier, in GB
+var tierToMemoryGb = map[cloudresourcesv1beta1.AzureManagedRedisTier]int{
+	cloudresourcesv1beta1.AzureManagedRedisTierS1: 1,
+	cloudresourcesv1beta1.AzureManagedRedisTierS2: 3,
+	cloudresourcesv1beta1.AzureManagedRedisTierS3: 6,
+	cloudresourcesv1beta1.AzureManagedRedisTierS4: 12,
+	cloudresourcesv1beta1.AzureManagedRedisTierS5: 24,
+
+	cloudresourcesv1beta1.AzureManagedRedisTierP1: 6,
+	cloudresourcesv1beta1.AzureManagedRedisTierP2: 12,
+	cloudresourcesv1beta1.AzureManagedRedisTierP3: 24,
+	cloudresourcesv1beta1.AzureManagedRedisTierP4: 60,
+	cloudresourcesv1beta1.AzureManagedRedisTierP5: 120,
+
+	cloudresourcesv1beta1.AzureManagedRedisTierC3: 6,
+	cloudresourcesv1beta1.AzureManagedRedisTierC4: 12,
+	cloudresourcesv1beta1.AzureManagedRedisTierC5: 24,
+	cloudresourcesv1beta1.AzureManagedRedisTierC6: 60,
+	cloudresourcesv1beta1.AzureManagedRedisTierC7: 120,
+}
+
+// QuotaUsage returns the memory, as defined by the tier, the AMR makes of the SKR quota.
+func QuotaUsage(obj client.Object) map[string]int {
+	amr, ok := obj.(*cloudresourcesv1beta1.AzureManagedRedis)
+	if !ok {
+		return nil
+	}
+	memoryGb, ok := tierToMemoryGb[amr.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: memoryGb,
+	}
+}
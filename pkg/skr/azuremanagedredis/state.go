@@ -81,3 +81,11 @@ func (s *State) GetAuthSecretData() map[string][]byte {
 
 	return util.MergeMaps(authSecretBaseData, parsedAuthSecretExtraData, false)
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpAzureManagedRedis != nil
+}
@@ -0,0 +1,81 @@
+package azurenfsvolume
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpNfsInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if composed.MarkedForDeletionPredicate(ctx, st) {
+		// SKR IpRange is marked for deletion, do not create mirror in KCP
+		return nil, nil
+	}
+
+	if state.KcpNfsInstance != nil {
+		// mirror IpRange in KCP is already created
+		return nil, nil
+	}
+
+	state.KcpNfsInstance = &cloudcontrolv1beta1.NfsInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      state.ObjAsAzureNfsVolume().Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      state.ObjAsAzureNfsVolume().Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: state.ObjAsAzureNfsVolume().Namespace,
+				common.LabelKymaModule:                   common.FieldOwner,
+			},
+		},
+		Spec: cloudcontrolv1beta1.NfsInstanceSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: state.ObjAsAzureNfsVolume().Namespace,
+				Name:      state.ObjAsAzureNfsVolume().Name,
+			},
+			IpRange: cloudcontrolv1beta1.IpRangeRef{
+				Name: state.SkrIpRange.Status.Id,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			Instance: cloudcontrolv1beta1.NfsInstanceInfo{
+				Azure: &cloudcontrolv1beta1.NfsInstanceAzure{
+					CapacityGb: state.ObjAsAzureNfsVolume().Spec.CapacityGb,
+					Sku:        azureNfsSku(state.ObjAsAzureNfsVolume().Spec.Tier),
+				},
+			},
+		},
+	}
+
+	tracing.InjectIntoObj(ctx, state.KcpNfsInstance)
+	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpNfsInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP NfsInstance", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP NfsInstance")
+
+	//Set the state to creating
+	state.ObjAsAzureNfsVolume().Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(state.ObjAsAzureNfsVolume()).
+		ErrorLogMessage("Error setting Creating state on AzureNfsVolume").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
+
+func azureNfsSku(tier cloudresourcesv1beta1.AzureNfsTier) cloudcontrolv1beta1.AzureNfsSku {
+	if tier == cloudresourcesv1beta1.AzureNfsTierPremiumZRS {
+		return cloudcontrolv1beta1.AzureNfsSkuPremiumZRS
+	}
+	return cloudcontrolv1beta1.AzureNfsSkuPremiumLRS
+}
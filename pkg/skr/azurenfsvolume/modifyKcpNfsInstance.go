@@ -0,0 +1,42 @@
+package azurenfsvolume
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyKcpNfsInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, nil
+	}
+
+	if state.KcpNfsInstance == nil || state.KcpNfsInstance.Spec.Instance.Azure == nil {
+		return nil, nil
+	}
+
+	if state.KcpNfsInstance.Spec.Instance.Azure.CapacityGb == state.ObjAsAzureNfsVolume().Spec.CapacityGb {
+		return nil, nil
+	}
+
+	// As of now, only CapacityGb is mutable
+	modified := state.KcpNfsInstance.DeepCopy()
+	modified.Spec.Instance.Azure.CapacityGb = state.ObjAsAzureNfsVolume().Spec.CapacityGb
+	err := state.KcpCluster.K8sClient().Update(ctx, modified)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP NfsInstance capacity", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Updated KCP NfsInstance capacity")
+
+	state.ObjAsAzureNfsVolume().Status.State = cloudresourcesv1beta1.StateProcessing
+	return composed.UpdateStatus(state.ObjAsAzureNfsVolume()).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+		ErrorLogMessage("Error setting Processing state on AzureNfsVolume").
+		SuccessError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
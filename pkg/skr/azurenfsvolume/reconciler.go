@@ -0,0 +1,103 @@
+package azurenfsvolume
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsvolume"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
+	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func NewReconcilerFactory() skrruntime.ReconcilerFactory {
+	return &reconcilerFactory{}
+}
+
+type reconcilerFactory struct {
+}
+
+func (f *reconcilerFactory) New(args skrruntime.ReconcilerArguments) reconcile.Reconciler {
+	return &reconciler{
+		factory: newStateFactory(
+			composed.NewStateFactory(composed.NewStateClusterFromCluster(args.SkrCluster)),
+			args.ScopeProvider,
+			composed.NewStateClusterFromCluster(args.KcpCluster),
+		),
+	}
+}
+
+type reconciler struct {
+	factory *stateFactory
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	if Ignore.ShouldIgnoreKey(request) {
+		return ctrl.Result{}, nil
+	}
+
+	state, err := r.factory.NewState(ctx, request)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error creating AzureNfsVolume state: %w", err)
+	}
+	action := r.newAction()
+
+	return composed.Handling().
+		WithMetrics("azurenfsvolume", util.RequestObjToString(request)).
+		WithNoLog().
+		Handle(action(ctx, state))
+}
+
+func (r *reconciler) newAction() composed.Action {
+	return composed.ComposeActions(
+		"crAzureNfsVolumeMain",
+		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.AzureNfsVolume{}),
+		composed.LoadObj,
+		composed.ComposeActions(
+			"crAzureNfsVolumeValidateSpec",
+			nfsvolume.ValidatePersistentVolume, nfsvolume.ValidatePersistentVolumeClaim,
+		),
+		defaultiprange.New(),
+
+		nfsvolume.LoadVolume,
+		nfsvolume.SanitizeReleasedVolume,
+		nfsvolume.LoadPersistentVolumeClaim,
+		addFinalizer,
+		updateId,
+		loadKcpNfsInstance,
+		quotacheck.New(QuotaUsage),
+		createKcpNfsInstance,
+		modifyKcpNfsInstance,
+		updateStatus,
+		nfsvolume.CreateVolume,
+		nfsvolume.CreatePersistentVolumeClaim,
+		nfsvolume.ModifyPersistentVolume,
+		nfsvolume.ModifyPersistentVolumeClaim,
+		requeueWaitKcpStatus,
+		stopIfNotBeingDeleted,
+
+		// this below executes only when marked for deletion
+
+		nfsvolume.RemovePersistentVolumeClaimFinalizer,
+		nfsvolume.DeletePVC,
+		nfsvolume.WaitPVCDeleted,
+
+		nfsvolume.RemovePersistentVolumeFinalizer,
+		nfsvolume.DeletePv,
+		nfsvolume.WaitPvDeleted,
+
+		deleteKcpNfsInstance,
+		waitKcpNfsInstanceDeleted,
+
+		removeFinalizer,
+
+		composed.StopAndForgetAction,
+	)
+}
@@ -0,0 +1,72 @@
+package azurenfsvolume
+
+import (
+	"context"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpNfsInstance == nil {
+		// it's deleted
+		return nil, nil
+	}
+
+	kcpCondErr := meta.FindStatusCondition(state.KcpNfsInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeError)
+	kcpCondReady := meta.FindStatusCondition(state.KcpNfsInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
+
+	skrCondErr := meta.FindStatusCondition(state.ObjAsAzureNfsVolume().Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+	skrCondReady := meta.FindStatusCondition(state.ObjAsAzureNfsVolume().Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady)
+
+	capacityChanged := !state.ObjAsAzureNfsVolume().Status.Capacity.Equal(state.KcpNfsInstance.Status.Capacity)
+	state.ObjAsAzureNfsVolume().Status.Capacity = state.KcpNfsInstance.Status.Capacity
+
+	if kcpCondErr != nil && skrCondErr == nil {
+		state.ObjAsAzureNfsVolume().Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(state.ObjAsAzureNfsVolume()).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: kcpCondErr.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error updating KCP AzureNfsVolume status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR AzureNfsVolume status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	if kcpCondReady != nil && skrCondReady == nil {
+		logger.Info("Updating SKR AzureNfsVolume status with Ready condition")
+		state.ObjAsAzureNfsVolume().Status.Server = state.KcpNfsInstance.Status.Host
+		state.ObjAsAzureNfsVolume().Status.Path = state.KcpNfsInstance.Status.Path
+		state.ObjAsAzureNfsVolume().Status.State = cloudresourcesv1beta1.StateReady
+		return composed.UpdateStatus(state.ObjAsAzureNfsVolume()).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeReady,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeReady,
+				Message: kcpCondReady.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			ErrorLogMessage("Error updating KCP AzureNfsVolume status with ready condition").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
+	if capacityChanged {
+		return composed.UpdateStatus(state.ObjAsAzureNfsVolume()).
+			SuccessErrorNil().
+			ErrorLogMessage("Error updating SKR AzureNfsVolume status with Capacity change").
+			SuccessLogMsg("Updated SKR AzureNfsVolume status with Capacity change").
+			Run(ctx, state)
+	}
+	return nil, nil
+}
@@ -0,0 +1,86 @@
+package azurenfsvolume
+
+import (
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func getVolumeName(azureVol *cloudresourcesv1beta1.AzureNfsVolume) string {
+	if azureVol.Spec.PersistentVolume != nil &&
+		len(azureVol.Spec.PersistentVolume.Name) > 0 {
+		return azureVol.Spec.PersistentVolume.Name
+	}
+
+	return azureVol.Status.Id
+}
+
+func getVolumeLabels(azureVol *cloudresourcesv1beta1.AzureNfsVolume) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if azureVol.Spec.PersistentVolume != nil {
+		for labelName, labelValue := range azureVol.Spec.PersistentVolume.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolName, azureVol.Name)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolNS, azureVol.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+	pvLabels := labelsBuilder.Build()
+
+	return pvLabels
+}
+
+func getVolumeAnnotations(azureVol *cloudresourcesv1beta1.AzureNfsVolume) map[string]string {
+	if azureVol.Spec.PersistentVolume == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, azureVol.Spec.PersistentVolume.Annotations)
+	return result
+}
+
+func getVolumeClaimName(azureVol *cloudresourcesv1beta1.AzureNfsVolume) string {
+	if azureVol.Spec.PersistentVolumeClaim != nil &&
+		len(azureVol.Spec.PersistentVolumeClaim.Name) > 0 {
+		return azureVol.Spec.PersistentVolumeClaim.Name
+	}
+
+	return azureVol.Name
+}
+
+func getVolumeClaimLabels(azureVol *cloudresourcesv1beta1.AzureNfsVolume) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if azureVol.Spec.PersistentVolumeClaim != nil {
+		for labelName, labelValue := range azureVol.Spec.PersistentVolumeClaim.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolName, azureVol.Name)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelNfsVolNS, azureVol.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelStorageCapacity, azureNfsVolumeCapacityToResourceQuantity(azureVol).String())
+
+	pvcLabels := labelsBuilder.Build()
+	return pvcLabels
+}
+
+func getVolumeClaimAnnotations(azureVol *cloudresourcesv1beta1.AzureNfsVolume) map[string]string {
+	if azureVol.Spec.PersistentVolumeClaim == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, azureVol.Spec.PersistentVolumeClaim.Annotations)
+	return result
+}
+
+func azureNfsVolumeCapacityToResourceQuantity(azureVol *cloudresourcesv1beta1.AzureNfsVolume) *resource.Quantity {
+	return resource.NewQuantity(int64(azureVol.Spec.CapacityGb)*1024*1024*1024, resource.BinarySI)
+}
@@ -7,6 +7,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -83,6 +84,7 @@ func createKcpRedisCluster(ctx context.Context, st composed.State) (error, conte
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpRedisCluster)
 	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpRedisCluster)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating KCP RedisCluster", composed.StopWithRequeue, ctx)
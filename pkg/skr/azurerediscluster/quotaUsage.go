@@ -0,0 +1,36 @@
+package azurerediscluster
+
+import (
+	"math"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// azureRedisClusterTierToMemoryGbMap is the cache size of a shard of the Azure premium SKU of each tier, in GiB
+var azureRedisClusterTierToMemoryGbMap = map[cloudresourcesv1beta1.AzureRedisClusterTier]float64{
+	cloudresourcesv1beta1.AzureRedisTierC3: 6,
+	cloudresourcesv1beta1.AzureRedisTierC4: 13,
+	cloudresourcesv1beta1.AzureRedisTierC5: 26,
+	cloudresourcesv1beta1.AzureRedisTierC6: 53,
+	cloudresourcesv1beta1.AzureRedisTierC7: 120,
+}
+
+// QuotaUsage returns the memory, as defined by the tier of the shard times the shard count, the cluster
+// makes of the SKR quota. Replicas hold copies of the same data and are not counted.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.AzureRedisCluster)
+	if !ok {
+		return nil
+	}
+	memoryGb, ok := azureRedisClusterTierToMemoryGbMap[redis.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	// shard count is optional, and without it Azure creates a single shard
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: int(math.Ceil(memoryGb * float64(max(1, redis.Spec.ShardCount)))),
+	}
+}
@@ -0,0 +1,40 @@
+package azureredisinstance
+
+import (
+	"math"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// azureRedisTierToMemoryGbMap is the cache size of the Azure SKU of each tier, in GiB
+var azureRedisTierToMemoryGbMap = map[cloudresourcesv1beta1.AzureRedisTier]float64{
+	cloudresourcesv1beta1.AzureRedisTierS1: 1,
+	cloudresourcesv1beta1.AzureRedisTierS2: 2.5,
+	cloudresourcesv1beta1.AzureRedisTierS3: 6,
+	cloudresourcesv1beta1.AzureRedisTierS4: 13,
+	cloudresourcesv1beta1.AzureRedisTierS5: 26,
+
+	cloudresourcesv1beta1.AzureRedisTierP1: 6,
+	cloudresourcesv1beta1.AzureRedisTierP2: 13,
+	cloudresourcesv1beta1.AzureRedisTierP3: 26,
+	cloudresourcesv1beta1.AzureRedisTierP4: 53,
+	cloudresourcesv1beta1.AzureRedisTierP5: 120,
+}
+
+// QuotaUsage returns the memory, as defined by the tier and rounded up, the instance makes of the SKR quota.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.AzureRedisInstance)
+	if !ok {
+		return nil
+	}
+	memoryGb, ok := azureRedisTierToMemoryGbMap[redis.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: int(math.Ceil(memoryGb)),
+	}
+}
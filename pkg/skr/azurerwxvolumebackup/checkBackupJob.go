@@ -0,0 +1,88 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func checkBackupJob(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRwxVolumeBackup()
+	logger := composed.LoggerFromCtx(ctx)
+	if backup.Status.OpIdentifier == "" {
+		return composed.LogErrorAndReturn(nil, "Should not reach checkBackupJob action if opIdentifier is missing.", composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx)
+	}
+	logger.Info("Checking backup job status", "opIdentifier", backup.Status.OpIdentifier)
+
+	job, err := state.client.GetStorageJob(ctx, state.vaultName, state.resourceGroupName, backup.Status.OpIdentifier)
+
+	if err != nil && !meta.IsNotFound(err) {
+		return composed.LogErrorAndReturn(err, "Error getting backup job", composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx)
+	}
+	if err != nil || job == nil {
+		logger.Error(nil, "Backup job not found. Remove OpIdentifier and retry ")
+		backup.Status.OpIdentifier = ""
+		return composed.PatchStatus(backup).
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+	if job.Status == nil {
+		return composed.LogErrorAndReturn(nil, "Backup job status is nil. Retry later", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+
+	switch *job.Status {
+	case string(armrecoveryservicesbackup.JobStatusInProgress),
+		string(armrecoveryservicesbackup.JobStatusCancelling):
+		logger.Info("Backup job in progress", "status", *job.Status)
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+	case string(armrecoveryservicesbackup.JobStatusCompleted):
+		logger.Info("Backup job completed")
+		state.backupJob = job
+		return nil, ctx
+	case string(armrecoveryservicesbackup.JobStatusCompletedWithWarnings):
+		message, err := client.AzureStorageErrorInfoToJson(job.ErrorDetails)
+		if err != nil {
+			logger.Error(err, "Error in marshalling backup job error details to json")
+			message = "Could not get warning details"
+		}
+		// the recovery point is created, some files might be missing from it
+		logger.Info("Backup job completed with warnings", "message", message)
+		state.backupJob = job
+		return nil, ctx
+	case string(armrecoveryservicesbackup.JobStatusCancelled):
+		return backupJobFailed(ctx, state, job, cloudresourcesv1beta1.ConditionReasonBackupJobCancelled, "Backup operation got cancelled")
+	default:
+		return backupJobFailed(ctx, state, job, cloudresourcesv1beta1.ConditionReasonBackupJobFailed, fmt.Sprintf("Backup operation failed with status %s", *job.Status))
+	}
+}
+
+func backupJobFailed(ctx context.Context, state *State, job *armrecoveryservicesbackup.AzureStorageJob, reason, msg string) (error, context.Context) {
+	backup := state.ObjAsAzureRwxVolumeBackup()
+	logger := composed.LoggerFromCtx(ctx)
+
+	message, err := client.AzureStorageErrorInfoToJson(job.ErrorDetails)
+	if err != nil {
+		logger.Error(err, "Error in marshalling backup job error details to json")
+		message = "Could not get error details"
+	}
+	logger.Error(nil, msg, "message", message)
+
+	backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupFailed
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  reason,
+			Message: fmt.Sprintf("%s: %v", msg, message),
+		}).
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
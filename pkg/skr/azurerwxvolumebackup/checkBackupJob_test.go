@@ -0,0 +1,126 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckBackupJob(t *testing.T) {
+
+	setupTriggeredState := func(ctx context.Context, jobId string, startTime time.Time) (*cloudresourcesv1beta1.AzureRwxVolumeBackup, *State) {
+		backup := setupDefaultBackup()
+		backup.Status.Id = "asdf"
+		backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupCreating
+		backup.Status.StartTime = &metav1.Time{Time: startTime}
+		state := setupDefaultState(ctx, backup)
+		state.protectedResourceName = jobId
+		return backup, state
+	}
+
+	t.Run("findBackupJob", func(t *testing.T) {
+
+		t.Run("backup job not found yet", func(t *testing.T) {
+			ctx := context.Background()
+			backup, state := setupTriggeredState(ctx, "job-1", time.Now())
+
+			err, _ := findBackupJob(ctx, state)
+
+			assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T10000ms()), err)
+			assert.Empty(t, backup.Status.OpIdentifier)
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupCreating, backup.Status.State)
+		})
+
+		t.Run("backup job not found after timeout", func(t *testing.T) {
+			ctx := context.Background()
+			backup, state := setupTriggeredState(ctx, "job-1", time.Now().Add(-backupJobLookupTimeout-time.Minute))
+
+			err, _ := findBackupJob(ctx, state)
+
+			assert.Equal(t, composed.StopAndForget, err)
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupFailed, backup.Status.State)
+			cond := meta.FindStatusCondition(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+			assert.NotNil(t, cond)
+			assert.Equal(t, cloudresourcesv1beta1.ConditionReasonBackupJobNotFound, cond.Reason)
+		})
+
+		t.Run("backup job found", func(t *testing.T) {
+			ctx := context.Background()
+			backup, state := setupTriggeredState(ctx, "job-1", time.Now().Add(-time.Minute))
+			assert.Nil(t, state.client.TriggerBackup(ctx, "", "", "", state.protectedResourceName, ""))
+
+			err, _ := findBackupJob(ctx, state)
+
+			assert.Nil(t, err)
+			assert.Equal(t, "job-1", backup.Status.OpIdentifier)
+		})
+
+	})
+
+	t.Run("checkBackupJob", func(t *testing.T) {
+
+		t.Run("backup job not found", func(t *testing.T) {
+			ctx := context.Background()
+			backup, state := setupTriggeredState(ctx, "job-1", time.Now().Add(-time.Minute))
+			backup.Status.OpIdentifier = "unknown-job"
+
+			err, _ := checkBackupJob(ctx, state)
+
+			assert.Equal(t, composed.StopWithRequeue, err)
+			assert.Empty(t, backup.Status.OpIdentifier)
+		})
+
+		t.Run("backup job completed", func(t *testing.T) {
+			ctx := context.Background()
+			backup, state := setupTriggeredState(ctx, "job-1", time.Now().Add(-time.Minute))
+			assert.Nil(t, state.client.TriggerBackup(ctx, "", "", "", state.protectedResourceName, ""))
+			backup.Status.OpIdentifier = "job-1"
+
+			err, _ := checkBackupJob(ctx, state)
+
+			assert.Nil(t, err)
+			assert.NotNil(t, state.backupJob)
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupCreating, backup.Status.State)
+		})
+
+		t.Run("backup job failed", func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), "TriggerBackup", string(armrecoveryservicesbackup.JobStatusFailed))
+			backup, state := setupTriggeredState(ctx, "job-1", time.Now().Add(-time.Minute))
+			assert.Nil(t, state.client.TriggerBackup(ctx, "", "", "", state.protectedResourceName, ""))
+			backup.Status.OpIdentifier = "job-1"
+
+			err, _ := checkBackupJob(ctx, state)
+
+			assert.Equal(t, composed.StopAndForget, err)
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupFailed, backup.Status.State)
+			cond := meta.FindStatusCondition(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+			assert.NotNil(t, cond)
+			assert.Equal(t, cloudresourcesv1beta1.ConditionReasonBackupJobFailed, cond.Reason)
+		})
+
+		t.Run("backup job cancelled", func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), "TriggerBackup", string(armrecoveryservicesbackup.JobStatusCancelled))
+			backup, state := setupTriggeredState(ctx, "job-1", time.Now().Add(-time.Minute))
+			assert.Nil(t, state.client.TriggerBackup(ctx, "", "", "", state.protectedResourceName, ""))
+			backup.Status.OpIdentifier = "job-1"
+
+			err, _ := checkBackupJob(ctx, state)
+
+			assert.Equal(t, composed.StopAndForget, err)
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupFailed, backup.Status.State)
+			cond := meta.FindStatusCondition(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+			assert.NotNil(t, cond)
+			assert.Equal(t, cloudresourcesv1beta1.ConditionReasonBackupJobCancelled, cond.Reason)
+		})
+
+	})
+
+}
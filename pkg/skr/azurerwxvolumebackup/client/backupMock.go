@@ -3,6 +3,9 @@ package client
 import (
 	"context"
 	"errors"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
 )
 
 func newBackupMockClient() *backupClient {
@@ -14,6 +17,7 @@ type backupMockClient struct {
 }
 
 func (m *backupMockClient) TriggerBackup(ctx context.Context, vaultName, resourceGroupName, containerName, protectedItemName, location string) error {
+	// TriggerBackup utilizes protectedItemName as jobId and the TriggerBackup context value as the final status after InProgress
 
 	// unhappy path
 	if ctx.Value("TriggerBackup") == "fail" {
@@ -21,5 +25,10 @@ func (m *backupMockClient) TriggerBackup(ctx context.Context, vaultName, resourc
 	}
 
 	// happy path
+	nextJobStatus := armrecoveryservicesbackup.JobStatusCompleted
+	if status, ok := ctx.Value("TriggerBackup").(string); ok {
+		nextJobStatus = armrecoveryservicesbackup.JobStatus(status)
+	}
+	jobsMock.AddBackupJob(protectedItemName, armrecoveryservicesbackup.JobStatusInProgress, nextJobStatus, time.Now().Format(time.RFC3339))
 	return nil
 }
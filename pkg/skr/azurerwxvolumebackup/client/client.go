@@ -5,6 +5,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservices"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 )
 
@@ -55,11 +56,17 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 			return nil, err
 		}
 
+		fileSharesClient, err := armstorage.NewFileSharesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+
+		if err != nil {
+			return nil, err
+		}
+
 		c = client{
 			NewVaultClient(recoveryServicesFactory.NewVaultsClient()),
 			NewBackupClient(recoveryServicesBackupFactory.NewBackupsClient()),
 			NewProtectionPoliciesClient(recoveryServicesBackupFactory.NewProtectionPoliciesClient()),
-			NewRecoveryPointClient(recoveryServicesBackupFactory.NewRecoveryPointsClient()),
+			NewRecoveryPointClient(recoveryServicesBackupFactory.NewRecoveryPointsClient(), fileSharesClient),
 			NewJobsClient(recoveryServicesBackupFactory.NewBackupJobsClient(), recoveryServicesBackupFactory.NewJobDetailsClient()),
 			NewRestoreClient(recoveryServicesBackupFactory.NewRestoresClient()),
 			NewBackupProtectableItemsClient(recoveryServicesBackupFactory.NewBackupProtectableItemsClient()),
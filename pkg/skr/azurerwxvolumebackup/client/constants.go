@@ -3,7 +3,9 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
@@ -35,6 +37,7 @@ const pvVolumeHandlePattern = "(?<resourceGroupName>[^\\#]*)#(?<storageAccountNa
 const recoverPointIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)\\/backupFabrics\\/Azure\\/protectionContainers\\/(?<container>[^\\/]*)\\/protectedItems\\/(?<protectedItem>[^\\/]*)\\/recoveryPoints\\/(?<recoveryPointId>[^\\/]*)"
 const vaultIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)"
 const protectedItemIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)\\/backupFabrics\\/Azure\\/protectionContainers\\/(?<container>[^\\/]*)\\/protectedItems\\/AzureFileShare;(?<protectedItem>[^\\/]*)"
+const containerNameRegexPattern = "^StorageContainer;Storage;(?<resourceGroup>[^;]*);(?<storageAccount>[^;]*)$"
 const containerIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)\\/backupFabrics\\/Azure\\/protectionContainers\\/(?<container>[^\\/]*)"
 
 const (
@@ -176,3 +179,34 @@ func ParseContainerId(containerId string) (subscription string, resourceGroup st
 func ToStorageJobTimeFilter(t time.Time) string {
 	return t.UTC().Format("2006-01-02 03:04:05 PM")
 }
+
+// ParseContainerName returns the resource group and the storage account of a protection container name
+func ParseContainerName(containerName string) (resourceGroup string, storageAccount string, err error) {
+	re := regexp.MustCompile(containerNameRegexPattern)
+	match := re.FindStringSubmatch(containerName)
+	if match == nil {
+		return "", "", fmt.Errorf("container name %s does not match pattern %s", containerName, containerNameRegexPattern)
+	}
+	result := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if i != 0 && name != "" {
+			result[name] = match[i]
+		}
+	}
+	return result["resourceGroup"], result["storageAccount"], nil
+}
+
+// ParseFileShareSnapshotUri returns the file share name and the snapshot time of the file share snapshot
+// an Azure Files recovery point is backed by, ie https://account.file.core.windows.net/share?sharesnapshot=2025-01-01T00:00:00.0000000Z
+func ParseFileShareSnapshotUri(snapshotUri string) (fileShareName string, snapshot string, err error) {
+	u, err := url.Parse(snapshotUri)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid file share snapshot uri %s: %w", snapshotUri, err)
+	}
+	fileShareName = strings.Trim(u.Path, "/")
+	snapshot = u.Query().Get("sharesnapshot")
+	if fileShareName == "" || snapshot == "" {
+		return "", "", fmt.Errorf("file share snapshot uri %s has no share name or snapshot", snapshotUri)
+	}
+	return fileShareName, snapshot, nil
+}
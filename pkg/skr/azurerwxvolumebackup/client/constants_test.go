@@ -133,6 +133,26 @@ func (s *constantsSuite) TestAzureStorageErrorInfoToJson() {
 	s.Equal("[{\"errorCode\":1234,\"errorString\":\"Sample message 1\",\"recommendations\":[\"recommendation 1\",\"recommendation 2\",\"recommendation 3\"]},{\"errorCode\":1234,\"errorString\":\"Sample message 2\",\"recommendations\":[\"recommendation 1\",\"recommendation 2\",\"recommendation 3\"]},{\"errorCode\":1234,\"errorString\":\"Sample message 3\",\"recommendations\":[\"recommendation 1\",\"recommendation 2\",\"recommendation 3\"]}]", detailsInJson)
 }
 
+func (s *constantsSuite) TestParseContainerName() {
+	resourceGroup, storageAccount, err := ParseContainerName(GetContainerName("test-rg", "testsa"))
+	s.Nil(err)
+	s.Equal("test-rg", resourceGroup)
+	s.Equal("testsa", storageAccount)
+
+	_, _, err = ParseContainerName("IaasVMContainer;iaasvmcontainerv2;test-rg;vm")
+	s.NotNil(err)
+}
+
+func (s *constantsSuite) TestParseFileShareSnapshotUri() {
+	fileShareName, snapshot, err := ParseFileShareSnapshotUri("https://testsa.file.core.windows.net/kh-file-share?sharesnapshot=2025-01-02T03:04:05.0000000Z")
+	s.Nil(err)
+	s.Equal("kh-file-share", fileShareName)
+	s.Equal("2025-01-02T03:04:05.0000000Z", snapshot)
+
+	_, _, err = ParseFileShareSnapshotUri("https://testsa.file.core.windows.net/kh-file-share")
+	s.NotNil(err)
+}
+
 func TestConstants(t *testing.T) {
 	suite.Run(t, new(constantsSuite))
 }
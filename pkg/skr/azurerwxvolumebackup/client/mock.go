@@ -23,12 +23,13 @@ func NewMockClient() azureclient.ClientProvider[Client] {
 		protectionPoliciesMock := &protectionPoliciesMockClient{protectionPoliciesClient: *newProtectionPoliciesMockClient()}
 		backupProtectableItemsMock := &backupProtectableItemsMockClient{backupProtectableItemsClient: *newBackupProtectableItemsMockClient()}
 		protectedItemsMock := &protectedItemsMockClient{protectedItemsClient: *newProtectedItemsMockClient()}
+		recoveryPointMock := &recoveryPointMockClient{recoveryPointClient: *newRecoveryPointMockClient()}
 
 		return client{
 			vaultMock,
 			backupMock,
 			protectionPoliciesMock,
-			nil,
+			recoveryPointMock,
 			jobsMock,
 			restoreMock,
 			backupProtectableItemsMock,
@@ -2,20 +2,27 @@ package client
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
 )
 
 type RecoveryPointClient interface {
 	GetRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string, recoveryPointId string) (*armrecoveryservicesbackup.RecoveryPointResource, error)
 	ListRecoveryPoints(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string) ([]*armrecoveryservicesbackup.RecoveryPointResource, error)
+	DeleteRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, containerName string, protectedItemName string, recoveryPointId string) error
 }
 
 type recoveryPointClient struct {
-	azureClient *armrecoveryservicesbackup.RecoveryPointsClient
+	azureClient      *armrecoveryservicesbackup.RecoveryPointsClient
+	fileSharesClient *armstorage.FileSharesClient
 }
 
-func NewRecoveryPointClient(rpc *armrecoveryservicesbackup.RecoveryPointsClient) RecoveryPointClient {
-	return recoveryPointClient{rpc}
+func NewRecoveryPointClient(rpc *armrecoveryservicesbackup.RecoveryPointsClient, fsc *armstorage.FileSharesClient) RecoveryPointClient {
+	return recoveryPointClient{rpc, fsc}
 }
 
 func (c recoveryPointClient) GetRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string, recoveryPointId string) (*armrecoveryservicesbackup.RecoveryPointResource, error) {
@@ -69,3 +76,47 @@ func (c recoveryPointClient) ListRecoveryPoints(ctx context.Context, vaultName s
 
 	return result, nil
 }
+
+// DeleteRecoveryPoint deletes an Azure Files recovery point by deleting the file share snapshot it is backed by.
+// Azure Backup has no API to delete a single recovery point, it drops the recovery point once its snapshot is gone.
+// A recovery point that does not exist anymore is not an error.
+func (c recoveryPointClient) DeleteRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, containerName string, protectedItemName string, recoveryPointId string) error {
+	rp, err := c.GetRecoveryPoint(ctx, vaultName, resourceGroupName, AzureFabricName, containerName, protectedItemName, recoveryPointId)
+	if meta.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	props, ok := rp.Properties.(*armrecoveryservicesbackup.AzureFileShareRecoveryPoint)
+	if !ok || props.FileShareSnapshotURI == nil {
+		return fmt.Errorf("recovery point %s is not backed by a file share snapshot", recoveryPointId)
+	}
+	storageResourceGroupName, storageAccountName, err := ParseContainerName(containerName)
+	if err != nil {
+		return err
+	}
+	fileShareName, snapshot, err := ParseFileShareSnapshotUri(*props.FileShareSnapshotURI)
+	if err != nil {
+		return err
+	}
+
+	// Azure Backup leases the snapshots it takes, so the lease has to be broken before the snapshot can be deleted.
+	// Breaking the lease of a snapshot that is not leased fails with conflict.
+	_, err = c.fileSharesClient.Lease(ctx, storageResourceGroupName, storageAccountName, fileShareName, &armstorage.FileSharesClientLeaseOptions{
+		XMSSnapshot: new(snapshot),
+		Parameters: &armstorage.LeaseShareRequest{
+			Action:      to.Ptr(armstorage.LeaseShareActionBreak),
+			BreakPeriod: new(int32(0)),
+		},
+	})
+	if err != nil && !meta.IsConflictError(err) && !meta.IsNotFound(err) {
+		return err
+	}
+
+	_, err = c.fileSharesClient.Delete(ctx, storageResourceGroupName, storageAccountName, fileShareName, &armstorage.FileSharesClientDeleteOptions{
+		XMSSnapshot: new(snapshot),
+	})
+	return meta.IgnoreNotFoundError(err)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+)
+
+func newRecoveryPointMockClient() *recoveryPointClient {
+	return &recoveryPointClient{}
+}
+
+type recoveryPointMockClient struct {
+	recoveryPointClient
+}
+
+func (m *recoveryPointMockClient) GetRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string, recoveryPointId string) (*armrecoveryservicesbackup.RecoveryPointResource, error) {
+	if ctx.Value("GetRecoveryPoint") == "fail" {
+		return nil, errors.New("failed GetRecoveryPoint")
+	}
+	return newMockRecoveryPoint(recoveryPointId), nil
+}
+
+func (m *recoveryPointMockClient) ListRecoveryPoints(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string) ([]*armrecoveryservicesbackup.RecoveryPointResource, error) {
+	if ctx.Value("ListRecoveryPoints") == "fail" {
+		return nil, errors.New("failed ListRecoveryPoints")
+	}
+	if ctx.Value("ListRecoveryPoints") == "empty" {
+		return nil, nil
+	}
+	return []*armrecoveryservicesbackup.RecoveryPointResource{
+		newMockRecoveryPoint("recoveryPoint"),
+	}, nil
+}
+
+func (m *recoveryPointMockClient) DeleteRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, containerName string, protectedItemName string, recoveryPointId string) error {
+	if ctx.Value("DeleteRecoveryPoint") == "fail" {
+		return errors.New("failed DeleteRecoveryPoint")
+	}
+	return nil
+}
+
+func newMockRecoveryPoint(recoveryPointName string) *armrecoveryservicesbackup.RecoveryPointResource {
+	return &armrecoveryservicesbackup.RecoveryPointResource{
+		Name: new(recoveryPointName),
+		Properties: &armrecoveryservicesbackup.AzureFileShareRecoveryPoint{
+			ObjectType:            new("AzureFileShareRecoveryPoint"),
+			RecoveryPointTime:     new(time.Now()),
+			RecoveryPointSizeInGB: new(int32(1)),
+		},
+	}
+}
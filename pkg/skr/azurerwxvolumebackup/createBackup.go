@@ -39,6 +39,9 @@ func createBackup(ctx context.Context, st composed.State) (error, context.Contex
 	// Bind BackupPolicy to Fileshare
 	containerName := azurerwxvolumebackupclient.GetContainerName(resourceGroupName, storageAccountName)
 
+	// The backup job is looked up by its start time, so it is taken before the backup is triggered
+	startTime := metav1.Now()
+
 	// Invoke backup
 	err := state.client.TriggerBackup(ctx, vaultName, resourceGroupName, containerName, protectedItemName, backup.Spec.Location)
 	if err != nil {
@@ -60,6 +63,11 @@ func createBackup(ctx context.Context, st composed.State) (error, context.Contex
 
 	}
 
-	backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupDone // TODO: redo with Creating
-	return composed.UpdateStatus(backup).SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).Run(ctx, state)
+	backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupCreating
+	backup.Status.StartTime = &startTime
+	backup.Status.StorageAccountPath = azurerwxvolumebackupclient.GetStorageAccountPath(state.subscriptionId, resourceGroupName, storageAccountName)
+	return composed.PatchStatus(backup).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		Run(ctx, state)
 }
@@ -11,6 +11,7 @@ import (
 	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	spy "github.com/kyma-project/cloud-manager/pkg/testinfra/clientspy"
+	"github.com/kyma-project/cloud-manager/pkg/util"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,8 +38,12 @@ func setupDefaultBackup() *cloudresourcesv1beta1.AzureRwxVolumeBackup {
 
 }
 
-func setupDefaultCluster() composed.StateCluster {
-	fakeClient := fake.NewClientBuilder().WithScheme(commonscheme.SkrScheme).Build()
+func setupDefaultCluster(backup *cloudresourcesv1beta1.AzureRwxVolumeBackup) composed.StateCluster {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(commonscheme.SkrScheme).
+		WithObjects(backup).
+		WithStatusSubresource(backup).
+		Build()
 	k8sClient := spy.NewClientSpy(fakeClient)
 	cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
 
@@ -48,7 +53,7 @@ func setupDefaultCluster() composed.StateCluster {
 
 func setupDefaultState(ctx context.Context, backup *cloudresourcesv1beta1.AzureRwxVolumeBackup) *State {
 
-	cluster := setupDefaultCluster()
+	cluster := setupDefaultCluster(backup)
 
 	scope := &cloudcontrolv1beta1.Scope{
 		ObjectMeta: metav1.ObjectMeta{
@@ -138,8 +143,9 @@ func TestCreateBackup(t *testing.T) {
 
 				err, _ := createBackup(newCtx, state)
 
-				assert.Equal(t, composed.StopWithRequeue, err)
-				assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupDone, backup.Status.State)
+				assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T10000ms()), err)
+				assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupCreating, backup.Status.State)
+				assert.NotNil(t, backup.Status.StartTime)
 
 			})
 
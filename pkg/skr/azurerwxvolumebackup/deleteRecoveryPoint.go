@@ -0,0 +1,47 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteRecoveryPoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRwxVolumeBackup()
+	logger := composed.LoggerFromCtx(ctx)
+
+	if backup.Status.RecoveryPointId == "" {
+		return nil, ctx
+	}
+
+	_, resourceGroup, vault, container, protectedItem, recoveryPointId, err := client.ParseRecoveryPointId(backup.Status.RecoveryPointId)
+	if err != nil {
+		logger.Error(err, "Invalid recoveryPointId, the recovery point is not deleted")
+		return nil, ctx
+	}
+
+	logger.Info("Deleting recovery point", "recoveryPointId", backup.Status.RecoveryPointId)
+	err = state.client.DeleteRecoveryPoint(ctx, vault, resourceGroup, container, protectedItem, recoveryPointId)
+	if err != nil {
+		logger.Error(err, "Error deleting recovery point")
+		backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupError
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonRecoveryPointDeleteFailed,
+				Message: fmt.Sprintf("Failed to delete recovery point: %s", err),
+			}).
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupDeleted
+	return nil, ctx
+}
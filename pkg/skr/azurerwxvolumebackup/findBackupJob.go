@@ -0,0 +1,57 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// backupJobLookupTimeout is how long after the backup was triggered its job is looked for
+const backupJobLookupTimeout = 15 * time.Minute
+
+func findBackupJob(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRwxVolumeBackup()
+	logger := composed.LoggerFromCtx(ctx)
+
+	if backup.Status.OpIdentifier != "" {
+		return nil, ctx
+	}
+	if backup.Status.StartTime == nil {
+		return composed.LogErrorAndReturn(nil, "Should not reach findBackupJob action if startTime is missing.", composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx)
+	}
+
+	jobId, err := state.client.FindNextBackupJobId(ctx, state.vaultName, state.resourceGroupName, state.fileShareName, backup.Status.StartTime.Time)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error finding backup job", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+
+	if jobId == nil {
+		if time.Since(backup.Status.StartTime.Time) < backupJobLookupTimeout {
+			logger.Info("Backup job not found yet")
+			return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+		}
+		logger.Error(nil, "Backup job not found", "startTime", backup.Status.StartTime)
+		backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupFailed
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonBackupJobNotFound,
+				Message: fmt.Sprintf("Backup job was not found %v after the backup was triggered", backupJobLookupTimeout),
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	logger.Info("Backup job found", "opIdentifier", *jobId)
+	backup.Status.OpIdentifier = *jobId
+	return composed.PatchStatus(backup).
+		SuccessErrorNil().
+		Run(ctx, state)
+}
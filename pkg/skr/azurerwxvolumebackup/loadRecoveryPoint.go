@@ -0,0 +1,95 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// recoveryPointLookupTimeout is how long after the backup job completed its recovery point is looked for
+const recoveryPointLookupTimeout = 5 * time.Minute
+
+// findJobRecoveryPoint returns the latest file share recovery point taken while the job was running
+func findJobRecoveryPoint(recoveryPoints []*armrecoveryservicesbackup.RecoveryPointResource, job *armrecoveryservicesbackup.AzureStorageJob) (*armrecoveryservicesbackup.RecoveryPointResource, *armrecoveryservicesbackup.AzureFileShareRecoveryPoint) {
+	var result *armrecoveryservicesbackup.RecoveryPointResource
+	var resultProps *armrecoveryservicesbackup.AzureFileShareRecoveryPoint
+	for _, rp := range recoveryPoints {
+		props, ok := rp.Properties.(*armrecoveryservicesbackup.AzureFileShareRecoveryPoint)
+		if !ok || props.RecoveryPointTime == nil {
+			continue
+		}
+		if job.StartTime != nil && props.RecoveryPointTime.Before(*job.StartTime) {
+			continue
+		}
+		if job.EndTime != nil && props.RecoveryPointTime.After(*job.EndTime) {
+			continue
+		}
+		if resultProps == nil || props.RecoveryPointTime.After(*resultProps.RecoveryPointTime) {
+			result = rp
+			resultProps = props
+		}
+	}
+	return result, resultProps
+}
+
+func loadRecoveryPoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRwxVolumeBackup()
+	logger := composed.LoggerFromCtx(ctx)
+	if state.backupJob == nil {
+		return composed.LogErrorAndReturn(nil, "Should not reach loadRecoveryPoint action if backup job is not completed.", composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx)
+	}
+
+	containerName := client.GetContainerName(state.resourceGroupName, state.storageAccountName)
+	recoveryPoints, err := state.client.ListRecoveryPoints(ctx, state.vaultName, state.resourceGroupName, client.AzureFabricName, containerName, state.protectedResourceName)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing recovery points", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+
+	rp, props := findJobRecoveryPoint(recoveryPoints, state.backupJob)
+	if rp == nil {
+		endTime := ptr.Deref(state.backupJob.EndTime, time.Now())
+		if time.Since(endTime) < recoveryPointLookupTimeout {
+			logger.Info("Recovery point of the backup job not listed yet")
+			return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+		}
+		logger.Error(nil, "Recovery point of the backup job not found")
+		backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupFailed
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonRecoveryPointNotFound,
+				Message: "Backup job completed, but its recovery point was not found",
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	backup.Status.RecoveryPointId = ptr.Deref(rp.ID, client.GetRecoveryPointPath(state.subscriptionId, state.resourceGroupName,
+		state.vaultName, state.storageAccountName, state.protectedResourceName, ptr.Deref(rp.Name, "")))
+	backup.Status.StorageAccountPath = client.GetStorageAccountPath(state.subscriptionId, state.resourceGroupName, state.storageAccountName)
+	if props.RecoveryPointSizeInGB != nil {
+		backup.Status.Capacity = *resource.NewQuantity(int64(*props.RecoveryPointSizeInGB)*1024*1024*1024, resource.BinarySI)
+	}
+	backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupDone
+
+	logger.Info("Backup completed", "recoveryPointId", backup.Status.RecoveryPointId)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonReady,
+			Message: "Backup operation finished successfully.",
+		}).
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
@@ -0,0 +1,116 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestLoadRecoveryPoint(t *testing.T) {
+
+	setupCompletedState := func(ctx context.Context, endTime time.Time) (*cloudresourcesv1beta1.AzureRwxVolumeBackup, *State) {
+		backup := setupDefaultBackup()
+		backup.Status.Id = "asdf"
+		backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupCreating
+		backup.Status.OpIdentifier = "job-1"
+		state := setupDefaultState(ctx, backup)
+		state.vaultName = "vault"
+		state.resourceGroupName = "rg"
+		state.storageAccountName = "sa"
+		state.protectedResourceName = "AzureFileShare;1234"
+		state.backupJob = &armrecoveryservicesbackup.AzureStorageJob{
+			Status:  new(string(armrecoveryservicesbackup.JobStatusCompleted)),
+			EndTime: new(endTime),
+		}
+		return backup, state
+	}
+
+	t.Run("recovery point found", func(t *testing.T) {
+		ctx := context.Background()
+		backup, state := setupCompletedState(ctx, time.Now().Add(time.Minute))
+
+		err, _ := loadRecoveryPoint(ctx, state)
+
+		assert.Equal(t, composed.StopAndForget, err)
+		assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupDone, backup.Status.State)
+		assert.True(t, meta.IsStatusConditionTrue(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady))
+		assert.Equal(t, azurerwxvolumebackupclient.GetRecoveryPointPath("test-subscription-id", "rg", "vault", "sa", "AzureFileShare;1234", "recoveryPoint"), backup.Status.RecoveryPointId)
+		assert.True(t, backup.Status.Capacity.Equal(resource.MustParse("1Gi")))
+	})
+
+	t.Run("recovery point not listed yet", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "ListRecoveryPoints", "empty")
+		backup, state := setupCompletedState(ctx, time.Now())
+
+		err, _ := loadRecoveryPoint(ctx, state)
+
+		assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T10000ms()), err)
+		assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupCreating, backup.Status.State)
+	})
+
+	t.Run("recovery point not found after timeout", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "ListRecoveryPoints", "empty")
+		backup, state := setupCompletedState(ctx, time.Now().Add(-recoveryPointLookupTimeout-time.Minute))
+
+		err, _ := loadRecoveryPoint(ctx, state)
+
+		assert.Equal(t, composed.StopAndForget, err)
+		assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupFailed, backup.Status.State)
+		cond := meta.FindStatusCondition(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+		assert.NotNil(t, cond)
+		assert.Equal(t, cloudresourcesv1beta1.ConditionReasonRecoveryPointNotFound, cond.Reason)
+	})
+
+}
+
+func TestDeleteRecoveryPoint(t *testing.T) {
+
+	recoveryPointId := azurerwxvolumebackupclient.GetRecoveryPointPath("test-subscription-id", "rg", "vault", "sa", "AzureFileShare;1234", "recoveryPoint")
+
+	t.Run("no recovery point", func(t *testing.T) {
+		ctx := context.Background()
+		backup := setupDefaultBackup()
+		state := setupDefaultState(ctx, backup)
+
+		err, _ := deleteRecoveryPoint(ctx, state)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("recovery point deleted", func(t *testing.T) {
+		ctx := context.Background()
+		backup := setupDefaultBackup()
+		backup.Status.RecoveryPointId = recoveryPointId
+		state := setupDefaultState(ctx, backup)
+
+		err, _ := deleteRecoveryPoint(ctx, state)
+
+		assert.Nil(t, err)
+		assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupDeleted, backup.Status.State)
+	})
+
+	t.Run("recovery point delete failed", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "DeleteRecoveryPoint", "fail")
+		backup := setupDefaultBackup()
+		backup.Status.RecoveryPointId = recoveryPointId
+		state := setupDefaultState(ctx, backup)
+
+		err, _ := deleteRecoveryPoint(ctx, state)
+
+		assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T60000ms()), err)
+		assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupError, backup.Status.State)
+		cond := meta.FindStatusCondition(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+		assert.NotNil(t, cond)
+		assert.Equal(t, cloudresourcesv1beta1.ConditionReasonRecoveryPointDeleteFailed, cond.Reason)
+	})
+
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -45,8 +46,9 @@ func (r *reconciler) newAction() composed.Action {
 		"azureRwxVolumeBackupMain",
 		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.AzureRwxVolumeBackup{}),
 		commonscope.New(),
-		composed.IfElse(
-			composed.Not(CompletedOrDeletedPredicate),
+		composed.If(
+			// a backup deleted while its job runs is finished first, so its recovery point is known and can be deleted
+			composed.Any(composed.Not(CompletedOrDeletedPredicate), BackupJobRunningPredicate),
 			composed.ComposeActions("AzureRwxVolumeBackupNotCompletedOrDeleted",
 				actions.PatchAddCommonFinalizer(),
 				loadPersistentVolumeClaim,
@@ -54,12 +56,28 @@ func (r *reconciler) newAction() composed.Action {
 				createClient,
 				createVault,
 				getProtectedResourceName,
-				createBackupPolicy,
-				protectFileshare,
-				createBackup,
-			), nil,
+				composed.If(
+					composed.Not(BackupTriggeredPredicate),
+					composed.ComposeActions("AzureRwxVolumeBackupTrigger",
+						createBackupPolicy,
+						protectFileshare,
+						quotacheck.New(nil),
+						createBackup,
+					),
+				),
+				findBackupJob,
+				checkBackupJob,
+				loadRecoveryPoint,
+			),
+		),
+		composed.If(
+			composed.MarkedForDeletionPredicate,
+			composed.ComposeActions("AzureRwxVolumeBackupDelete",
+				createClient,
+				deleteRecoveryPoint,
+				actions.PatchRemoveCommonFinalizer(),
+			),
 		),
-		actions.PatchRemoveCommonFinalizer(),
 		composed.StopAndForgetAction,
 	)
 }
@@ -84,3 +102,11 @@ func CompletedOrDeletedPredicate(_ context.Context, state composed.State) bool {
 	return isDeleted || currentState == cloudresourcesv1beta1.AzureRwxBackupDone || currentState == cloudresourcesv1beta1.AzureRwxBackupFailed
 
 }
+
+func BackupTriggeredPredicate(_ context.Context, state composed.State) bool {
+	return state.Obj().(*cloudresourcesv1beta1.AzureRwxVolumeBackup).Status.StartTime != nil
+}
+
+func BackupJobRunningPredicate(_ context.Context, state composed.State) bool {
+	return state.Obj().(*cloudresourcesv1beta1.AzureRwxVolumeBackup).Status.State == cloudresourcesv1beta1.AzureRwxBackupCreating
+}
@@ -3,6 +3,7 @@ package azurerwxvolumebackup
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
@@ -10,6 +11,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
 	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -25,6 +27,7 @@ type State struct {
 	scope                 *cloudcontrolv1beta1.Scope
 	subscriptionId        string
 	protectedResourceName string // TODO: fetch via action
+	backupJob             *armrecoveryservicesbackup.AzureStorageJob
 }
 
 func (s *State) ObjAsAzureRwxVolumeBackup() *cloudresourcesv1beta1.AzureRwxVolumeBackup {
@@ -63,3 +66,11 @@ func newStateFactory(
 		clientProvider:          clientProvider,
 	}
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef()
+}
+
+func (s *State) IsProvisioned() bool {
+	return len(s.ObjAsAzureRwxVolumeBackup().Status.Id) > 0
+}
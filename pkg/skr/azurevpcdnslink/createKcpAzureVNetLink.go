@@ -6,6 +6,7 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 )
 
 func createKcpAzureVNetLink(ctx context.Context, st composed.State) (error, context.Context) {
@@ -34,6 +35,7 @@ func createKcpAzureVNetLink(ctx context.Context, st composed.State) (error, cont
 		WithRemoteTenant(state.ObjAsVNetLink().Spec.RemoteTenant).
 		Build()
 
+	tracing.InjectIntoObj(ctx, state.KcpAzureVNetLink)
 	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpAzureVNetLink)
 
 	if err == nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -61,6 +62,7 @@ func createKcpRemoteNetwork(ctx context.Context, st composed.State) (error, cont
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, remoteNetwork)
 	err = state.KcpCluster.K8sClient().Create(ctx, remoteNetwork)
 
 	if err != nil {
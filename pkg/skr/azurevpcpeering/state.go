@@ -53,3 +53,11 @@ func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State,
 func (s *State) ObjAsAzureVpcPeering() *cloudresourcesv1beta1.AzureVpcPeering {
 	return s.Obj().(*cloudresourcesv1beta1.AzureVpcPeering)
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.RemoteNetwork != nil || s.KcpVpcPeering != nil
+}
@@ -1,6 +1,7 @@
 package backupschedule
 
 import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +34,7 @@ type BackupScheduleSpec interface {
 	SetMaxReadyBackups(count int)
 	GetMaxFailedBackups() int
 	SetMaxFailedBackups(count int)
+	GetRetentionPolicy() *cloudresourcesv1beta1.RetentionPolicy
 }
 
 type BackupScheduleStatus interface {
@@ -0,0 +1,61 @@
+package backupschedule
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type retentionBucket struct {
+	count  int
+	period func(t time.Time) string
+}
+
+// RetainedByPolicy classifies the backups into the daily, weekly, monthly and yearly buckets of the policy
+// by their creation time in UTC, and returns the names of the backups that fall into at least one of them.
+// Each bucket retains the most recent backup of each of its last N periods that have a backup.
+// Only the backups that are eligible for retention, usually the Ready ones, should be passed.
+func RetainedByPolicy[T client.Object](policy *cloudresourcesv1beta1.RetentionPolicy, backups []T) sets.Set[string] {
+	retained := sets.New[string]()
+	if policy == nil {
+		return retained
+	}
+
+	//sort the backups in reverse chronological order.
+	sorted := make([]T, len(backups))
+	copy(sorted, backups)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetCreationTimestamp().After(sorted[j].GetCreationTimestamp().Time)
+	})
+
+	buckets := []retentionBucket{
+		{count: policy.Daily, period: func(t time.Time) string { return t.Format(time.DateOnly) }},
+		{count: policy.Weekly, period: func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{count: policy.Monthly, period: func(t time.Time) string { return t.Format("2006-01") }},
+		{count: policy.Yearly, period: func(t time.Time) string { return t.Format("2006") }},
+	}
+
+	for _, bucket := range buckets {
+		periods := sets.New[string]()
+		for _, backup := range sorted {
+			if periods.Len() >= bucket.count {
+				break
+			}
+			period := bucket.period(backup.GetCreationTimestamp().UTC())
+			if periods.Has(period) {
+				continue
+			}
+			periods.Insert(period)
+			retained.Insert(backup.GetName())
+		}
+	}
+
+	return retained
+}
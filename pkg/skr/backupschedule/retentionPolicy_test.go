@@ -0,0 +1,73 @@
+package backupschedule
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// dailyBackups returns one backup per day at noon UTC, going back count days from the given day.
+func dailyBackups(from time.Time, count int) []*cloudresourcesv1beta1.GcpNfsVolumeBackup {
+	var backups []*cloudresourcesv1beta1.GcpNfsVolumeBackup
+	for i := 0; i < count; i++ {
+		created := from.AddDate(0, 0, -i)
+		backups = append(backups, &cloudresourcesv1beta1.GcpNfsVolumeBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("backup-%s", created.Format(time.DateOnly)),
+				CreationTimestamp: metav1.Time{Time: created},
+			},
+		})
+	}
+	return backups
+}
+
+func TestRetainedByPolicy(t *testing.T) {
+	// Sunday
+	from := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("nil policy retains nothing", func(t *testing.T) {
+		retained := RetainedByPolicy(nil, dailyBackups(from, 10))
+		assert.Equal(t, 0, retained.Len())
+	})
+
+	t.Run("daily bucket retains the last N days", func(t *testing.T) {
+		retained := RetainedByPolicy(&cloudresourcesv1beta1.RetentionPolicy{Daily: 3}, dailyBackups(from, 10))
+		assert.Equal(t, sets.New("backup-2026-03-01", "backup-2026-02-28", "backup-2026-02-27"), retained)
+	})
+
+	t.Run("weekly bucket retains the last backup of each week", func(t *testing.T) {
+		retained := RetainedByPolicy(&cloudresourcesv1beta1.RetentionPolicy{Weekly: 3}, dailyBackups(from, 30))
+		// 2026-03-01 is the Sunday closing ISO week 9, the previous weeks end on Sundays 02-22 and 02-15
+		assert.Equal(t, sets.New("backup-2026-03-01", "backup-2026-02-22", "backup-2026-02-15"), retained)
+	})
+
+	t.Run("monthly bucket retains the last backup of each month", func(t *testing.T) {
+		retained := RetainedByPolicy(&cloudresourcesv1beta1.RetentionPolicy{Monthly: 3}, dailyBackups(from, 100))
+		assert.Equal(t, sets.New("backup-2026-03-01", "backup-2026-02-28", "backup-2026-01-31"), retained)
+	})
+
+	t.Run("yearly bucket retains the last backup of each year", func(t *testing.T) {
+		retained := RetainedByPolicy(&cloudresourcesv1beta1.RetentionPolicy{Yearly: 5}, dailyBackups(from, 400))
+		assert.Equal(t, sets.New("backup-2026-03-01", "backup-2025-12-31"), retained)
+	})
+
+	t.Run("buckets are combined", func(t *testing.T) {
+		retained := RetainedByPolicy(&cloudresourcesv1beta1.RetentionPolicy{Daily: 2, Weekly: 2, Monthly: 2}, dailyBackups(from, 100))
+		assert.Equal(t, sets.New("backup-2026-03-01", "backup-2026-02-28", "backup-2026-02-22"), retained)
+	})
+
+	t.Run("order of the backups does not matter", func(t *testing.T) {
+		backups := dailyBackups(from, 10)
+		reversed := make([]*cloudresourcesv1beta1.GcpNfsVolumeBackup, 0, len(backups))
+		for i := len(backups) - 1; i >= 0; i-- {
+			reversed = append(reversed, backups[i])
+		}
+		policy := &cloudresourcesv1beta1.RetentionPolicy{Daily: 2, Weekly: 2}
+		assert.Equal(t, RetainedByPolicy(policy, backups), RetainedByPolicy(policy, reversed))
+	})
+}
@@ -1,11 +1,13 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -51,6 +53,7 @@ func (impl *backupImplAwsNfs) getBackupObject(state *State, objectMeta *metav1.O
 					Namespace: schedule.GetSourceRef().Namespace,
 				},
 			},
+			Hooks: x.Spec.Hooks.DeepCopy(),
 		},
 	}, nil
 }
@@ -62,3 +65,78 @@ func (impl *backupImplAwsNfs) sourceToObjWithConditionAndState(obj client.Object
 	}
 	return x, nil
 }
+
+func (impl *backupImplAwsNfs) EmptyScratchVolume() client.Object {
+	return &cloudresourcesv1beta1.AwsNfsVolume{}
+}
+
+func (impl *backupImplAwsNfs) NewScratchVolume(ctx context.Context, clnt client.Client, backup client.Object, name string) (client.Object, error) {
+	x, ok := backup.(*cloudresourcesv1beta1.AwsNfsVolumeBackup)
+	if !ok {
+		return nil, errors.New("backup Object should be of type AwsNfsVolumeBackup")
+	}
+
+	source := &cloudresourcesv1beta1.AwsNfsVolume{}
+	err := clnt.Get(ctx, x.Spec.Source.Volume.ToNamespacedName(x.Namespace), source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloudresourcesv1beta1.AwsNfsVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: x.Namespace,
+		},
+		Spec: cloudresourcesv1beta1.AwsNfsVolumeSpec{
+			IpRange:         source.Spec.IpRange,
+			Capacity:        source.Spec.Capacity,
+			PerformanceMode: source.Spec.PerformanceMode,
+			Throughput:      source.Spec.Throughput,
+		},
+	}, nil
+}
+
+func (impl *backupImplAwsNfs) IsScratchVolumeReady(volume client.Object) bool {
+	x, ok := volume.(*cloudresourcesv1beta1.AwsNfsVolume)
+	return ok && meta.IsStatusConditionTrue(x.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady)
+}
+
+func (impl *backupImplAwsNfs) EmptyRestore() composed.ObjWithConditions {
+	return &cloudresourcesv1beta1.AwsNfsVolumeRestore{}
+}
+
+func (impl *backupImplAwsNfs) NewRestore(backup client.Object, volume client.Object, name string) client.Object {
+	return &cloudresourcesv1beta1.AwsNfsVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: backup.GetNamespace(),
+		},
+		Spec: cloudresourcesv1beta1.AwsNfsVolumeRestoreSpec{
+			Source: cloudresourcesv1beta1.AwsNfsVolumeRestoreSource{
+				Backup: cloudresourcesv1beta1.BackupRef{
+					Name:      backup.GetName(),
+					Namespace: backup.GetNamespace(),
+				},
+			},
+			Destination: &cloudresourcesv1beta1.AwsNfsVolumeRestoreDestination{
+				Volume: cloudresourcesv1beta1.VolumeRef{
+					Name:      volume.GetName(),
+					Namespace: volume.GetNamespace(),
+				},
+			},
+		},
+	}
+}
+
+func (impl *backupImplAwsNfs) GetRestoreState(restore composed.ObjWithConditions) string {
+	x, ok := restore.(*cloudresourcesv1beta1.AwsNfsVolumeRestore)
+	if !ok {
+		return ""
+	}
+	return x.Status.State
+}
+
+func (impl *backupImplAwsNfs) IsBackupReady(backup client.Object) bool {
+	x, ok := backup.(*cloudresourcesv1beta1.AwsNfsVolumeBackup)
+	return ok && x.Status.State == cloudresourcesv1beta1.StateReady
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -101,3 +102,79 @@ func (src *azureRwxSource) State() string {
 func (src *azureRwxSource) SetState(_ string) {
 	//NOOP
 }
+
+func (impl *backupImplAzureRwx) EmptyScratchVolume() client.Object {
+	return &corev1.PersistentVolumeClaim{}
+}
+
+func (impl *backupImplAzureRwx) NewScratchVolume(ctx context.Context, clnt client.Client, backup client.Object, name string) (client.Object, error) {
+	x, ok := backup.(*cloudresourcesv1beta1.AzureRwxVolumeBackup)
+	if !ok {
+		return nil, errors.New("backup Object should be of type AzureRwxVolumeBackup")
+	}
+
+	source := &corev1.PersistentVolumeClaim{}
+	err := clnt.Get(ctx, x.Spec.Source.Pvc.ToNamespacedName(x.Namespace), source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: x.Namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: source.Spec.StorageClassName,
+			AccessModes:      source.Spec.AccessModes,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: source.Spec.Resources.Requests,
+			},
+		},
+	}, nil
+}
+
+func (impl *backupImplAzureRwx) IsScratchVolumeReady(volume client.Object) bool {
+	x, ok := volume.(*corev1.PersistentVolumeClaim)
+	return ok && x.Status.Phase == corev1.ClaimBound
+}
+
+func (impl *backupImplAzureRwx) EmptyRestore() composed.ObjWithConditions {
+	return &cloudresourcesv1beta1.AzureRwxVolumeRestore{}
+}
+
+func (impl *backupImplAzureRwx) NewRestore(backup client.Object, volume client.Object, name string) client.Object {
+	return &cloudresourcesv1beta1.AzureRwxVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: backup.GetNamespace(),
+		},
+		Spec: cloudresourcesv1beta1.AzureRwxVolumeRestoreSpec{
+			Source: cloudresourcesv1beta1.AzureRwxVolumeRestoreSource{
+				Backup: cloudresourcesv1beta1.AzureRwxVolumeBackupRef{
+					Name:      backup.GetName(),
+					Namespace: backup.GetNamespace(),
+				},
+			},
+			Destination: cloudresourcesv1beta1.PvcSource{
+				Pvc: cloudresourcesv1beta1.PvcRef{
+					Name:      volume.GetName(),
+					Namespace: volume.GetNamespace(),
+				},
+			},
+		},
+	}
+}
+
+func (impl *backupImplAzureRwx) GetRestoreState(restore composed.ObjWithConditions) string {
+	x, ok := restore.(*cloudresourcesv1beta1.AzureRwxVolumeRestore)
+	if !ok {
+		return ""
+	}
+	return x.Status.State
+}
+
+func (impl *backupImplAzureRwx) IsBackupReady(backup client.Object) bool {
+	x, ok := backup.(*cloudresourcesv1beta1.AzureRwxVolumeBackup)
+	return ok && x.Status.State == cloudresourcesv1beta1.AzureRwxBackupDone
+}
@@ -52,6 +52,7 @@ func (impl *backupImplGcpNfs) getBackupObject(state *State, objectMeta *metav1.O
 				},
 			},
 			AccessibleFrom: state.ObjAsGcpNfsBackupSchedule().Spec.AccessibleFrom,
+			Hooks:          x.Spec.Hooks.DeepCopy(),
 		},
 	}, nil
 }
@@ -8,8 +8,10 @@ import (
 	"github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func deleteBackups(ctx context.Context, st composed.State) (error, context.Context) {
@@ -34,8 +36,9 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 	}
 
 	//If the number of backups is zero, OR
-	//If maxRetentionDays is not positive, requeue to update next run time
-	if len(state.Backups) == 0 || schedule.GetMaxRetentionDays() <= 0 {
+	//If maxRetentionDays is not positive and there is no retention policy, requeue to update next run time
+	policy := schedule.GetRetentionPolicy()
+	if len(state.Backups) == 0 || (schedule.GetMaxRetentionDays() <= 0 && policy == nil) {
 		schedule.SetLastDeleteRun(&metav1.Time{Time: state.nextRunTime.UTC()})
 		schedule.SetNextDeleteTimes(nil)
 		schedule.SetLastDeletedBackups(nil)
@@ -51,6 +54,16 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 	nextDeleteTimes := map[string]string{}
 	var lastDeleted []corev1.ObjectReference
 	readyCount, failedCount := 0, 0
+
+	//Ready backups outside every bucket of the retention policy are deleted
+	var readyBackups []client.Object
+	for _, bk := range state.Backups {
+		if backup, okay := bk.(composed.ObjWithConditionsAndState); okay && backup.State() == v1beta1.StateReady {
+			readyBackups = append(readyBackups, bk)
+		}
+	}
+	retained := backupschedule.RetainedByPolicy(policy, readyBackups)
+
 	for _, bk := range state.Backups {
 		backup, okay := bk.(composed.ObjWithConditionsAndState)
 		if !okay {
@@ -58,11 +71,18 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 			continue
 		}
 
+		//Keep the backup while it is being verified
+		if backupschedule.IsVerificationInProgress(backup) {
+			continue
+		}
+
 		//Check if the backup object should be deleted
+		retainedByPolicy := policy != nil && backup.State() == v1beta1.StateReady
 		toRetain := time.Duration(schedule.GetMaxRetentionDays()) * 24 * time.Hour
 		elapsed := time.Since(backup.GetCreationTimestamp().Time)
-		if elapsed > toRetain ||
-			(backup.State() == v1beta1.StateReady && readyCount >= schedule.GetMaxReadyBackups()) ||
+		if (retainedByPolicy && !retained.Has(backup.GetName())) ||
+			(!retainedByPolicy && elapsed > toRetain) ||
+			(!retainedByPolicy && backup.State() == v1beta1.StateReady && readyCount >= schedule.GetMaxReadyBackups()) ||
 			(backup.State() == v1beta1.StateFailed && failedCount >= schedule.GetMaxFailedBackups()) {
 			logger.WithValues("Backup", backup.GetName()).Info("Deleting backup object")
 			err := state.Cluster().K8sClient().Delete(ctx, backup)
@@ -83,7 +103,8 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 				failedCount++
 			}
 		}
-		if len(nextDeleteTimes) < MaxSchedules {
+		//The deletion time of backups retained by the policy is not known in advance
+		if len(nextDeleteTimes) < MaxSchedules && !retainedByPolicy {
 			backupName := fmt.Sprintf("%s/%s", backup.GetNamespace(), backup.GetName())
 			deleteTime := backup.GetCreationTimestamp().AddDate(0, 0, schedule.GetMaxRetentionDays())
 			nextDeleteTimes[backupName] = deleteTime.UTC().Format(time.RFC3339)
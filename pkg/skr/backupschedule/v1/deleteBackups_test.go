@@ -167,6 +167,11 @@ func (s *deleteBackupsSuite) TestWhenNoMaxRetentionSet() {
 }
 
 func (s *deleteBackupsSuite) testDeleteBackup(backup1, backup2, backup client.Object, maxDays, maxReady, maxFailed int, b1Exists, b2Exists bool) {
+	s.testDeleteBackupWithPolicy(backup1, backup2, backup, maxDays, maxReady, maxFailed, nil, b1Exists, b2Exists)
+}
+
+func (s *deleteBackupsSuite) testDeleteBackupWithPolicy(backup1, backup2, backup client.Object, maxDays, maxReady, maxFailed int,
+	policy *v1beta1.RetentionPolicy, b1Exists, b2Exists bool) {
 
 	runTime := time.Now().UTC()
 
@@ -174,6 +179,7 @@ func (s *deleteBackupsSuite) testDeleteBackup(backup1, backup2, backup client.Ob
 	obj.Spec.MaxRetentionDays = maxDays
 	obj.Spec.MaxReadyBackups = maxReady
 	obj.Spec.MaxFailedBackups = maxFailed
+	obj.Spec.RetentionPolicy = policy
 	factory, err := newTestStateFactoryWithObj(obj)
 	s.Nil(err)
 
@@ -263,6 +269,24 @@ func (s *deleteBackupsSuite) TestMaxFailedBackups() {
 	s.testDeleteBackup(backup1, backup2, backup, 375, 100, 1, true, false)
 }
 
+func (s *deleteBackupsSuite) TestRetentionPolicy() {
+	backup1 := gcpBackup1.DeepCopy()
+	backup1.Status.State = v1beta1.StateReady
+	backup2 := gcpBackup2.DeepCopy()
+	backup2.Status.State = v1beta1.StateReady
+	backup := &v1beta1.GcpNfsVolumeBackup{}
+	s.testDeleteBackupWithPolicy(backup1, backup2, backup, 375, 100, 5, &v1beta1.RetentionPolicy{Daily: 1}, true, false)
+}
+
+func (s *deleteBackupsSuite) TestRetentionPolicyOverridesMaxRetention() {
+	backup1 := gcpBackup1.DeepCopy()
+	backup1.Status.State = v1beta1.StateReady
+	backup2 := gcpBackup2.DeepCopy()
+	backup2.Status.State = v1beta1.StateReady
+	backup := &v1beta1.GcpNfsVolumeBackup{}
+	s.testDeleteBackupWithPolicy(backup1, backup2, backup, 1, 1, 5, &v1beta1.RetentionPolicy{Daily: 3}, true, true)
+}
+
 func (s *deleteBackupsSuite) TestDeleteGcpBackupFailure() {
 	backup1 := gcpBackup1.DeepCopy()
 	backup := &v1beta1.GcpNfsVolumeBackup{}
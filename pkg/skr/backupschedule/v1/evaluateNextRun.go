@@ -7,6 +7,7 @@ import (
 
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -56,6 +57,10 @@ func evaluateNextRun(ctx context.Context, st composed.State) (error, context.Con
 	//If it still not time to run, reconcile with delay
 	if timeLeft := GetRemainingTimeFromNow(&nextRunTime); timeLeft > 0 {
 		logger.WithValues("BackupSchedule", schedule.GetName()).Info(fmt.Sprintf("Next Run in : %d seconds", timeLeft))
+		//Keep polling the running backup verification until the next run
+		if state.verificationRunning {
+			timeLeft = min(timeLeft, util.Timing.T60000ms())
+		}
 		return composed.StopWithRequeueDelay(timeLeft), nil
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
 	"github.com/stretchr/testify/suite"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -135,6 +136,28 @@ func (s *evaluateNextRunSuite) TestWhenNextRunTimeIsNotDueYet() {
 	s.LessOrEqual(delay, offset)
 }
 
+func (s *evaluateNextRunSuite) TestWhenNextRunTimeIsNotDueYetAndVerificationIsRunning() {
+	now := time.Now().UTC()
+	obj := gcpNfsBackupSchedule.DeepCopy()
+	obj.Spec.EndTime = &metav1.Time{Time: now.Add(5 * time.Hour)}
+	obj.Status.NextRunTimes = []string{now.Add(time.Hour).Format(time.RFC3339)}
+	factory, err := newTestStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	//Get state object with GcpNfsBackupSchedule
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.SetVerificationRunning(true)
+
+	//Invoke API under test
+	err, _ = evaluateNextRun(ctx, state)
+
+	//validate the running verification is polled before the next run
+	s.Equal(composed.StopWithRequeueDelay(util.Timing.T60000ms()), err)
+}
+
 func (s *evaluateNextRunSuite) TestWhenScheduleJustRun() {
 	now := time.Now().UTC()
 	obj := gcpNfsBackupSchedule.DeepCopy()
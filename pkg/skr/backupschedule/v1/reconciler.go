@@ -9,6 +9,8 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/types"
@@ -59,6 +61,9 @@ func (r *Reconciler) newAction() composed.Action {
 		feature.LoadFeatureContextFromObj(r.backupImpl.emptyScheduleObject()),
 		composed.LoadObj,
 		addFinalizer,
+		loadBackups,
+		backupschedule.VerifyBackups,
+		quotacheck.New(nil),
 		checkCompleted,
 		checkSuspension,
 		validateSchedule,
@@ -68,11 +73,11 @@ func (r *Reconciler) newAction() composed.Action {
 		evaluateNextRun,
 		loadScope,
 		loadSource,
-		loadBackups,
 		createBackup,
 		deleteBackups,
 		deleteCascade,
 		removeFinalizer,
+		backupschedule.RequeueWhileVerifying,
 		composed.StopAndForgetAction,
 	)
 }
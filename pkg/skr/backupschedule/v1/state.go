@@ -29,6 +29,8 @@ type State struct {
 	createRunCompleted bool
 	deleteRunCompleted bool
 
+	verificationRunning bool
+
 	backupImpl backupImpl
 	Scope      *cloudcontrolv1beta1.Scope
 	env        abstractions.Environment
@@ -77,3 +79,32 @@ func (s *State) ObjAsBackupSchedule() backupschedule.BackupSchedule {
 func (s *State) ObjAsGcpNfsBackupSchedule() *cloudresourcesv1beta1.GcpNfsBackupSchedule {
 	return s.Obj().(*cloudresourcesv1beta1.GcpNfsBackupSchedule)
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.ObjAsBackupSchedule().GetLastCreateRun() != nil && !s.ObjAsBackupSchedule().GetLastCreateRun().IsZero()
+}
+
+func (s *State) GetBackups() []client.Object {
+	return s.Backups
+}
+
+func (s *State) GetBackupVerifier() backupschedule.BackupVerifier {
+	verifier, _ := s.backupImpl.(backupschedule.BackupVerifier)
+	return verifier
+}
+
+func (s *State) Now() time.Time {
+	return time.Now().UTC()
+}
+
+func (s *State) IsVerificationRunning() bool {
+	return s.verificationRunning
+}
+
+func (s *State) SetVerificationRunning(v bool) {
+	s.verificationRunning = v
+}
@@ -163,7 +163,7 @@ type testStateFactory struct {
 	env        abstractions.Environment
 }
 
-func newTestStateFactoryWithObj(object client.Object) (*testStateFactory, error) {
+func newTestStateFactoryWithObj(object client.Object, objects ...client.Object) (*testStateFactory, error) {
 
 	kcpClient := fake.NewClientBuilder().
 		WithScheme(commonscheme.KcpScheme).
@@ -179,6 +179,8 @@ func newTestStateFactoryWithObj(object client.Object) (*testStateFactory, error)
 		WithStatusSubresource(&awsNfsVolume).
 		WithObjects(object).
 		WithStatusSubresource(object).
+		WithObjects(objects...).
+		WithStatusSubresource(objects...).
 		Build()
 	skrCluster := composed.NewStateCluster(skrClient, skrClient, nil, commonscheme.SkrScheme)
 	env := abstractions.NewMockedEnvironment(map[string]string{"GCP_SA_JSON_KEY_PATH": "test"})
@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var awsNfsBackupSchedule = cloudresourcesv1beta1.AwsNfsBackupSchedule{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "test-aws-backup-schedule",
+		Namespace: "test",
+	},
+	Spec: cloudresourcesv1beta1.AwsNfsBackupScheduleSpec{
+		NfsVolumeRef: corev1.ObjectReference{
+			Name:      awsNfsVolume.Name,
+			Namespace: awsNfsVolume.Namespace,
+		},
+		Verify: &cloudresourcesv1beta1.BackupVerification{
+			Every:   1,
+			Timeout: metav1.Duration{Duration: 6 * time.Hour},
+		},
+	},
+	Status: cloudresourcesv1beta1.AwsNfsBackupScheduleStatus{
+		NextRunTimes: []string{time.Now().Add(time.Hour).UTC().Format(time.RFC3339)},
+		BackupIndex:  1,
+		LastCreatedBackup: corev1.ObjectReference{
+			Name:      "test-aws-backup",
+			Namespace: "test",
+		},
+	},
+}
+
+var awsNfsVolumeBackup = cloudresourcesv1beta1.AwsNfsVolumeBackup{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "test-aws-backup",
+		Namespace: "test",
+		Labels: map[string]string{
+			cloudresourcesv1beta1.LabelScheduleName:      awsNfsBackupSchedule.Name,
+			cloudresourcesv1beta1.LabelScheduleNamespace: awsNfsBackupSchedule.Namespace,
+		},
+	},
+	Spec: cloudresourcesv1beta1.AwsNfsVolumeBackupSpec{
+		Source: cloudresourcesv1beta1.AwsNfsVolumeBackupSource{
+			Volume: cloudresourcesv1beta1.VolumeRef{
+				Name:      awsNfsVolume.Name,
+				Namespace: awsNfsVolume.Namespace,
+			},
+		},
+	},
+	Status: cloudresourcesv1beta1.AwsNfsVolumeBackupStatus{
+		State: cloudresourcesv1beta1.StateReady,
+	},
+}
+
+var verifyKey = types.NamespacedName{Name: "test-aws-backup-verify", Namespace: "test"}
+
+type verifyBackupsSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *verifyBackupsSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *verifyBackupsSuite) newState(schedule *cloudresourcesv1beta1.AwsNfsBackupSchedule,
+	backup *cloudresourcesv1beta1.AwsNfsVolumeBackup, objects ...client.Object) (*State, *testStateFactory) {
+	factory, err := newTestStateFactoryWithObj(schedule, append(objects, backup)...)
+	s.Nil(err)
+
+	state, err := factory.factory.NewState(s.ctx, composed.NewStateFactory(factory.skrCluster).NewState(
+		types.NamespacedName{Name: schedule.Name, Namespace: schedule.Namespace}, schedule))
+	s.Nil(err)
+	state.backupImpl = &backupImplAwsNfs{}
+	state.Backups = []client.Object{backup}
+	return state, factory
+}
+
+func (s *verifyBackupsSuite) loadBackup(factory *testStateFactory) *cloudresourcesv1beta1.AwsNfsVolumeBackup {
+	backup := &cloudresourcesv1beta1.AwsNfsVolumeBackup{}
+	err := factory.skrCluster.K8sClient().Get(s.ctx, client.ObjectKeyFromObject(&awsNfsVolumeBackup), backup)
+	s.Nil(err)
+	return backup
+}
+
+func (s *verifyBackupsSuite) TestWhenVerifyIsNotEnabled() {
+	schedule := awsNfsBackupSchedule.DeepCopy()
+	schedule.Spec.Verify = nil
+	state, factory := s.newState(schedule, awsNfsVolumeBackup.DeepCopy())
+
+	err, _ctx := backupschedule.VerifyBackups(s.ctx, state)
+	s.Nil(err)
+	s.Nil(_ctx)
+	s.Nil(s.loadBackup(factory).Status.Verification)
+}
+
+func (s *verifyBackupsSuite) TestWhenBackupIsNotDueForVerification() {
+	schedule := awsNfsBackupSchedule.DeepCopy()
+	schedule.Spec.Verify.Every = 2
+	state, factory := s.newState(schedule, awsNfsVolumeBackup.DeepCopy())
+
+	err, _ctx := backupschedule.VerifyBackups(s.ctx, state)
+	s.Nil(err)
+	s.Nil(_ctx)
+	s.Nil(s.loadBackup(factory).Status.Verification)
+}
+
+func (s *verifyBackupsSuite) TestStartVerification() {
+	state, factory := s.newState(awsNfsBackupSchedule.DeepCopy(), awsNfsVolumeBackup.DeepCopy())
+
+	err, _ctx := backupschedule.VerifyBackups(s.ctx, state)
+	s.Nil(err)
+	s.Nil(_ctx)
+	s.True(state.IsVerificationRunning())
+
+	//validate the running verification is polled at the end of the flow
+	err, _ = backupschedule.RequeueWhileVerifying(s.ctx, state)
+	s.Equal(composed.StopWithRequeueDelay(util.Timing.T60000ms()), err)
+
+	//validate the backup verification is in progress
+	backup := s.loadBackup(factory)
+	s.NotNil(backup.Status.Verification)
+	s.Equal(cloudresourcesv1beta1.BackupVerificationInProgress, backup.Status.Verification.State)
+
+	//validate the scratch volume is created
+	volume := &cloudresourcesv1beta1.AwsNfsVolume{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, verifyKey, volume)
+	s.Nil(err)
+	s.Equal(backup.Name, volume.Labels[cloudresourcesv1beta1.LabelVerifiedBackup])
+	s.Equal(awsNfsVolume.Spec.IpRange, volume.Spec.IpRange)
+}
+
+func (s *verifyBackupsSuite) TestVerificationSucceeded() {
+	backup := awsNfsVolumeBackup.DeepCopy()
+	backup.Status.Verification = &cloudresourcesv1beta1.BackupVerificationStatus{
+		State:     cloudresourcesv1beta1.BackupVerificationInProgress,
+		StartTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+	}
+	volume := awsNfsVolume.DeepCopy()
+	volume.ObjectMeta = metav1.ObjectMeta{Name: verifyKey.Name, Namespace: verifyKey.Namespace}
+	restore := &cloudresourcesv1beta1.AwsNfsVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: verifyKey.Name, Namespace: verifyKey.Namespace},
+		Status: cloudresourcesv1beta1.AwsNfsVolumeRestoreStatus{
+			State: cloudresourcesv1beta1.JobStateDone,
+		},
+	}
+	state, factory := s.newState(awsNfsBackupSchedule.DeepCopy(), backup, volume, restore)
+
+	err, _ctx := backupschedule.VerifyBackups(s.ctx, state)
+	s.Nil(err)
+	s.Nil(_ctx)
+
+	//validate the verification result
+	backup = s.loadBackup(factory)
+	s.Equal(cloudresourcesv1beta1.BackupVerificationSucceeded, backup.Status.Verification.State)
+	s.NotNil(backup.Status.Verification.CompletionTime)
+
+	//validate the scratch objects are deleted
+	err = factory.skrCluster.K8sClient().Get(s.ctx, verifyKey, &cloudresourcesv1beta1.AwsNfsVolumeRestore{})
+	s.True(apierrors.IsNotFound(err))
+	err = factory.skrCluster.K8sClient().Get(s.ctx, verifyKey, &cloudresourcesv1beta1.AwsNfsVolume{})
+	s.True(apierrors.IsNotFound(err))
+}
+
+func (s *verifyBackupsSuite) TestVerificationTimedOut() {
+	backup := awsNfsVolumeBackup.DeepCopy()
+	backup.Status.Verification = &cloudresourcesv1beta1.BackupVerificationStatus{
+		State:     cloudresourcesv1beta1.BackupVerificationInProgress,
+		StartTime: &metav1.Time{Time: time.Now().Add(-7 * time.Hour)},
+	}
+	state, factory := s.newState(awsNfsBackupSchedule.DeepCopy(), backup)
+
+	err, _ctx := backupschedule.VerifyBackups(s.ctx, state)
+	s.Nil(err)
+	s.Nil(_ctx)
+
+	//validate the verification result
+	backup = s.loadBackup(factory)
+	s.Equal(cloudresourcesv1beta1.BackupVerificationFailed, backup.Status.Verification.State)
+	s.NotEmpty(backup.Status.Verification.Message)
+}
+
+func TestVerifyBackups(t *testing.T) {
+	suite.Run(t, new(verifyBackupsSuite))
+}
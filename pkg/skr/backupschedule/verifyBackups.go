@@ -0,0 +1,268 @@
+package backupschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultVerificationTimeout = 6 * time.Hour
+
+// BackupVerifier creates the provider specific objects used to test restore a backup.
+type BackupVerifier interface {
+	EmptyScratchVolume() client.Object
+	NewScratchVolume(ctx context.Context, clnt client.Client, backup client.Object, name string) (client.Object, error)
+	IsScratchVolumeReady(volume client.Object) bool
+	EmptyRestore() composed.ObjWithConditions
+	NewRestore(backup client.Object, volume client.Object, name string) client.Object
+	GetRestoreState(restore composed.ObjWithConditions) string
+	IsBackupReady(backup client.Object) bool
+}
+
+// VerificationState is the interface the VerifyBackups action needs from the provider states.
+type VerificationState interface {
+	composed.State
+	ObjAsBackupSchedule() BackupSchedule
+	GetBackups() []client.Object
+	GetBackupVerifier() BackupVerifier
+	Now() time.Time
+	IsVerificationRunning() bool
+	SetVerificationRunning(v bool)
+}
+
+type verifiableSchedule interface {
+	GetVerify() *cloudresourcesv1beta1.BackupVerification
+}
+
+type verifiableBackup interface {
+	client.Object
+	GetVerification() *cloudresourcesv1beta1.BackupVerificationStatus
+	SetVerification(verification *cloudresourcesv1beta1.BackupVerificationStatus)
+}
+
+// IsVerificationInProgress returns true if the backup is currently being test restored.
+func IsVerificationInProgress(obj client.Object) bool {
+	backup, ok := obj.(verifiableBackup)
+	return ok && backup.GetVerification() != nil &&
+		backup.GetVerification().State == cloudresourcesv1beta1.BackupVerificationInProgress
+}
+
+// VerifyBackups advances the test restore of the last created backup. It never stops the flow
+// while waiting for the restore, so that the creation and pruning of backups run as usual;
+// the RequeueWhileVerifying action at the end of the flow polls the running verification.
+func VerifyBackups(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(VerificationState)
+	schedule := state.ObjAsBackupSchedule()
+	verifier := state.GetBackupVerifier()
+	logger := composed.LoggerFromCtx(ctx)
+
+	if verifier == nil {
+		return nil, nil
+	}
+
+	var verify *cloudresourcesv1beta1.BackupVerification
+	if x, ok := schedule.(verifiableSchedule); ok {
+		verify = x.GetVerify()
+	}
+
+	//Find the backup being verified
+	var backup verifiableBackup
+	for _, obj := range state.GetBackups() {
+		if IsVerificationInProgress(obj) {
+			backup = obj.(verifiableBackup)
+			break
+		}
+	}
+
+	//If the schedule is being deleted or verification is disabled, abandon the running verification
+	if composed.IsMarkedForDeletion(schedule) || verify == nil {
+		if backup == nil {
+			return nil, nil
+		}
+		logger.WithValues("Backup", backup.GetName()).Info("Abandoning backup verification")
+		if err := deleteVerificationObjects(ctx, state, backup); err != nil {
+			return composed.LogErrorAndReturn(err, "Error deleting backup verification objects", composed.StopWithRequeue, ctx)
+		}
+		if err := patchVerification(ctx, state, backup, nil); err != nil {
+			return composed.LogErrorAndReturn(err, "Error resetting backup verification", composed.StopWithRequeue, ctx)
+		}
+		return nil, nil
+	}
+
+	//Start the verification of the last created backup
+	if backup == nil {
+		backup = backupToVerify(state, verify)
+		if backup == nil {
+			return nil, nil
+		}
+		logger.WithValues("Backup", backup.GetName()).Info("Starting backup verification")
+		err := patchVerification(ctx, state, backup, &cloudresourcesv1beta1.BackupVerificationStatus{
+			State:     cloudresourcesv1beta1.BackupVerificationInProgress,
+			StartTime: &metav1.Time{Time: state.Now()},
+		})
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error starting backup verification", composed.StopWithRequeue, ctx)
+		}
+	}
+
+	//Check the timeout
+	timeout := verify.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultVerificationTimeout
+	}
+	if state.Now().Sub(backup.GetVerification().StartTime.Time) > timeout {
+		return completeVerification(ctx, state, backup, cloudresourcesv1beta1.BackupVerificationFailed,
+			fmt.Sprintf("Test restore did not complete within %s", timeout))
+	}
+
+	clnt := state.Cluster().K8sClient()
+	key := types.NamespacedName{Name: verificationName(backup), Namespace: backup.GetNamespace()}
+
+	//Create the scratch volume
+	volume := verifier.EmptyScratchVolume()
+	err := clnt.Get(ctx, key, volume)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading the verification volume", composed.StopWithRequeue, ctx)
+	}
+	if apierrors.IsNotFound(err) {
+		volume, err = verifier.NewScratchVolume(ctx, clnt, backup, key.Name)
+		if err != nil {
+			return completeVerification(ctx, state, backup, cloudresourcesv1beta1.BackupVerificationFailed,
+				fmt.Sprintf("Error creating the verification volume: %s", err))
+		}
+		volume.SetLabels(verificationLabels(schedule, backup))
+		logger.WithValues("Volume", key.Name).Info("Creating verification volume")
+		if err := clnt.Create(ctx, volume); err != nil {
+			return composed.LogErrorAndReturn(err, "Error creating the verification volume", composed.StopWithRequeue, ctx)
+		}
+		return verificationRequeue(state)
+	}
+	if !verifier.IsScratchVolumeReady(volume) {
+		return verificationRequeue(state)
+	}
+
+	//Restore the backup into the scratch volume
+	restore := verifier.EmptyRestore()
+	err = clnt.Get(ctx, key, restore)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading the verification restore", composed.StopWithRequeue, ctx)
+	}
+	if apierrors.IsNotFound(err) {
+		obj := verifier.NewRestore(backup, volume, key.Name)
+		obj.SetLabels(verificationLabels(schedule, backup))
+		logger.WithValues("Restore", key.Name).Info("Creating verification restore")
+		if err := clnt.Create(ctx, obj); err != nil {
+			return composed.LogErrorAndReturn(err, "Error creating the verification restore", composed.StopWithRequeue, ctx)
+		}
+		return verificationRequeue(state)
+	}
+
+	switch verifier.GetRestoreState(restore) {
+	case cloudresourcesv1beta1.JobStateDone:
+		return completeVerification(ctx, state, backup, cloudresourcesv1beta1.BackupVerificationSucceeded, "")
+	case cloudresourcesv1beta1.JobStateFailed, cloudresourcesv1beta1.JobStateError:
+		msg := "Test restore failed"
+		if cond := meta.FindStatusCondition(*restore.Conditions(), cloudresourcesv1beta1.ConditionTypeError); cond != nil {
+			msg = fmt.Sprintf("%s: %s", msg, cond.Message)
+		}
+		return completeVerification(ctx, state, backup, cloudresourcesv1beta1.BackupVerificationFailed, msg)
+	}
+
+	return verificationRequeue(state)
+}
+
+func backupToVerify(state VerificationState, verify *cloudresourcesv1beta1.BackupVerification) verifiableBackup {
+	schedule := state.ObjAsBackupSchedule()
+	every := max(verify.Every, 1)
+	if schedule.GetBackupIndex()%every != 0 {
+		return nil
+	}
+
+	for _, obj := range state.GetBackups() {
+		if obj.GetName() != schedule.GetLastCreatedBackup().Name {
+			continue
+		}
+		backup, ok := obj.(verifiableBackup)
+		if !ok || backup.GetVerification() != nil || !state.GetBackupVerifier().IsBackupReady(backup) {
+			return nil
+		}
+		return backup
+	}
+	return nil
+}
+
+func completeVerification(ctx context.Context, state VerificationState, backup verifiableBackup, result, message string) (error, context.Context) {
+	logger := composed.LoggerFromCtx(ctx)
+
+	if err := deleteVerificationObjects(ctx, state, backup); err != nil {
+		return composed.LogErrorAndReturn(err, "Error deleting backup verification objects", composed.StopWithRequeue, ctx)
+	}
+
+	verification := backup.GetVerification().DeepCopy()
+	verification.State = result
+	verification.CompletionTime = &metav1.Time{Time: state.Now()}
+	verification.Message = message
+	if err := patchVerification(ctx, state, backup, verification); err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating backup verification", composed.StopWithRequeue, ctx)
+	}
+
+	logger.WithValues("Backup", backup.GetName()).Info(fmt.Sprintf("Backup verification completed: %s", result))
+	return nil, nil
+}
+
+func deleteVerificationObjects(ctx context.Context, state VerificationState, backup verifiableBackup) error {
+	verifier := state.GetBackupVerifier()
+	clnt := state.Cluster().K8sClient()
+
+	for _, obj := range []client.Object{verifier.EmptyRestore(), verifier.EmptyScratchVolume()} {
+		obj.SetName(verificationName(backup))
+		obj.SetNamespace(backup.GetNamespace())
+		if err := clnt.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patchVerification(ctx context.Context, state VerificationState, backup verifiableBackup,
+	verification *cloudresourcesv1beta1.BackupVerificationStatus) error {
+	original := backup.DeepCopyObject().(client.Object)
+	backup.SetVerification(verification)
+	return state.Cluster().K8sClient().Status().Patch(ctx, backup, client.MergeFrom(original))
+}
+
+// verificationRequeue marks the verification as running and continues the flow.
+func verificationRequeue(state VerificationState) (error, context.Context) {
+	state.SetVerificationRunning(true)
+	return nil, nil
+}
+
+// RequeueWhileVerifying polls the running verification once the rest of the flow is done.
+func RequeueWhileVerifying(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(VerificationState)
+	if !state.IsVerificationRunning() {
+		return nil, nil
+	}
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+}
+
+func verificationName(backup client.Object) string {
+	return fmt.Sprintf("%s-verify", backup.GetName())
+}
+
+func verificationLabels(schedule BackupSchedule, backup client.Object) map[string]string {
+	return map[string]string{
+		cloudresourcesv1beta1.LabelScheduleName:      schedule.GetName(),
+		cloudresourcesv1beta1.LabelScheduleNamespace: schedule.GetNamespace(),
+		cloudresourcesv1beta1.LabelVerifiedBackup:    backup.GetName(),
+	}
+}
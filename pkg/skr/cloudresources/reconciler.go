@@ -74,6 +74,7 @@ func (r *reconciler) newAction() composed.Action {
 		),
 		handleServed,
 		addFinalizer,
+		statusQuotas,
 		statusReady,
 
 		composed.StopAndForgetAction,
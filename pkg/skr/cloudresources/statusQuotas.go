@@ -0,0 +1,141 @@
+package cloudresources
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"github.com/kyma-project/cloud-manager/pkg/skr/alicloudnfsvolume"
+	"github.com/kyma-project/cloud-manager/pkg/skr/alicloudrediscluster"
+	"github.com/kyma-project/cloud-manager/pkg/skr/alicloudredisinstance"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolume"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsrediscluster"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsredisinstance"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azuremanagedredis"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurenfsvolume"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurerediscluster"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azureredisinstance"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
+	"github.com/kyma-project/cloud-manager/pkg/skr/gcpnfsvolume"
+	"github.com/kyma-project/cloud-manager/pkg/skr/gcprediscluster"
+	"github.com/kyma-project/cloud-manager/pkg/skr/gcpredisinstance"
+	"github.com/kyma-project/cloud-manager/pkg/skr/sapnfsvolume"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// quotaUsageFuncs are the capacity usage functions of the kinds having capacity quotas,
+// the same ones their reconcilers give to the quota check
+var quotaUsageFuncs = map[string]quotacheck.UsageFunc{
+	"AwsNfsVolume":          awsnfsvolume.QuotaUsage,
+	"AzureNfsVolume":        azurenfsvolume.QuotaUsage,
+	"GcpNfsVolume":          gcpnfsvolume.QuotaUsage,
+	"SapNfsVolume":          sapnfsvolume.QuotaUsage,
+	"AlicloudNfsVolume":     alicloudnfsvolume.QuotaUsage,
+	"AwsRedisInstance":      awsredisinstance.QuotaUsage,
+	"AwsRedisCluster":       awsrediscluster.QuotaUsage,
+	"AzureRedisInstance":    azureredisinstance.QuotaUsage,
+	"AzureRedisCluster":     azurerediscluster.QuotaUsage,
+	"AzureManagedRedis":     azuremanagedredis.QuotaUsage,
+	"GcpRedisInstance":      gcpredisinstance.QuotaUsage,
+	"GcpRedisCluster":       gcprediscluster.QuotaUsage,
+	"AlicloudRedisInstance": alicloudredisinstance.QuotaUsage,
+	"AlicloudRedisCluster":  alicloudrediscluster.QuotaUsage,
+}
+
+var quotaNames = []string{
+	quota.QuotaTotalCount,
+	quota.QuotaTotalCapacityGb,
+	quota.QuotaTotalMemoryGb,
+}
+
+// statusQuotas publishes the effective quotas of this SKR with their current usage in
+// the CloudResources status, for all the installed kinds that have any quota configured.
+func statusQuotas(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if composed.MarkedForDeletionPredicate(ctx, state) {
+		return nil, ctx
+	}
+
+	scheme := state.Cluster().Scheme()
+	subject := quota.SubjectFromCtx(ctx, state.KymaRef.Name)
+	var quotas []cloudresourcesv1beta1.QuotaUsage
+
+	for gvk := range scheme.AllKnownTypes() {
+		if gvk.Group != cloudresourcesv1beta1.GroupVersion.Group {
+			continue
+		}
+		if gvk.Kind == "CloudResources" || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		o, err := scheme.New(gvk)
+		if err != nil {
+			continue
+		}
+		obj, ok := o.(client.Object)
+		if !ok {
+			continue
+		}
+
+		limits := map[string]int{}
+		for _, name := range quotaNames {
+			limit := quota.SkrQuota.LimitForSubject(obj, scheme, subject, name)
+			if limit != util.MaxInt {
+				limits[name] = limit
+			}
+		}
+		if len(limits) == 0 {
+			continue
+		}
+
+		items, err := quotacheck.ListKind(ctx, state.Cluster().ApiReader(), scheme, obj)
+		if meta.IsNoMatchError(err) {
+			// this CRD is not installed
+			continue
+		}
+		if err != nil {
+			logger.
+				WithValues("gvk", gvk.String()).
+				Error(err, "Error listing GVK for quota usage")
+			continue
+		}
+
+		usage := quotacheck.TotalUsage(items, quotaUsageFuncs[gvk.Kind])
+		for name, limit := range limits {
+			quotas = append(quotas, cloudresourcesv1beta1.QuotaUsage{
+				Kind:  gvk.Kind,
+				Name:  name,
+				Limit: limit,
+				Used:  usage[name],
+			})
+		}
+	}
+
+	sort.Slice(quotas, func(i, j int) bool {
+		if quotas[i].Kind == quotas[j].Kind {
+			return quotas[i].Name < quotas[j].Name
+		}
+		return quotas[i].Kind < quotas[j].Kind
+	})
+
+	if reflect.DeepEqual(quotas, state.ObjAsCloudResources().Status.Quotas) ||
+		(len(quotas) == 0 && len(state.ObjAsCloudResources().Status.Quotas) == 0) {
+		return nil, ctx
+	}
+
+	state.ObjAsCloudResources().Status.Quotas = quotas
+
+	return composed.UpdateStatus(state.ObjAsCloudResources()).
+		ErrorLogMessage("Error updating CloudResources CR with quota usage").
+		SuccessLogMsg(fmt.Sprintf("Updated CloudResources CR with quota usage of %d quotas", len(quotas))).
+		SuccessErrorNil().
+		Run(ctx, state)
+}
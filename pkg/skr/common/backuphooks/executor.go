@@ -0,0 +1,83 @@
+package backuphooks
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/streaming/pkg/httpstream"
+)
+
+// Executor executes a command in a container of a pod, the same way as `kubectl exec`,
+// and returns the command's stderr output.
+type Executor interface {
+	Exec(ctx context.Context, pod *corev1.Pod, container string, command []string) (string, error)
+}
+
+// NewExecutor returns an Executor that executes the commands through the API server
+// of the cluster with the given rest config.
+func NewExecutor(cfg *rest.Config) Executor {
+	return &executor{cfg: cfg}
+}
+
+type executor struct {
+	cfg *rest.Config
+
+	clientsetOnce sync.Once
+	clientset     kubernetes.Interface
+	clientsetErr  error
+}
+
+func (e *executor) getClientset() (kubernetes.Interface, error) {
+	e.clientsetOnce.Do(func() {
+		e.clientset, e.clientsetErr = kubernetes.NewForConfig(e.cfg)
+	})
+	return e.clientset, e.clientsetErr
+}
+
+func (e *executor) Exec(ctx context.Context, pod *corev1.Pod, container string, command []string) (string, error) {
+	clientset, err := e.getClientset()
+	if err != nil {
+		return "", err
+	}
+
+	req := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	// same as kubectl exec, websocket is tried first, and SPDY is used if the API server can not upgrade to it
+	wsExec, err := remotecommand.NewWebSocketExecutor(e.cfg, "GET", req.URL().String())
+	if err != nil {
+		return "", err
+	}
+	spdyExec, err := remotecommand.NewSPDYExecutor(e.cfg, "POST", req.URL())
+	if err != nil {
+		return "", err
+	}
+	exec, err := remotecommand.NewFallbackExecutor(wsExec, spdyExec, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	return stderr.String(), err
+}
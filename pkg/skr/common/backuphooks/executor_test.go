@@ -0,0 +1,63 @@
+package backuphooks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+type execRequest struct {
+	method string
+	path   string
+	query  url.Values
+}
+
+func TestExecutorFallsBackToSpdyWhenWebsocketUpgradeFails(t *testing.T) {
+	var mu sync.Mutex
+	var requests []execRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, execRequest{method: r.Method, path: r.URL.Path, query: r.URL.Query()})
+		mu.Unlock()
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	executor := NewExecutor(&rest.Config{Host: server.URL})
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "app"}}
+
+	_, err := executor.Exec(context.Background(), pod, "db", []string{"fsfreeze", "-f", "/data"})
+	assert.Error(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if assert.Len(t, requests, 2) {
+		assert.Equal(t, http.MethodGet, requests[0].method, "websocket is tried first")
+		assert.Equal(t, http.MethodPost, requests[1].method, "SPDY is the fallback")
+		for _, req := range requests {
+			assert.Equal(t, "/api/v1/namespaces/ns/pods/app/exec", req.path)
+			assert.Equal(t, "db", req.query.Get("container"))
+			assert.Equal(t, []string{"fsfreeze", "-f", "/data"}, req.query["command"])
+			assert.Equal(t, "true", req.query.Get("stdout"))
+			assert.Equal(t, "true", req.query.Get("stderr"))
+		}
+	}
+}
+
+func TestExecutorReusesClientset(t *testing.T) {
+	e := NewExecutor(&rest.Config{Host: "https://localhost:6443"}).(*executor)
+
+	first, err := e.getClientset()
+	assert.NoError(t, err)
+	second, err := e.getClientset()
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+}
@@ -0,0 +1,59 @@
+package backuphooks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// New returns a composed.Action that executes the pre and post backup hooks of the reconciled
+// backup object in the pods selected by the hooks pod selector, and records their outcome in
+// the backup status.
+// The pre hook is executed once before the cloud backup is triggered, and the flow continues
+// so the backup is triggered in the same reconciliation. The post hook is executed once after
+// the backup is triggered, and also when the pre hook or the backup failed, or the backup is
+// deleted before it was triggered, so the workload quiesced by the pre hook is always resumed.
+// Flows that can stop before reaching this action, for example on a failed backup, must also
+// run the action returned by NewResume.
+// A hook failing in any of the pods with the Fail policy marks the backup as Failed.
+// The provided state MUST implement the State interface, and it should run just before
+// the cloud backup is triggered.
+func New(executor Executor) composed.Action {
+	return withState(runHooks(executor))
+}
+
+// NewResume returns a composed.Action that executes the post backup hook of the reconciled
+// backup object, if the pre hook was executed and the backup was triggered, failed or is
+// being deleted. It should run before the actions that stop the flow of a completed or
+// failed backup, so the workload quiesced by the pre hook is resumed even if the flow never
+// reaches the action returned by New.
+func NewResume(executor Executor) composed.Action {
+	return withState(resumeHooks(executor))
+}
+
+func withState(action composed.Action) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, ok := st.(State)
+		if !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("state %T provided to backuphooks flow does not implement backuphooks.State", st),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+
+		_, ok = state.Obj().(Object)
+		if !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("object %T provided to backuphooks flow does not implement backuphooks.Object", state.Obj()),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+
+		return action(ctx, state)
+	}
+}
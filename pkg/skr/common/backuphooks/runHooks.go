@@ -0,0 +1,267 @@
+package backuphooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultHookTimeout = 30 * time.Second
+	maxMessageLength   = 256
+)
+
+func runHooks(executor Executor) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state := st.(State)
+		logger := composed.LoggerFromCtx(ctx)
+		obj := state.Obj().(Object)
+
+		hooks := obj.GetHooks()
+		if hooks == nil {
+			return nil, ctx
+		}
+
+		status := obj.GetHooksStatus()
+		if status == nil {
+			status = &cloudresourcesv1beta1.BackupHooksStatus{}
+		}
+		preDone := hooks.Pre == nil || status.Pre != nil
+		postDone := hooks.Post == nil || status.Post != nil
+		deleting := composed.IsMarkedForDeletion(obj)
+
+		if !preDone {
+			// The pre hook is only executed before the backup is triggered,
+			// and if it was not executed, there is nothing to resume.
+			if deleting || state.IsBackupTriggered() {
+				return nil, ctx
+			}
+
+			logger.Info("Executing backup pre hook")
+			status.Pre = execHook(ctx, state, executor, hooks, hooks.Pre)
+			if status.Pre.State == cloudresourcesv1beta1.BackupHookFailed && isFailurePolicyFail(hooks.Pre) {
+				if hooks.Post != nil {
+					logger.Info("Executing backup post hook after the pre hook failed")
+					status.Post = execHook(ctx, state, executor, hooks, hooks.Post)
+				}
+				return markFailed(ctx, state, status, fmt.Sprintf("Backup pre hook failed: %s", status.Pre.Message))
+			}
+
+			// continue, so the backup is triggered right after the pre hook
+			obj.SetHooksStatus(status)
+			return composed.PatchStatus(obj).
+				ErrorLogMessage("Error updating backup status with the pre hook outcome").
+				SuccessErrorNil().
+				Run(ctx, state)
+		}
+
+		if postDone || !shouldRunPostHook(state) {
+			return nil, ctx
+		}
+
+		return runPostHook(ctx, state, executor, status)
+	}
+}
+
+// resumeHooks executes the post hook if the pre hook was executed, and the backup was
+// triggered, failed or is being deleted, so the workload is resumed also when the flow
+// is stopped before reaching runHooks.
+func resumeHooks(executor Executor) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state := st.(State)
+		obj := state.Obj().(Object)
+
+		hooks := obj.GetHooks()
+		if hooks == nil || hooks.Post == nil {
+			return nil, ctx
+		}
+
+		status := obj.GetHooksStatus()
+		if status == nil {
+			status = &cloudresourcesv1beta1.BackupHooksStatus{}
+		}
+		if hooks.Pre != nil && status.Pre == nil {
+			// the pre hook was not executed, and there is nothing to resume
+			return nil, ctx
+		}
+		if status.Post != nil || !shouldRunPostHook(state) {
+			return nil, ctx
+		}
+
+		return runPostHook(ctx, state, executor, status)
+	}
+}
+
+// shouldRunPostHook returns true once the backup is triggered, failed, or is being deleted.
+func shouldRunPostHook(state State) bool {
+	obj := state.Obj().(Object)
+	switch {
+	case composed.IsMarkedForDeletion(obj),
+		state.IsBackupTriggered(),
+		obj.State() == cloudresourcesv1beta1.StateError,
+		obj.State() == cloudresourcesv1beta1.StateFailed:
+		return true
+	}
+	return false
+}
+
+func runPostHook(ctx context.Context, state State, executor Executor, status *cloudresourcesv1beta1.BackupHooksStatus) (error, context.Context) {
+	obj := state.Obj().(Object)
+	hooks := obj.GetHooks()
+
+	composed.LoggerFromCtx(ctx).Info("Executing backup post hook")
+	status.Post = execHook(ctx, state, executor, hooks, hooks.Post)
+	if !composed.IsMarkedForDeletion(obj) && status.Post.State == cloudresourcesv1beta1.BackupHookFailed && isFailurePolicyFail(hooks.Post) {
+		return markFailed(ctx, state, status, fmt.Sprintf("Backup post hook failed: %s", status.Post.Message))
+	}
+
+	obj.SetHooksStatus(status)
+	return composed.PatchStatus(obj).
+		ErrorLogMessage("Error updating backup status with the post hook outcome").
+		SuccessErrorNil().
+		Run(ctx, state)
+}
+
+func markFailed(ctx context.Context, state State, status *cloudresourcesv1beta1.BackupHooksStatus, message string) (error, context.Context) {
+	obj := state.Obj().(Object)
+	obj.SetHooksStatus(status)
+	obj.SetState(cloudresourcesv1beta1.StateFailed)
+	return composed.PatchStatus(obj).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ReasonBackupHookFailed,
+			Message: message,
+		}).
+		ErrorLogMessage("Error updating backup status with the failed hook outcome").
+		SuccessLogMsg("Backup marked as Failed due to the failed backup hook").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
+
+// execHook executes the hook command in all selected pods and returns the outcome.
+func execHook(ctx context.Context, state State, executor Executor,
+	hooks *cloudresourcesv1beta1.BackupHooks, hook *cloudresourcesv1beta1.BackupHook) *cloudresourcesv1beta1.BackupHookStatus {
+	result := &cloudresourcesv1beta1.BackupHookStatus{
+		State: cloudresourcesv1beta1.BackupHookSucceeded,
+	}
+	defer func() {
+		result.CompletionTime = &metav1.Time{Time: time.Now()}
+	}()
+
+	pods, err := selectPods(ctx, state, hooks)
+	if err != nil {
+		result.State = cloudresourcesv1beta1.BackupHookFailed
+		result.Message = truncate(fmt.Sprintf("Error listing pods: %s", err))
+		return result
+	}
+	if len(pods) == 0 {
+		result.State = cloudresourcesv1beta1.BackupHookFailed
+		result.Message = fmt.Sprintf("No running pods with the %s=true label match the pod selector", cloudresourcesv1beta1.LabelBackupHooks)
+		return result
+	}
+
+	timeout := hook.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultHookTimeout
+	}
+
+	var failed []string
+	for _, pod := range pods {
+		container := hooks.Container
+		if container == "" {
+			container = pod.Spec.Containers[0].Name
+		}
+
+		execCtx, cancel := context.WithTimeout(ctx, timeout)
+		stderr, err := executor.Exec(execCtx, &pod, container, hook.Command)
+		cancel()
+
+		podStatus := cloudresourcesv1beta1.BackupHookPodStatus{
+			Name:  pod.Name,
+			State: cloudresourcesv1beta1.BackupHookSucceeded,
+		}
+		if err != nil {
+			message := err.Error()
+			if errors.Is(err, context.DeadlineExceeded) {
+				message = fmt.Sprintf("command did not complete within %s", timeout)
+			}
+			if stderr = strings.TrimSpace(stderr); stderr != "" {
+				message = fmt.Sprintf("%s: %s", message, tail(stderr))
+			}
+			podStatus.State = cloudresourcesv1beta1.BackupHookFailed
+			podStatus.Message = truncate(message)
+			failed = append(failed, pod.Name)
+		}
+		result.Pods = append(result.Pods, podStatus)
+	}
+
+	if len(failed) > 0 {
+		result.State = cloudresourcesv1beta1.BackupHookFailed
+		result.Message = truncate(fmt.Sprintf("Command failed in pods: %s", strings.Join(failed, ", ")))
+	}
+	return result
+}
+
+// selectPods lists the running pods matching the hooks pod selector in the namespace of the backup.
+// The pods are read directly from the API server, so the SKR pods are not cached.
+// Only the pods opted in with the LabelBackupHooks label are selected, since the backup creator
+// is not required to have the pods/exec permission, and must not be able to execute arbitrary
+// commands in pods whose owners did not allow it.
+func selectPods(ctx context.Context, state State, hooks *cloudresourcesv1beta1.BackupHooks) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&hooks.PodSelector)
+	if err != nil {
+		return nil, err
+	}
+	optIn, err := labels.NewRequirement(cloudresourcesv1beta1.LabelBackupHooks, selection.Equals, []string{"true"})
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*optIn)
+
+	list := &corev1.PodList{}
+	err = state.Cluster().ApiReader().List(ctx, list,
+		client.InNamespace(state.Obj().GetNamespace()),
+		client.MatchingLabelsSelector{Selector: selector},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []corev1.Pod
+	for _, pod := range list.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil || len(pod.Spec.Containers) == 0 {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	return pods, nil
+}
+
+func isFailurePolicyFail(hook *cloudresourcesv1beta1.BackupHook) bool {
+	return hook.FailurePolicy != cloudresourcesv1beta1.BackupHookFailurePolicyIgnore
+}
+
+func tail(s string) string {
+	if len(s) <= maxMessageLength {
+		return s
+	}
+	return s[len(s)-maxMessageLength:]
+}
+
+func truncate(s string) string {
+	if len(s) <= maxMessageLength {
+		return s
+	}
+	return s[:maxMessageLength]
+}
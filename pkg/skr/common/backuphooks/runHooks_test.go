@@ -0,0 +1,321 @@
+package backuphooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testState struct {
+	composed.State
+	triggered bool
+}
+
+func (s *testState) IsBackupTriggered() bool {
+	return s.triggered
+}
+
+type execCall struct {
+	pod       string
+	container string
+	command   []string
+}
+
+type fakeExecutor struct {
+	calls  []execCall
+	errors map[string]error
+}
+
+func (e *fakeExecutor) Exec(_ context.Context, pod *corev1.Pod, container string, command []string) (string, error) {
+	e.calls = append(e.calls, execCall{pod: pod.Name, container: container, command: command})
+	if err, ok := e.errors[pod.Name]; ok {
+		return "permission denied", err
+	}
+	return "", nil
+}
+
+func newPod(name string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":                                  "db",
+				cloudresourcesv1beta1.LabelBackupHooks: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "postgres"}, {Name: "sidecar"}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func newBackup(failurePolicy string) *cloudresourcesv1beta1.GcpNfsVolumeBackup {
+	return &cloudresourcesv1beta1.GcpNfsVolumeBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "default",
+		},
+		Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
+			Hooks: &cloudresourcesv1beta1.BackupHooks{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				Pre: &cloudresourcesv1beta1.BackupHook{
+					Command:       []string{"fsfreeze", "--freeze", "/data"},
+					Timeout:       metav1.Duration{Duration: time.Minute},
+					FailurePolicy: failurePolicy,
+				},
+				Post: &cloudresourcesv1beta1.BackupHook{
+					Command:       []string{"fsfreeze", "--unfreeze", "/data"},
+					Timeout:       metav1.Duration{Duration: time.Minute},
+					FailurePolicy: failurePolicy,
+				},
+			},
+		},
+	}
+}
+
+func runBackupHooks(t *testing.T, executor Executor, triggered bool, backup *cloudresourcesv1beta1.GcpNfsVolumeBackup, objects ...client.Object) (error, *cloudresourcesv1beta1.GcpNfsVolumeBackup) {
+	return runAction(t, New(executor), triggered, backup, objects...)
+}
+
+func runResumeHooks(t *testing.T, executor Executor, triggered bool, backup *cloudresourcesv1beta1.GcpNfsVolumeBackup, objects ...client.Object) (error, *cloudresourcesv1beta1.GcpNfsVolumeBackup) {
+	return runAction(t, NewResume(executor), triggered, backup, objects...)
+}
+
+func runAction(t *testing.T, action composed.Action, triggered bool, backup *cloudresourcesv1beta1.GcpNfsVolumeBackup, objects ...client.Object) (error, *cloudresourcesv1beta1.GcpNfsVolumeBackup) {
+	all := append([]client.Object{backup}, objects...)
+	skrClient := fake.NewClientBuilder().
+		WithScheme(commonscheme.SkrScheme).
+		WithObjects(all...).
+		WithStatusSubresource(backup).
+		Build()
+	cluster := composed.NewStateCluster(skrClient, skrClient, nil, commonscheme.SkrScheme)
+	state := &testState{
+		State:     composed.NewStateFactory(cluster).NewState(client.ObjectKeyFromObject(backup), backup),
+		triggered: triggered,
+	}
+
+	err, _ := action(context.Background(), state)
+
+	loaded := &cloudresourcesv1beta1.GcpNfsVolumeBackup{}
+	assert.NoError(t, skrClient.Get(context.Background(), client.ObjectKeyFromObject(backup), loaded))
+	return err, loaded
+}
+
+func TestRunHooks(t *testing.T) {
+
+	t.Run("without hooks nothing is executed", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		backup := newBackup("")
+		backup.Spec.Hooks = nil
+		err, loaded := runBackupHooks(t, executor, false, backup, newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Empty(t, executor.calls)
+		assert.Nil(t, loaded.Status.Hooks)
+	})
+
+	t.Run("pre hook is executed in running pods before the backup is triggered", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		err, loaded := runBackupHooks(t, executor, false, newBackup(""),
+			newPod("db-0", corev1.PodRunning),
+			newPod("db-1", corev1.PodRunning),
+			newPod("db-2", corev1.PodPending),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []execCall{
+			{pod: "db-0", container: "postgres", command: []string{"fsfreeze", "--freeze", "/data"}},
+			{pod: "db-1", container: "postgres", command: []string{"fsfreeze", "--freeze", "/data"}},
+		}, executor.calls)
+		if assert.NotNil(t, loaded.Status.Hooks) && assert.NotNil(t, loaded.Status.Hooks.Pre) {
+			assert.Equal(t, cloudresourcesv1beta1.BackupHookSucceeded, loaded.Status.Hooks.Pre.State)
+			assert.Len(t, loaded.Status.Hooks.Pre.Pods, 2)
+			assert.NotNil(t, loaded.Status.Hooks.Pre.CompletionTime)
+			assert.Nil(t, loaded.Status.Hooks.Post)
+		}
+	})
+
+	t.Run("post hook is executed after the backup is triggered", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		backup := newBackup("")
+		backup.Spec.Hooks.Container = "sidecar"
+		backup.Status.Hooks = &cloudresourcesv1beta1.BackupHooksStatus{
+			Pre: &cloudresourcesv1beta1.BackupHookStatus{State: cloudresourcesv1beta1.BackupHookSucceeded},
+		}
+		err, loaded := runBackupHooks(t, executor, true, backup, newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Equal(t, []execCall{
+			{pod: "db-0", container: "sidecar", command: []string{"fsfreeze", "--unfreeze", "/data"}},
+		}, executor.calls)
+		if assert.NotNil(t, loaded.Status.Hooks.Post) {
+			assert.Equal(t, cloudresourcesv1beta1.BackupHookSucceeded, loaded.Status.Hooks.Post.State)
+		}
+	})
+
+	t.Run("post hook waits for the backup to be triggered", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		backup := newBackup("")
+		backup.Status.Hooks = &cloudresourcesv1beta1.BackupHooksStatus{
+			Pre: &cloudresourcesv1beta1.BackupHookStatus{State: cloudresourcesv1beta1.BackupHookSucceeded},
+		}
+		err, loaded := runBackupHooks(t, executor, false, backup, newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Empty(t, executor.calls)
+		assert.Nil(t, loaded.Status.Hooks.Post)
+	})
+
+	t.Run("failed pre hook marks the backup failed and resumes the workload", func(t *testing.T) {
+		executor := &fakeExecutor{errors: map[string]error{"db-1": errors.New("command terminated with non-zero exit code: 1")}}
+		err, loaded := runBackupHooks(t, executor, false, newBackup(cloudresourcesv1beta1.BackupHookFailurePolicyFail),
+			newPod("db-0", corev1.PodRunning),
+			newPod("db-1", corev1.PodRunning),
+		)
+		assert.Equal(t, composed.StopAndForget, err)
+		assert.Len(t, executor.calls, 4)
+		assert.Equal(t, string(cloudresourcesv1beta1.GcpNfsBackupFailed), loaded.State())
+		cond := meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+		if assert.NotNil(t, cond) {
+			assert.Equal(t, cloudresourcesv1beta1.ReasonBackupHookFailed, cond.Reason)
+			assert.Equal(t, "Backup pre hook failed: Command failed in pods: db-1", cond.Message)
+		}
+		assert.Equal(t, cloudresourcesv1beta1.BackupHookFailed, loaded.Status.Hooks.Pre.State)
+		assert.Equal(t, "command terminated with non-zero exit code: 1: permission denied", loaded.Status.Hooks.Pre.Pods[1].Message)
+		assert.NotNil(t, loaded.Status.Hooks.Post)
+	})
+
+	t.Run("failed pre hook with ignore policy continues", func(t *testing.T) {
+		executor := &fakeExecutor{errors: map[string]error{"db-0": errors.New("command terminated with non-zero exit code: 1")}}
+		err, loaded := runBackupHooks(t, executor, false, newBackup(cloudresourcesv1beta1.BackupHookFailurePolicyIgnore),
+			newPod("db-0", corev1.PodRunning),
+		)
+		assert.NoError(t, err)
+		assert.Len(t, executor.calls, 1)
+		assert.Equal(t, cloudresourcesv1beta1.BackupHookFailed, loaded.Status.Hooks.Pre.State)
+		assert.Empty(t, loaded.State())
+	})
+
+	t.Run("no matching pods fails the pre hook", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		err, loaded := runBackupHooks(t, executor, false, newBackup(""), newPod("db-0", corev1.PodSucceeded))
+		assert.Equal(t, composed.StopAndForget, err)
+		assert.Empty(t, executor.calls)
+		assert.Equal(t, "No running pods with the cloud-resources.kyma-project.io/backupHooks=true label match the pod selector", loaded.Status.Hooks.Pre.Message)
+		assert.Equal(t, string(cloudresourcesv1beta1.GcpNfsBackupFailed), loaded.State())
+	})
+
+	t.Run("pods not opted in to backup hooks are rejected", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		notOptedIn := newPod("db-1", corev1.PodRunning)
+		delete(notOptedIn.Labels, cloudresourcesv1beta1.LabelBackupHooks)
+		optedOut := newPod("db-2", corev1.PodRunning)
+		optedOut.Labels[cloudresourcesv1beta1.LabelBackupHooks] = "false"
+		err, loaded := runBackupHooks(t, executor, false, newBackup(""), notOptedIn, optedOut)
+		assert.Equal(t, composed.StopAndForget, err)
+		assert.Empty(t, executor.calls)
+		assert.Equal(t, cloudresourcesv1beta1.BackupHookFailed, loaded.Status.Hooks.Pre.State)
+		assert.Equal(t, string(cloudresourcesv1beta1.GcpNfsBackupFailed), loaded.State())
+	})
+
+	t.Run("only pods opted in to backup hooks are selected", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		notOptedIn := newPod("db-1", corev1.PodRunning)
+		delete(notOptedIn.Labels, cloudresourcesv1beta1.LabelBackupHooks)
+		err, _ := runBackupHooks(t, executor, false, newBackup(""), newPod("db-0", corev1.PodRunning), notOptedIn)
+		assert.NoError(t, err)
+		assert.Equal(t, []execCall{
+			{pod: "db-0", container: "postgres", command: []string{"fsfreeze", "--freeze", "/data"}},
+		}, executor.calls)
+	})
+
+	t.Run("post hook is executed when the backup failed before it was triggered", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		backup := newBackup("")
+		backup.Status.State = cloudresourcesv1beta1.GcpNfsBackupError
+		backup.Status.Hooks = &cloudresourcesv1beta1.BackupHooksStatus{
+			Pre: &cloudresourcesv1beta1.BackupHookStatus{State: cloudresourcesv1beta1.BackupHookSucceeded},
+		}
+		err, loaded := runBackupHooks(t, executor, false, backup, newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Len(t, executor.calls, 1)
+		assert.NotNil(t, loaded.Status.Hooks.Post)
+	})
+}
+
+func TestResumeHooks(t *testing.T) {
+
+	preSucceeded := func(backup *cloudresourcesv1beta1.GcpNfsVolumeBackup) *cloudresourcesv1beta1.GcpNfsVolumeBackup {
+		backup.Status.Hooks = &cloudresourcesv1beta1.BackupHooksStatus{
+			Pre: &cloudresourcesv1beta1.BackupHookStatus{State: cloudresourcesv1beta1.BackupHookSucceeded},
+		}
+		return backup
+	}
+
+	t.Run("post hook is executed when the backup is failed", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		backup := preSucceeded(newBackup(""))
+		backup.Status.State = cloudresourcesv1beta1.GcpNfsBackupFailed
+		err, loaded := runResumeHooks(t, executor, false, backup, newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Equal(t, []execCall{
+			{pod: "db-0", container: "postgres", command: []string{"fsfreeze", "--unfreeze", "/data"}},
+		}, executor.calls)
+		if assert.NotNil(t, loaded.Status.Hooks.Post) {
+			assert.Equal(t, cloudresourcesv1beta1.BackupHookSucceeded, loaded.Status.Hooks.Post.State)
+		}
+	})
+
+	t.Run("post hook is executed once the backup is triggered", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		err, loaded := runResumeHooks(t, executor, true, preSucceeded(newBackup("")), newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Len(t, executor.calls, 1)
+		assert.NotNil(t, loaded.Status.Hooks.Post)
+	})
+
+	t.Run("post hook is not executed before the backup is triggered", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		err, loaded := runResumeHooks(t, executor, false, preSucceeded(newBackup("")), newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Empty(t, executor.calls)
+		assert.Nil(t, loaded.Status.Hooks.Post)
+	})
+
+	t.Run("post hook is not executed if the pre hook was not executed", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		backup := newBackup("")
+		backup.Status.State = cloudresourcesv1beta1.GcpNfsBackupFailed
+		err, loaded := runResumeHooks(t, executor, true, backup, newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Empty(t, executor.calls)
+		assert.Nil(t, loaded.Status.Hooks)
+	})
+
+	t.Run("post hook is not executed again", func(t *testing.T) {
+		executor := &fakeExecutor{}
+		backup := preSucceeded(newBackup(""))
+		backup.Status.State = cloudresourcesv1beta1.GcpNfsBackupFailed
+		backup.Status.Hooks.Post = &cloudresourcesv1beta1.BackupHookStatus{State: cloudresourcesv1beta1.BackupHookSucceeded}
+		err, _ := runResumeHooks(t, executor, true, backup, newPod("db-0", corev1.PodRunning))
+		assert.NoError(t, err)
+		assert.Empty(t, executor.calls)
+	})
+
+	t.Run("failed post hook marks the backup failed", func(t *testing.T) {
+		executor := &fakeExecutor{errors: map[string]error{"db-0": errors.New("command terminated with non-zero exit code: 1")}}
+		err, loaded := runResumeHooks(t, executor, true, preSucceeded(newBackup("")), newPod("db-0", corev1.PodRunning))
+		assert.Equal(t, composed.StopAndForget, err)
+		assert.Equal(t, string(cloudresourcesv1beta1.GcpNfsBackupFailed), loaded.State())
+		assert.Equal(t, cloudresourcesv1beta1.BackupHookFailed, loaded.Status.Hooks.Post.State)
+	})
+}
@@ -0,0 +1,22 @@
+package backuphooks
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+type State interface {
+	composed.State
+	// IsBackupTriggered returns true if the cloud backup of the reconciled object was already
+	// requested from the cloud provider. The pre hook is executed before, and the post hook
+	// after the backup is triggered.
+	IsBackupTriggered() bool
+}
+
+// Object is the backup object the hooks are executed for.
+type Object interface {
+	composed.ObjWithConditionsAndState
+	GetHooks() *cloudresourcesv1beta1.BackupHooks
+	GetHooksStatus() *cloudresourcesv1beta1.BackupHooksStatus
+	SetHooksStatus(v *cloudresourcesv1beta1.BackupHooksStatus)
+}
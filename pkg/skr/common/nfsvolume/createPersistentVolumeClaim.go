@@ -1,9 +1,9 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
-	"github.com/kyma-project/cloud-manager/api"
 
+	"github.com/kyma-project/cloud-manager/api"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	corev1 "k8s.io/api/core/v1"
@@ -11,38 +11,38 @@ import (
 	"k8s.io/utils/ptr"
 )
 
-func createPersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func CreatePersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 	logger := composed.LoggerFromCtx(ctx)
 
 	if composed.MarkedForDeletionPredicate(ctx, state) {
 		return nil, nil
 	}
-	if state.PVC != nil {
-		logger.Info("PersistentVolumeClaim for AwsNfsVolume already exists")
+	if state.GetPVC() != nil {
+		logger.Info("PersistentVolumeClaim for NfsVolume already exists")
 		return nil, nil
 	}
 
-	if state.Volume == nil {
+	if state.GetVolume() == nil {
 		return composed.StopWithRequeueDelay(2 * util.Timing.T100ms()), nil
 	}
 
 	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:   state.Obj().GetNamespace(),
-			Name:        getVolumeClaimName(state.ObjAsAwsNfsVolume()),
-			Labels:      getVolumeClaimLabels(state.ObjAsAwsNfsVolume()),
-			Annotations: getVolumeClaimAnnotations(state.ObjAsAwsNfsVolume()),
+			Name:        state.GetVolumeClaimName(),
+			Labels:      state.GetVolumeClaimLabels(),
+			Annotations: state.GetVolumeClaimAnnotations(),
 			Finalizers: []string{
 				api.CommonFinalizerDeletionHook,
 			},
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
-			VolumeName:  state.Volume.GetName(), // connection to PV
+			VolumeName:  state.GetVolume().GetName(), // connection to PV
 			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
 			Resources: corev1.VolumeResourceRequirements{
 				Requests: corev1.ResourceList{
-					"storage": state.ObjAsAwsNfsVolume().Spec.Capacity,
+					"storage": state.GetCapacity(),
 				},
 			},
 			StorageClassName: new(""),
@@ -54,7 +54,7 @@ func createPersistentVolumeClaim(ctx context.Context, st composed.State) (error,
 		return composed.LogErrorAndReturn(err, "Error creating PVC for PV", composed.StopWithRequeue, ctx)
 	}
 
-	logger.Info("PVC for AWS PV created")
+	logger.Info("PVC for NfsVolume PV created")
 
 	return nil, nil
 }
@@ -1,9 +1,9 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
-	"github.com/kyma-project/cloud-manager/api"
 
+	"github.com/kyma-project/cloud-manager/api"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	corev1 "k8s.io/api/core/v1"
@@ -11,19 +11,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func createVolume(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func CreateVolume(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 	logger := composed.LoggerFromCtx(ctx)
 
 	if composed.MarkedForDeletionPredicate(ctx, state) {
 		return nil, nil
 	}
-	if state.Volume != nil {
-		logger.Info("PersistentVolume for AwsNfsVolume already exists")
+	if state.GetVolume() != nil {
+		logger.Info("PersistentVolume for NfsVolume already exists")
 		return nil, nil
 	}
 
-	kcpCondReady := meta.FindStatusCondition(state.KcpNfsInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
+	kcpCondReady := meta.FindStatusCondition(state.GetKcpNfsInstance().Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
 	if kcpCondReady == nil {
 		// not yet ready, PV will be created only once KCP NfsInstance is ready
 		return nil, nil
@@ -32,21 +32,21 @@ func createVolume(ctx context.Context, st composed.State) (error, context.Contex
 	pv := &corev1.PersistentVolume{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:   state.Obj().GetNamespace(),
-			Name:        getVolumeName(state.ObjAsAwsNfsVolume()),
-			Labels:      getVolumeLabels(state.ObjAsAwsNfsVolume()),
-			Annotations: getVolumeAnnotations(state.ObjAsAwsNfsVolume()),
+			Name:        state.GetVolumeName(),
+			Labels:      state.GetVolumeLabels(),
+			Annotations: state.GetVolumeAnnotations(),
 			Finalizers: []string{
 				api.CommonFinalizerDeletionHook,
 			},
 		},
 		Spec: corev1.PersistentVolumeSpec{
 			Capacity: corev1.ResourceList{
-				"storage": state.ObjAsAwsNfsVolume().Spec.Capacity,
+				"storage": state.GetCapacity(),
 			},
 			PersistentVolumeSource: corev1.PersistentVolumeSource{
 				NFS: &corev1.NFSVolumeSource{
-					Server:   state.ObjAsAwsNfsVolume().Status.Server,
-					Path:     "/",
+					Server:   state.GetNfsServer(),
+					Path:     state.GetNfsPath(),
 					ReadOnly: false,
 				},
 			},
@@ -55,10 +55,10 @@ func createVolume(ctx context.Context, st composed.State) (error, context.Contex
 	}
 	err := state.Cluster().K8sClient().Create(ctx, pv)
 	if err != nil {
-		return composed.LogErrorAndReturn(err, "Error creating PV for AwsNfsVolume", composed.StopWithRequeue, ctx)
+		return composed.LogErrorAndReturn(err, "Error creating PV for NfsVolume", composed.StopWithRequeue, ctx)
 	}
 
-	logger.Info("PV for AwsNfsVolume created")
+	logger.Info("PV for NfsVolume created")
 
 	return nil, nil
 }
@@ -1,37 +1,39 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
 	"fmt"
+
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func deletePVC(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func DeletePVC(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 	logger := composed.LoggerFromCtx(ctx)
 
 	if !composed.MarkedForDeletionPredicate(ctx, st) {
 		return nil, nil
 	}
 
-	if state.PVC == nil {
+	pvc := state.GetPVC()
+	if pvc == nil {
 		return nil, nil
 	}
 
-	if !state.PVC.DeletionTimestamp.IsZero() {
+	if !pvc.DeletionTimestamp.IsZero() {
 		return nil, nil
 	}
 
-	err, _ := composed.UpdateStatus(state.ObjAsAwsNfsVolume()).
+	err, _ := composed.UpdateStatus(state.ObjAsObjWithConditionsAndState()).
 		SetCondition(metav1.Condition{
 			Type:    cloudresourcesv1beta1.ConditionTypeDeleting,
 			Status:  metav1.ConditionTrue,
 			Reason:  cloudresourcesv1beta1.ConditionReasonDeletingPVC,
-			Message: fmt.Sprintf("Deleting PersistentVolumeClaim %s", state.PVC.Name),
+			Message: fmt.Sprintf("Deleting PersistentVolumeClaim %s", pvc.Name),
 		}).
-		ErrorLogMessage("Error setting ConditionReasonDeletingPVC condition on AwsNfsVolume").
+		ErrorLogMessage("Error setting ConditionReasonDeletingPVC condition on NfsVolume").
 		SuccessErrorNil().
 		FailedError(composed.StopWithRequeue).
 		Run(ctx, state)
@@ -39,11 +41,11 @@ func deletePVC(ctx context.Context, st composed.State) (error, context.Context)
 		return err, nil
 	}
 
-	logger.Info("Deleting PVC for AwsNfsVolume")
+	logger.Info("Deleting PVC for NfsVolume")
 
-	err = state.Cluster().K8sClient().Delete(ctx, state.PVC)
+	err = state.Cluster().K8sClient().Delete(ctx, pvc)
 	if err != nil {
-		return composed.LogErrorAndReturn(err, "Error deleting PVC for AwsNfsVolume", composed.StopWithRequeue, ctx)
+		return composed.LogErrorAndReturn(err, "Error deleting PVC for NfsVolume", composed.StopWithRequeue, ctx)
 	}
 
 	return composed.StopWithRequeue, nil
@@ -1,38 +1,40 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
 	"fmt"
+
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func deletePv(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func DeletePv(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 	logger := composed.LoggerFromCtx(ctx)
 
 	if !composed.MarkedForDeletionPredicate(ctx, st) {
 		return nil, nil
 	}
 
-	if state.Volume == nil {
+	volume := state.GetVolume()
+	if volume == nil {
 		return nil, nil
 	}
 
-	if !state.Volume.DeletionTimestamp.IsZero() {
+	if !volume.DeletionTimestamp.IsZero() {
 		return nil, nil
 	}
 
-	state.ObjAsAwsNfsVolume().Status.State = cloudresourcesv1beta1.StateDeleting
-	err, _ := composed.UpdateStatus(state.ObjAsAwsNfsVolume()).
+	state.ObjAsObjWithConditionsAndState().SetState(cloudresourcesv1beta1.StateDeleting)
+	err, _ := composed.UpdateStatus(state.ObjAsObjWithConditionsAndState()).
 		SetCondition(metav1.Condition{
 			Type:    cloudresourcesv1beta1.ConditionTypeDeleting,
 			Status:  metav1.ConditionTrue,
 			Reason:  cloudresourcesv1beta1.ConditionReasonDeletingPV,
-			Message: fmt.Sprintf("Deleting PersistentVolume %s", state.Volume.Name),
+			Message: fmt.Sprintf("Deleting PersistentVolume %s", volume.Name),
 		}).
-		ErrorLogMessage("Error setting ConditionReasonDeletingPV condition on AwsNfsVolume").
+		ErrorLogMessage("Error setting ConditionReasonDeletingPV condition on NfsVolume").
 		SuccessErrorNil().
 		FailedError(composed.StopWithRequeue).
 		Run(ctx, state)
@@ -40,11 +42,11 @@ func deletePv(ctx context.Context, st composed.State) (error, context.Context) {
 		return err, nil
 	}
 
-	logger.Info("Deleting PV for AwsNfsVolume")
+	logger.Info("Deleting PV for NfsVolume")
 
-	err = state.Cluster().K8sClient().Delete(ctx, state.Volume)
+	err = state.Cluster().K8sClient().Delete(ctx, volume)
 	if err != nil {
-		return composed.LogErrorAndReturn(err, "Error deleting PV for AwsNfsVolume", composed.StopWithRequeue, ctx)
+		return composed.LogErrorAndReturn(err, "Error deleting PV for NfsVolume", composed.StopWithRequeue, ctx)
 	}
 
 	return composed.StopWithRequeue, nil
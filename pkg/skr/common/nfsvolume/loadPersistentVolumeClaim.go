@@ -1,27 +1,28 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
+
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func loadPersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func LoadPersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 
 	pvc := &corev1.PersistentVolumeClaim{}
 	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{
 		Namespace: state.Obj().GetNamespace(),
-		Name:      getVolumeClaimName(state.ObjAsAwsNfsVolume()),
+		Name:      state.GetVolumeClaimName(),
 	}, pvc)
 	if client.IgnoreNotFound(err) != nil {
-		return composed.LogErrorAndReturn(err, "Error getting PersistentVolumeClaim by getVolumeClaimName()", composed.StopWithRequeue, ctx)
+		return composed.LogErrorAndReturn(err, "Error getting PersistentVolumeClaim by GetVolumeClaimName()", composed.StopWithRequeue, ctx)
 	}
 
 	if err == nil {
-		state.PVC = pvc
+		state.SetPVC(pvc)
 	}
 
 	return nil, nil
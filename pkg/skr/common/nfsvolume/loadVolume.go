@@ -1,7 +1,8 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
+
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -9,26 +10,26 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func loadVolume(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func LoadVolume(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 
 	vol := &corev1.PersistentVolume{}
 	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{
 		Namespace: state.Obj().GetNamespace(),
-		Name:      getVolumeName(state.ObjAsAwsNfsVolume()),
+		Name:      state.GetVolumeName(),
 	}, vol)
 	if client.IgnoreNotFound(err) != nil {
-		return composed.LogErrorAndReturn(err, "Error getting PersistentVolume by getVolumeName()", composed.StopWithRequeue, ctx)
+		return composed.LogErrorAndReturn(err, "Error getting PersistentVolume by GetVolumeName()", composed.StopWithRequeue, ctx)
 	}
 
 	if apierrors.IsNotFound(err) {
-		// first PVs were created with name = AwsNfsVolume.status.id
-		// next, a feature was added in AwsNfsVolume to specify PV name
-		// this is a fallback to old behavior where PV.name = AwsNfsVolume.status.id
+		// first PVs were created with name = NfsVolume.status.id
+		// next, a feature was added in NfsVolume to specify PV name
+		// this is a fallback to old behavior where PV.name = NfsVolume.status.id
 		// to remain compatibility with already created PVs
 		err = state.Cluster().K8sClient().Get(ctx, types.NamespacedName{
 			Namespace: state.Obj().GetNamespace(),
-			Name:      state.ObjAsAwsNfsVolume().Status.Id,
+			Name:      state.GetVolumeId(),
 		}, vol)
 		if client.IgnoreNotFound(err) != nil {
 			return composed.LogErrorAndReturn(err, "Error getting PersistentVolume by status.id", composed.StopWithRequeue, ctx)
@@ -36,7 +37,7 @@ func loadVolume(ctx context.Context, st composed.State) (error, context.Context)
 	}
 
 	if err == nil {
-		state.Volume = vol
+		state.SetVolume(vol)
 	}
 
 	return nil, nil
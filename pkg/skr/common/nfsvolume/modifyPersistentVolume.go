@@ -0,0 +1,55 @@
+package nfsvolume
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// ModifyPersistentVolume updates the capacity and labels of the PV once the NfsVolume is resized or relabeled.
+func ModifyPersistentVolume(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, nil
+	}
+
+	if !meta.IsStatusConditionTrue(*state.ObjAsObjWithConditionsAndState().Conditions(), cloudresourcesv1beta1.ConditionTypeReady) {
+		return nil, nil
+	}
+
+	volume := state.GetVolume()
+	if volume == nil {
+		return nil, nil
+	}
+
+	changed := false
+	capacity := state.GetCapacity()
+	if !capacity.Equal(volume.Spec.Capacity["storage"]) {
+		changed = true
+		volume.Spec.Capacity["storage"] = capacity
+		logger.Info("Detected modified PV capacity")
+	}
+
+	expectedLabels := state.GetVolumeLabels()
+	if !areLabelsEqual(volume.Labels, expectedLabels) {
+		changed = true
+		volume.Labels = expectedLabels
+		logger.Info("Detected modified PV labels")
+	}
+
+	if !changed {
+		return nil, nil
+	}
+
+	err := state.Cluster().K8sClient().Update(ctx, volume)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating PersistentVolume for NfsVolume", composed.StopWithRequeue, ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,45 @@
+package nfsvolume
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// ModifyPersistentVolumeClaim updates the labels of the PVC once the NfsVolume is resized or relabeled.
+func ModifyPersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, nil
+	}
+
+	if !meta.IsStatusConditionTrue(*state.ObjAsObjWithConditionsAndState().Conditions(), cloudresourcesv1beta1.ConditionTypeReady) {
+		return nil, nil
+	}
+
+	pvc := state.GetPVC()
+	if pvc == nil {
+		return nil, nil
+	}
+
+	expectedLabels := state.GetVolumeClaimLabels()
+	if areLabelsEqual(pvc.Labels, expectedLabels) {
+		return nil, nil
+	}
+
+	// storage request of a statically bound PVC can not be expanded, the capacity label tracks the resize
+	pvc.Labels = expectedLabels
+	logger.Info("Detected modified PVC labels")
+
+	err := state.Cluster().K8sClient().Update(ctx, pvc)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating PersistentVolumeClaim for NfsVolume", composed.StopWithRequeue, ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,35 @@
+package nfsvolume
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/api"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// RemovePersistentVolumeClaimFinalizer removes finalizer from PVC when its parent NfsVolume is marked for deletion
+func RemovePersistentVolumeClaimFinalizer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	pvc := state.GetPVC()
+
+	if !composed.IsMarkedForDeletion(pvc) {
+		return nil, nil
+	}
+
+	if pvc == nil {
+		return nil, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(pvc, api.CommonFinalizerDeletionHook) {
+		return nil, nil
+	}
+
+	controllerutil.RemoveFinalizer(pvc, api.CommonFinalizerDeletionHook)
+	err := state.Cluster().K8sClient().Update(ctx, pvc)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error saving SKR PersistentVolumeClaim after finalizer removal", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,35 @@
+package nfsvolume
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/api"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// RemovePersistentVolumeFinalizer removes finalizer from PV when its parent NfsVolume is marked for deletion
+func RemovePersistentVolumeFinalizer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	volume := state.GetVolume()
+
+	if !composed.IsMarkedForDeletion(volume) {
+		return nil, nil
+	}
+
+	if volume == nil {
+		return nil, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(volume, api.CommonFinalizerDeletionHook) {
+		return nil, nil
+	}
+
+	controllerutil.RemoveFinalizer(volume, api.CommonFinalizerDeletionHook)
+	err := state.Cluster().K8sClient().Update(ctx, volume)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error saving SKR PersistentVolume after finalizer removal", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, nil
+}
@@ -1,4 +1,4 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
@@ -8,32 +8,33 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-// If PV is in status.Phase RELEASED, spec.claimRef has to be removed
+// SanitizeReleasedVolume removes spec.claimRef from the PV in status.Phase RELEASED
 // so the PV can transfer to status.Phase AVAILABLE
 // and become ready to be attached to PVC
-func sanitizeReleasedVolume(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func SanitizeReleasedVolume(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 	logger := composed.LoggerFromCtx(ctx)
 
 	if composed.MarkedForDeletionPredicate(ctx, state) {
 		return nil, nil
 	}
 
-	if state.Volume == nil {
-		logger.Info("PersistentVolume for AwsNfsVolume not present.")
+	volume := state.GetVolume()
+	if volume == nil {
+		logger.Info("PersistentVolume for NfsVolume not present.")
 		return nil, nil
 	}
 
-	if state.Volume.Status.Phase != corev1.VolumeReleased {
+	if volume.Status.Phase != corev1.VolumeReleased {
 		return nil, nil
 	}
 
-	if state.Volume.Spec.ClaimRef == nil {
+	if volume.Spec.ClaimRef == nil {
 		return nil, nil
 	}
 
-	state.Volume.Spec.ClaimRef = nil
-	err := state.Cluster().K8sClient().Update(ctx, state.Volume)
+	volume.Spec.ClaimRef = nil
+	err := state.Cluster().K8sClient().Update(ctx, volume)
 
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error removing claimRef from PV", composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
@@ -1,4 +1,4 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"testing"
@@ -6,12 +6,10 @@ import (
 
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
-	"github.com/kyma-project/cloud-manager/pkg/composed"
 	spy "github.com/kyma-project/cloud-manager/pkg/testinfra/clientspy"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -22,16 +20,9 @@ func TestSanitizeReleasedVolume(t *testing.T) {
 
 		var awsNfsVolume *cloudresourcesv1beta1.AwsNfsVolume
 		var pv *corev1.PersistentVolume
-		var state *State
+		var state *testState
 		var k8sClient client.WithWatch
 
-		createEmptyAwsNfsVolumeState := func(k8sClient client.WithWatch, awsNfsVolume *cloudresourcesv1beta1.AwsNfsVolume) *State {
-			cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
-			return &State{
-				State: composed.NewStateFactory(cluster).NewState(types.NamespacedName{}, awsNfsVolume),
-			}
-		}
-
 		setupTest := func() {
 			awsNfsVolume = &cloudresourcesv1beta1.AwsNfsVolume{}
 
@@ -54,8 +45,8 @@ func TestSanitizeReleasedVolume(t *testing.T) {
 
 			k8sClient = spy.NewClientSpy(fakeClient)
 
-			state = createEmptyAwsNfsVolumeState(k8sClient, awsNfsVolume)
-			state.Volume = pv
+			state = newTestState(k8sClient, awsNfsVolume)
+			state.volume = pv
 		}
 
 		t.Run("Should: sanitize released PV and remove PVC ref", func(t *testing.T) {
@@ -63,7 +54,7 @@ func TestSanitizeReleasedVolume(t *testing.T) {
 			ctx := t.Context()
 			assert.NotNilf(t, pv.Spec.ClaimRef, "Claim ref not nil")
 
-			err, _ := sanitizeReleasedVolume(ctx, state)
+			err, _ := SanitizeReleasedVolume(ctx, state)
 
 			assert.Nilf(t, pv.Spec.ClaimRef, "Claim ref is nil")
 			assert.NotNil(t, err, "should return non-nilnil err") // not an actual err, but requeue
@@ -79,7 +70,7 @@ func TestSanitizeReleasedVolume(t *testing.T) {
 				},
 			}
 
-			err, res := sanitizeReleasedVolume(ctx, state)
+			err, res := SanitizeReleasedVolume(ctx, state)
 
 			assert.Nil(t, res, "should return nil result")
 			assert.Nil(t, err, "should return nil err")
@@ -89,33 +80,33 @@ func TestSanitizeReleasedVolume(t *testing.T) {
 		t.Run("Should: do nothing if Volume is notdefined in state", func(t *testing.T) {
 			setupTest()
 			ctx := t.Context()
-			state.Volume = nil
+			state.volume = nil
 
-			err, res := sanitizeReleasedVolume(ctx, state)
+			err, res := SanitizeReleasedVolume(ctx, state)
 
 			assert.Nil(t, res, "should return nil result")
 			assert.Nil(t, err, "should return nil err")
 			assert.EqualValues(t, 0, k8sClient.(spy.ClientSpy).UpdateCallCount(), "update should not be called")
 		})
 
-		t.Run("Should: do nothing if state.Volume.status is not in released phase", func(t *testing.T) {
+		t.Run("Should: do nothing if state.volume.status is not in released phase", func(t *testing.T) {
 			setupTest()
 			ctx := t.Context()
-			state.Volume.Status.Phase = corev1.VolumeBound
+			state.volume.Status.Phase = corev1.VolumeBound
 
-			err, res := sanitizeReleasedVolume(ctx, state)
+			err, res := SanitizeReleasedVolume(ctx, state)
 
 			assert.Nil(t, res, "should return nil result")
 			assert.Nil(t, err, "should return nil err")
 			assert.EqualValues(t, 0, k8sClient.(spy.ClientSpy).UpdateCallCount(), "update should not be called")
 		})
 
-		t.Run("Should: do nothing if state.Volume has no defined ClaimRef", func(t *testing.T) {
+		t.Run("Should: do nothing if state.volume has no defined ClaimRef", func(t *testing.T) {
 			setupTest()
 			ctx := t.Context()
-			state.Volume.Spec.ClaimRef = nil
+			state.volume.Spec.ClaimRef = nil
 
-			err, res := sanitizeReleasedVolume(ctx, state)
+			err, res := SanitizeReleasedVolume(ctx, state)
 
 			assert.Nil(t, res, "should return nil result")
 			assert.Nil(t, err, "should return nil err")
@@ -0,0 +1,49 @@
+package nfsvolume
+
+import (
+	"reflect"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// State is implemented by the states of the SKR NFS volume kinds that expose the KCP NfsInstance
+// to the workloads through a statically provisioned PersistentVolume and PersistentVolumeClaim.
+type State interface {
+	composed.State
+	ObjAsObjWithConditionsAndState() composed.ObjWithConditionsAndState
+	GetKcpNfsInstance() *cloudcontrolv1beta1.NfsInstance
+
+	GetVolume() *corev1.PersistentVolume
+	SetVolume(volume *corev1.PersistentVolume)
+	GetPVC() *corev1.PersistentVolumeClaim
+	SetPVC(pvc *corev1.PersistentVolumeClaim)
+
+	// GetVolumeId returns status.id, the name of the PersistentVolumes created before the name became configurable
+	GetVolumeId() string
+	GetVolumeName() string
+	GetVolumeLabels() map[string]string
+	GetVolumeAnnotations() map[string]string
+	GetVolumeClaimName() string
+	GetVolumeClaimLabels() map[string]string
+	GetVolumeClaimAnnotations() map[string]string
+	GetCapacity() resource.Quantity
+	GetNfsServer() string
+	GetNfsPath() string
+}
+
+func areLabelsEqual(first, second map[string]string) bool {
+	x := first
+	y := second
+
+	if x == nil {
+		x = map[string]string{}
+	}
+	if y == nil {
+		y = map[string]string{}
+	}
+
+	return reflect.DeepEqual(x, y)
+}
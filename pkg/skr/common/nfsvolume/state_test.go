@@ -0,0 +1,61 @@
+package nfsvolume
+
+import (
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// testState implements State over an AwsNfsVolume, naming the PV after status.id
+// and the PVC after the volume, as the provider states do by default.
+type testState struct {
+	composed.State
+	volume *corev1.PersistentVolume
+	pvc    *corev1.PersistentVolumeClaim
+}
+
+var _ State = &testState{}
+
+func newTestState(k8sClient client.WithWatch, nfsVolume *cloudresourcesv1beta1.AwsNfsVolume) *testState {
+	cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
+	return &testState{
+		State: composed.NewStateFactory(cluster).NewState(types.NamespacedName{}, nfsVolume),
+	}
+}
+
+func testOwnerLabels(nfsVolume *cloudresourcesv1beta1.AwsNfsVolume) map[string]string {
+	return map[string]string{
+		cloudresourcesv1beta1.LabelNfsVolName:   nfsVolume.Name,
+		cloudresourcesv1beta1.LabelNfsVolNS:     nfsVolume.Namespace,
+		cloudresourcesv1beta1.LabelCloudManaged: "true",
+	}
+}
+
+func (s *testState) obj() *cloudresourcesv1beta1.AwsNfsVolume {
+	return s.Obj().(*cloudresourcesv1beta1.AwsNfsVolume)
+}
+
+func (s *testState) ObjAsObjWithConditionsAndState() composed.ObjWithConditionsAndState {
+	return s.obj()
+}
+
+func (s *testState) GetKcpNfsInstance() *cloudcontrolv1beta1.NfsInstance { return nil }
+
+func (s *testState) GetVolume() *corev1.PersistentVolume          { return s.volume }
+func (s *testState) SetVolume(volume *corev1.PersistentVolume)    { s.volume = volume }
+func (s *testState) GetPVC() *corev1.PersistentVolumeClaim        { return s.pvc }
+func (s *testState) SetPVC(pvc *corev1.PersistentVolumeClaim)     { s.pvc = pvc }
+func (s *testState) GetVolumeId() string                          { return s.obj().Status.Id }
+func (s *testState) GetVolumeName() string                        { return s.obj().Status.Id }
+func (s *testState) GetVolumeLabels() map[string]string           { return testOwnerLabels(s.obj()) }
+func (s *testState) GetVolumeAnnotations() map[string]string      { return nil }
+func (s *testState) GetVolumeClaimName() string                   { return s.obj().Name }
+func (s *testState) GetVolumeClaimLabels() map[string]string      { return testOwnerLabels(s.obj()) }
+func (s *testState) GetVolumeClaimAnnotations() map[string]string { return nil }
+func (s *testState) GetCapacity() resource.Quantity               { return s.obj().Spec.Capacity }
+func (s *testState) GetNfsServer() string                         { return s.obj().Status.Server }
+func (s *testState) GetNfsPath() string                           { return "/" }
@@ -1,4 +1,4 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
@@ -12,15 +12,16 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
-func validatePersistentVolume(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
-	nfsVolume := state.ObjAsAwsNfsVolume()
+// ValidatePersistentVolume validates that if a PV with expected name exists, it belongs to current NfsVolume.
+func ValidatePersistentVolume(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	nfsVolume := state.ObjAsObjWithConditionsAndState()
 
 	if composed.MarkedForDeletionPredicate(ctx, st) {
 		return nil, nil
 	}
 
-	pvName := getVolumeName(nfsVolume)
+	pvName := state.GetVolumeName()
 	pv := &corev1.PersistentVolume{}
 	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{Name: pvName}, pv)
 
@@ -34,11 +35,11 @@ func validatePersistentVolume(ctx context.Context, st composed.State) (error, co
 
 	parentName, parentNameExists := pv.Labels[cloudresourcesv1beta1.LabelNfsVolName]
 	parentNamespace, parentNamespaceExists := pv.Labels[cloudresourcesv1beta1.LabelNfsVolNS]
-	if parentNameExists && parentNamespaceExists && parentName == nfsVolume.Name && parentNamespace == nfsVolume.Namespace {
+	if parentNameExists && parentNamespaceExists && parentName == nfsVolume.GetName() && parentNamespace == nfsVolume.GetNamespace() {
 		return nil, nil
 	}
 
-	nfsVolume.Status.State = cloudresourcesv1beta1.StateError
+	nfsVolume.SetState(cloudresourcesv1beta1.StateError)
 	errorMsg := fmt.Sprintf("Desired PV(%s) already exists with different owner", pvName)
 
 	return composed.UpdateStatus(nfsVolume).
@@ -1,4 +1,4 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
@@ -12,18 +12,18 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
-// Validate that if a PVC with expected name exists, it belongs to current AWSNfsVolume.
-func validatePersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
-	nfsVolume := state.ObjAsAwsNfsVolume()
+// ValidatePersistentVolumeClaim validates that if a PVC with expected name exists, it belongs to current NfsVolume.
+func ValidatePersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	nfsVolume := state.ObjAsObjWithConditionsAndState()
 
 	if composed.MarkedForDeletionPredicate(ctx, st) {
 		return nil, nil
 	}
 
-	pvcName := getVolumeClaimName(nfsVolume)
+	pvcName := state.GetVolumeClaimName()
 	pvc := &corev1.PersistentVolumeClaim{}
-	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{Name: pvcName, Namespace: nfsVolume.Namespace}, pvc)
+	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{Name: pvcName, Namespace: nfsVolume.GetNamespace()}, pvc)
 
 	if apierrors.IsNotFound(err) {
 		return nil, nil
@@ -35,11 +35,11 @@ func validatePersistentVolumeClaim(ctx context.Context, st composed.State) (erro
 
 	parentName, nameLabelExists := pvc.Labels[cloudresourcesv1beta1.LabelNfsVolName]
 	parentNamespace, namespaceLabelExists := pvc.Labels[cloudresourcesv1beta1.LabelNfsVolNS]
-	if nameLabelExists && namespaceLabelExists && parentName == nfsVolume.Name && parentNamespace == nfsVolume.Namespace {
+	if nameLabelExists && namespaceLabelExists && parentName == nfsVolume.GetName() && parentNamespace == nfsVolume.GetNamespace() {
 		return nil, nil
 	}
 
-	nfsVolume.Status.State = cloudresourcesv1beta1.StateError
+	nfsVolume.SetState(cloudresourcesv1beta1.StateError)
 	errorMsg := fmt.Sprintf("Desired PVC(%s/%s) already exists with different owner", pvc.Namespace, pvc.Name)
 
 	return composed.UpdateStatus(nfsVolume).
@@ -1,4 +1,4 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"testing"
@@ -8,12 +8,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
-	"github.com/kyma-project/cloud-manager/pkg/composed"
 	spy "github.com/kyma-project/cloud-manager/pkg/testinfra/clientspy"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -24,16 +22,9 @@ func TestValidatePersistentVolumeClaim(t *testing.T) {
 
 		var awsNfsVolume *cloudresourcesv1beta1.AwsNfsVolume
 		var pvc *corev1.PersistentVolumeClaim
-		var state *State
+		var state *testState
 		var k8sClient client.WithWatch
 
-		createEmptyAwsNfsVolumeState := func(k8sClient client.WithWatch, awsNfsVolume *cloudresourcesv1beta1.AwsNfsVolume) *State {
-			cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
-			return &State{
-				State: composed.NewStateFactory(cluster).NewState(types.NamespacedName{}, awsNfsVolume),
-			}
-		}
-
 		setupTest := func() {
 			awsNfsVolume = &cloudresourcesv1beta1.AwsNfsVolume{
 				ObjectMeta: metav1.ObjectMeta{
@@ -46,7 +37,7 @@ func TestValidatePersistentVolumeClaim(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-awsnfsvol",
 					Namespace: "test-ns",
-					Labels:    getVolumeClaimLabels(awsNfsVolume),
+					Labels:    testOwnerLabels(awsNfsVolume),
 				},
 			}
 
@@ -58,8 +49,8 @@ func TestValidatePersistentVolumeClaim(t *testing.T) {
 
 			k8sClient = spy.NewClientSpy(fakeClient)
 
-			state = createEmptyAwsNfsVolumeState(k8sClient, awsNfsVolume)
-			state.PVC = &corev1.PersistentVolumeClaim{}
+			state = newTestState(k8sClient, awsNfsVolume)
+			state.pvc = &corev1.PersistentVolumeClaim{}
 		}
 
 		t.Run("Should: do nothing if AwsNfsVolume is marked for deletion", func(t *testing.T) {
@@ -69,7 +60,7 @@ func TestValidatePersistentVolumeClaim(t *testing.T) {
 				Time: time.Now(),
 			}
 
-			err, res := validatePersistentVolumeClaim(ctx, state)
+			err, res := ValidatePersistentVolumeClaim(ctx, state)
 
 			assert.Nil(t, res, "should return nil res")
 			assert.Nil(t, err, "should return nil err")
@@ -83,7 +74,7 @@ func TestValidatePersistentVolumeClaim(t *testing.T) {
 				Build()
 			k8sClient.(spy.ClientSpy).SetClient(fakeClient)
 
-			err, res := validatePersistentVolumeClaim(ctx, state)
+			err, res := ValidatePersistentVolumeClaim(ctx, state)
 
 			assert.Nil(t, res, "should return nil res")
 			assert.Nil(t, err, "should return nil err")
@@ -93,7 +84,7 @@ func TestValidatePersistentVolumeClaim(t *testing.T) {
 			setupTest()
 			ctx := t.Context()
 
-			err, res := validatePersistentVolumeClaim(ctx, state)
+			err, res := ValidatePersistentVolumeClaim(ctx, state)
 
 			assert.Nil(t, res, "should return nil res")
 			assert.Nil(t, err, "should return nil err")
@@ -110,7 +101,7 @@ func TestValidatePersistentVolumeClaim(t *testing.T) {
 				Build()
 			k8sClient.(spy.ClientSpy).SetClient(fakeClient)
 
-			err, _ := validatePersistentVolumeClaim(ctx, state)
+			err, _ := ValidatePersistentVolumeClaim(ctx, state)
 
 			assert.NotNilf(t, err, "error should be returned")
 			errorConditions := meta.FindStatusCondition(awsNfsVolume.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
@@ -1,4 +1,4 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"testing"
@@ -8,12 +8,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
-	"github.com/kyma-project/cloud-manager/pkg/composed"
 	spy "github.com/kyma-project/cloud-manager/pkg/testinfra/clientspy"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -24,16 +22,9 @@ func TestValidatePersistentVolume(t *testing.T) {
 
 		var awsNfsVolume *cloudresourcesv1beta1.AwsNfsVolume
 		var pv *corev1.PersistentVolume
-		var state *State
+		var state *testState
 		var k8sClient client.WithWatch
 
-		createEmptyAwsNfsVolumeState := func(k8sClient client.WithWatch, awsNfsVolume *cloudresourcesv1beta1.AwsNfsVolume) *State {
-			cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
-			return &State{
-				State: composed.NewStateFactory(cluster).NewState(types.NamespacedName{}, awsNfsVolume),
-			}
-		}
-
 		setupTest := func() {
 			awsNfsVolume = &cloudresourcesv1beta1.AwsNfsVolume{
 				ObjectMeta: metav1.ObjectMeta{
@@ -48,7 +39,7 @@ func TestValidatePersistentVolume(t *testing.T) {
 			pv = &corev1.PersistentVolume{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:   awsNfsVolume.Status.Id,
-					Labels: getVolumeLabels(awsNfsVolume),
+					Labels: testOwnerLabels(awsNfsVolume),
 				},
 			}
 
@@ -60,8 +51,8 @@ func TestValidatePersistentVolume(t *testing.T) {
 
 			k8sClient = spy.NewClientSpy(fakeClient)
 
-			state = createEmptyAwsNfsVolumeState(k8sClient, awsNfsVolume)
-			state.Volume = &corev1.PersistentVolume{}
+			state = newTestState(k8sClient, awsNfsVolume)
+			state.volume = &corev1.PersistentVolume{}
 		}
 
 		t.Run("Should: do nothing if AwsNfsVolume is marked for deletion", func(t *testing.T) {
@@ -71,7 +62,7 @@ func TestValidatePersistentVolume(t *testing.T) {
 				Time: time.Now(),
 			}
 
-			err, res := validatePersistentVolume(ctx, state)
+			err, res := ValidatePersistentVolume(ctx, state)
 
 			assert.Nil(t, res, "should return nil res")
 			assert.Nil(t, err, "should return nil err")
@@ -85,7 +76,7 @@ func TestValidatePersistentVolume(t *testing.T) {
 				Build()
 			k8sClient.(spy.ClientSpy).SetClient(fakeClient)
 
-			err, res := validatePersistentVolume(ctx, state)
+			err, res := ValidatePersistentVolume(ctx, state)
 
 			assert.Nil(t, res, "should return nil res")
 			assert.Nil(t, err, "should return nil err")
@@ -95,7 +86,7 @@ func TestValidatePersistentVolume(t *testing.T) {
 			setupTest()
 			ctx := t.Context()
 
-			err, res := validatePersistentVolume(ctx, state)
+			err, res := ValidatePersistentVolume(ctx, state)
 
 			assert.Nil(t, res, "should return nil res")
 			assert.Nil(t, err, "should return nil err")
@@ -112,7 +103,7 @@ func TestValidatePersistentVolume(t *testing.T) {
 				Build()
 			k8sClient.(spy.ClientSpy).SetClient(fakeClient)
 
-			err, _ := validatePersistentVolume(ctx, state)
+			err, _ := ValidatePersistentVolume(ctx, state)
 
 			assert.NotNilf(t, err, "error should be returned")
 			errorConditions := meta.FindStatusCondition(awsNfsVolume.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
@@ -1,4 +1,4 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
@@ -7,15 +7,15 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/util"
 )
 
-func waitPVCDeleted(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func WaitPVCDeleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 	logger := composed.LoggerFromCtx(ctx)
 
 	if !composed.MarkedForDeletionPredicate(ctx, state) {
 		return nil, nil
 	}
 
-	if state.PVC == nil {
+	if state.GetPVC() == nil {
 		return nil, ctx
 	}
 
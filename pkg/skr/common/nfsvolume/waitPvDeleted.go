@@ -1,20 +1,21 @@
-package awsnfsvolume
+package nfsvolume
 
 import (
 	"context"
-	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"time"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
 )
 
-func waitPvDeleted(ctx context.Context, st composed.State) (error, context.Context) {
-	state := st.(*State)
+func WaitPvDeleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
 	logger := composed.LoggerFromCtx(ctx)
 
 	if !composed.MarkedForDeletionPredicate(ctx, state) {
 		return nil, nil
 	}
 
-	if state.Volume == nil {
+	if state.GetVolume() == nil {
 		logger.Info("PersistentVolume is deleted")
 		return nil, nil
 	}
@@ -0,0 +1,211 @@
+package quotacheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/elliotchance/pie/v2"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func checkQuota(usage UsageFunc) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state := st.(State)
+		logger := composed.LoggerFromCtx(ctx)
+		obj := state.Obj().(composed.ObjWithConditionsAndState)
+
+		if composed.MarkedForDeletionPredicate(ctx, st) {
+			return nil, ctx
+		}
+
+		if state.IsProvisioned() {
+			// Can not enforce quota on objects with already created KCP counterpart
+			// we must let them pass, even when breaching quota, since they were created
+			// probably in time when quota was higher or not implemented.
+			// WARNING!!! These objects during deprovisioning will lose their KCP copy
+			// eventually and must be allowed to be deleted in SKR as well.
+			// Only their growth on resize is checked against the quota.
+			return checkResize(ctx, state, usage)
+		}
+
+		items, err := ListKind(ctx, state.Cluster().K8sClient(), state.Cluster().Scheme(), obj)
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error listing SKR objects for quota check", composed.StopWithRequeue, ctx)
+		}
+
+		// Must be sorted by create date so quota allows OLDER objects, and puts quota error on NEWER
+		sort.Slice(items, func(i, j int) bool {
+			ti := items[i].GetCreationTimestamp()
+			tj := items[j].GetCreationTimestamp()
+			if ti.Equal(&tj) {
+				return items[i].GetName() < items[j].GetName()
+			}
+			return ti.Before(&tj)
+		})
+
+		// Sum up the usage of this object and all older objects, except those that
+		// exceed the quota themselves, since they are not provisioned
+		totalUsage := map[string]int{}
+		for _, item := range items {
+			isMe := item.GetName() == obj.GetName() && item.GetNamespace() == obj.GetNamespace()
+			if !isMe && IsQuotaExceeded(item) {
+				continue
+			}
+			for k, v := range ObjUsage(item, usage) {
+				totalUsage[k] += v
+			}
+			if isMe {
+				break
+			}
+		}
+
+		subject := quota.SubjectFromCtx(ctx, state.GetKymaRef().Name)
+		var exceededMessages []string
+		quotaNames := pie.Sort(pie.Keys(totalUsage))
+		for _, quotaName := range quotaNames {
+			limit := quota.SkrQuota.LimitForSubject(obj, state.Cluster().Scheme(), subject, quotaName)
+			if limit == util.MaxInt {
+				continue
+			}
+			if totalUsage[quotaName] > limit {
+				exceededMessages = append(exceededMessages, fmt.Sprintf("%s usage %d exceeds limit %d", quotaName, totalUsage[quotaName], limit))
+			}
+		}
+
+		if len(exceededMessages) == 0 {
+			// this object is allowed under the quota
+			return clearQuotaExceeded(ctx, st, totalUsage)
+		}
+
+		logger.
+			WithValues(
+				"usage", fmt.Sprintf("%v", totalUsage),
+				"resources", fmt.Sprintf("%v", pie.Map(items, func(o client.Object) string {
+					ts := o.GetCreationTimestamp()
+					return fmt.Sprintf("%s/%s/%s", o.GetNamespace(), o.GetName(), ts.Format(time.RFC3339Nano))
+				})),
+			).
+			Info("Quota exceeded")
+
+		return setQuotaExceeded(ctx, st, cloudresourcesv1beta1.ConditionTypeQuotaExceeded, exceededMessages)
+	}
+}
+
+// checkResize checks the growth of the capacity quotas usage of the provisioned object.
+// The object is allowed to keep its provisioned usage even when breaching quota, and
+// it is exempt from the total count, but it can only grow within the quota limits.
+func checkResize(ctx context.Context, state State, usage UsageFunc) (error, context.Context) {
+	stateWithProvisionedUsage, ok := state.(StateWithProvisionedUsage)
+	if !ok || usage == nil {
+		return nil, ctx
+	}
+	logger := composed.LoggerFromCtx(ctx)
+	obj := state.Obj()
+
+	provisionedUsage := stateWithProvisionedUsage.ProvisionedUsage()
+	desiredUsage := usage(obj)
+	var grownQuotaNames []string
+	for quotaName, v := range desiredUsage {
+		if v > provisionedUsage[quotaName] {
+			grownQuotaNames = append(grownQuotaNames, quotaName)
+		}
+	}
+	if len(grownQuotaNames) == 0 {
+		return clearQuotaExceeded(ctx, state, desiredUsage)
+	}
+
+	items, err := ListKind(ctx, state.Cluster().K8sClient(), state.Cluster().Scheme(), obj)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing SKR objects for quota check", composed.StopWithRequeue, ctx)
+	}
+
+	// Sum up the usage of all other objects, except those that exceed the quota
+	// themselves, since they are not provisioned, and the desired usage of this object
+	totalUsage := map[string]int{}
+	for _, item := range items {
+		isMe := item.GetName() == obj.GetName() && item.GetNamespace() == obj.GetNamespace()
+		if isMe || IsQuotaExceeded(item) {
+			continue
+		}
+		for k, v := range usage(item) {
+			totalUsage[k] += v
+		}
+	}
+	for k, v := range desiredUsage {
+		totalUsage[k] += v
+	}
+
+	subject := quota.SubjectFromCtx(ctx, state.GetKymaRef().Name)
+	var exceededMessages []string
+	for _, quotaName := range pie.Sort(grownQuotaNames) {
+		limit := quota.SkrQuota.LimitForSubject(obj, state.Cluster().Scheme(), subject, quotaName)
+		if limit == util.MaxInt {
+			continue
+		}
+		if totalUsage[quotaName] > limit {
+			exceededMessages = append(exceededMessages, fmt.Sprintf("%s usage %d exceeds limit %d", quotaName, totalUsage[quotaName], limit))
+		}
+	}
+
+	if len(exceededMessages) == 0 {
+		return clearQuotaExceeded(ctx, state, totalUsage)
+	}
+
+	logger.
+		WithValues(
+			"usage", fmt.Sprintf("%v", totalUsage),
+			"provisionedUsage", fmt.Sprintf("%v", provisionedUsage),
+		).
+		Info("Quota exceeded on resize")
+
+	return setQuotaExceeded(ctx, state, cloudresourcesv1beta1.ConditionReasonQuotaExceededOnResize, exceededMessages)
+}
+
+// clearQuotaExceeded removes the QuotaExceeded condition if the object has one
+func clearQuotaExceeded(ctx context.Context, st composed.State, totalUsage map[string]int) (error, context.Context) {
+	obj := st.Obj().(composed.ObjWithConditionsAndState)
+	quotaCond := meta.FindStatusCondition(*obj.Conditions(), cloudresourcesv1beta1.ConditionTypeQuotaExceeded)
+	if quotaCond == nil {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).
+		WithValues("usage", fmt.Sprintf("%v", totalUsage)).
+		Info("Clearing SKR QuotaExceeded condition back to processing")
+
+	obj.SetState(cloudresourcesv1beta1.StateProcessing)
+
+	return composed.UpdateStatus(obj).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeQuotaExceeded).
+		ErrorLogMessage("Error clearing QuotaExceeded condition for SKR object").
+		SuccessLogMsg("Cleared SKR QuotaExceeded condition back to processing").
+		SuccessErrorNil(). // continue afterward
+		Run(ctx, st)
+}
+
+func setQuotaExceeded(ctx context.Context, st composed.State, reason string, exceededMessages []string) (error, context.Context) {
+	obj := st.Obj().(composed.ObjWithConditionsAndState)
+	obj.SetState(cloudresourcesv1beta1.StateError)
+
+	return composed.UpdateStatus(obj).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeQuotaExceeded,
+			Status:  metav1.ConditionTrue,
+			Reason:  reason,
+			Message: fmt.Sprintf("Quota exceeded: %s", strings.Join(exceededMessages, "; ")),
+		}).
+		ErrorLogMessage("Error updating SKR object status with quota exceeded status").
+		SuccessLogMsg("SKR object status updated with quota exceeded status").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, st)
+}
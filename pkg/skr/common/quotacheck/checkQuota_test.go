@@ -0,0 +1,213 @@
+package quotacheck
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testState struct {
+	composed.State
+	provisioned bool
+}
+
+func (s *testState) GetKymaRef() klog.ObjectRef {
+	return klog.ObjectRef{Name: "quotacheck-skr", Namespace: "kcp-system"}
+}
+
+func (s *testState) IsProvisioned() bool {
+	return s.provisioned
+}
+
+type testStateWithProvisionedUsage struct {
+	*testState
+	provisionedUsage map[string]int
+}
+
+func (s *testStateWithProvisionedUsage) ProvisionedUsage() map[string]int {
+	return s.provisionedUsage
+}
+
+func newGcpNfsVolume(name string, capacityGb int, age time.Duration) *cloudresourcesv1beta1.GcpNfsVolume {
+	return &cloudresourcesv1beta1.GcpNfsVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age).Truncate(time.Second)),
+		},
+		Spec: cloudresourcesv1beta1.GcpNfsVolumeSpec{
+			CapacityGb: capacityGb,
+		},
+	}
+}
+
+func gcpNfsVolumeUsage(obj client.Object) map[string]int {
+	return map[string]int{
+		quota.QuotaTotalCapacityGb: obj.(*cloudresourcesv1beta1.GcpNfsVolume).Spec.CapacityGb,
+	}
+}
+
+func runQuotaCheck(t *testing.T, provisioned bool, obj client.Object, objects ...client.Object) (error, *cloudresourcesv1beta1.GcpNfsVolume) {
+	return runQuotaCheckWithState(t, func(state *testState) State {
+		state.provisioned = provisioned
+		return state
+	}, obj, objects...)
+}
+
+func runResizeQuotaCheck(t *testing.T, provisionedCapacityGb int, obj client.Object, objects ...client.Object) (error, *cloudresourcesv1beta1.GcpNfsVolume) {
+	return runQuotaCheckWithState(t, func(state *testState) State {
+		state.provisioned = true
+		return &testStateWithProvisionedUsage{
+			testState:        state,
+			provisionedUsage: map[string]int{quota.QuotaTotalCapacityGb: provisionedCapacityGb},
+		}
+	}, obj, objects...)
+}
+
+func runQuotaCheckWithState(t *testing.T, stateFn func(state *testState) State, obj client.Object, objects ...client.Object) (error, *cloudresourcesv1beta1.GcpNfsVolume) {
+	all := append([]client.Object{obj}, objects...)
+	skrClient := fake.NewClientBuilder().
+		WithScheme(commonscheme.SkrScheme).
+		WithObjects(all...).
+		WithStatusSubresource(all...).
+		Build()
+	cluster := composed.NewStateCluster(skrClient, skrClient, nil, commonscheme.SkrScheme)
+	state := stateFn(&testState{
+		State: composed.NewStateFactory(cluster).NewState(client.ObjectKeyFromObject(obj), obj),
+	})
+
+	err, _ := New(gcpNfsVolumeUsage)(context.Background(), state)
+
+	loaded := &cloudresourcesv1beta1.GcpNfsVolume{}
+	assert.NoError(t, skrClient.Get(context.Background(), client.ObjectKeyFromObject(obj), loaded))
+	return err, loaded
+}
+
+func TestCheckQuota(t *testing.T) {
+	scheme := commonscheme.SkrScheme
+	quota.SkrQuota.OverrideLimit(&cloudresourcesv1beta1.GcpNfsVolume{}, scheme, "quotacheck-skr", quota.QuotaTotalCount, 2)
+	quota.SkrQuota.OverrideLimit(&cloudresourcesv1beta1.GcpNfsVolume{}, scheme, "quotacheck-skr", quota.QuotaTotalCapacityGb, 2500)
+
+	t.Run("older objects within quota pass", func(t *testing.T) {
+		err, loaded := runQuotaCheck(t, false,
+			newGcpNfsVolume("first", 1000, 3*time.Second),
+			newGcpNfsVolume("second", 1000, 2*time.Second),
+			newGcpNfsVolume("third", 1000, time.Second),
+		)
+		assert.NoError(t, err)
+		assert.Nil(t, meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded))
+	})
+
+	t.Run("total count exceeded", func(t *testing.T) {
+		err, loaded := runQuotaCheck(t, false,
+			newGcpNfsVolume("third", 100, time.Second),
+			newGcpNfsVolume("first", 100, 3*time.Second),
+			newGcpNfsVolume("second", 100, 2*time.Second),
+		)
+		assert.Error(t, err)
+		cond := meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded)
+		if assert.NotNil(t, cond) {
+			assert.Equal(t, "Quota exceeded: totalCount usage 3 exceeds limit 2", cond.Message)
+		}
+		assert.Equal(t, cloudresourcesv1beta1.StateError, loaded.State())
+	})
+
+	t.Run("total capacity exceeded", func(t *testing.T) {
+		err, loaded := runQuotaCheck(t, false,
+			newGcpNfsVolume("second", 2000, time.Second),
+			newGcpNfsVolume("first", 1000, 2*time.Second),
+		)
+		assert.Error(t, err)
+		cond := meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded)
+		if assert.NotNil(t, cond) {
+			assert.Equal(t, "Quota exceeded: totalCapacityGb usage 3000 exceeds limit 2500", cond.Message)
+		}
+	})
+
+	t.Run("older objects exceeding quota are not counted", func(t *testing.T) {
+		exceeded := newGcpNfsVolume("first", 3000, 2*time.Second)
+		exceeded.Status.Conditions = []metav1.Condition{{
+			Type:   cloudresourcesv1beta1.ConditionTypeQuotaExceeded,
+			Status: metav1.ConditionTrue,
+			Reason: cloudresourcesv1beta1.ConditionTypeQuotaExceeded,
+		}}
+		err, loaded := runQuotaCheck(t, false,
+			newGcpNfsVolume("second", 2000, time.Second),
+			exceeded,
+		)
+		assert.NoError(t, err)
+		assert.Nil(t, meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded))
+	})
+
+	t.Run("quota exceeded condition is cleared", func(t *testing.T) {
+		obj := newGcpNfsVolume("second", 1000, time.Second)
+		obj.SetState(cloudresourcesv1beta1.StateError)
+		obj.Status.Conditions = []metav1.Condition{{
+			Type:   cloudresourcesv1beta1.ConditionTypeQuotaExceeded,
+			Status: metav1.ConditionTrue,
+			Reason: cloudresourcesv1beta1.ConditionTypeQuotaExceeded,
+		}}
+		err, loaded := runQuotaCheck(t, false, obj, newGcpNfsVolume("first", 1000, 2*time.Second))
+		assert.NoError(t, err)
+		assert.Nil(t, meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded))
+		assert.Equal(t, cloudresourcesv1beta1.StateProcessing, loaded.State())
+	})
+
+	t.Run("provisioned object is not checked", func(t *testing.T) {
+		err, loaded := runQuotaCheck(t, true,
+			newGcpNfsVolume("second", 5000, time.Second),
+			newGcpNfsVolume("first", 1000, 2*time.Second),
+		)
+		assert.NoError(t, err)
+		assert.Nil(t, meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded))
+	})
+	t.Run("provisioned object resized over capacity quota is rejected", func(t *testing.T) {
+		err, loaded := runResizeQuotaCheck(t, 1000,
+			newGcpNfsVolume("first", 2000, 2*time.Second),
+			newGcpNfsVolume("second", 1000, time.Second),
+		)
+		assert.Error(t, err)
+		cond := meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded)
+		if assert.NotNil(t, cond) {
+			assert.Equal(t, cloudresourcesv1beta1.ConditionReasonQuotaExceededOnResize, cond.Reason)
+			assert.Equal(t, "Quota exceeded: totalCapacityGb usage 3000 exceeds limit 2500", cond.Message)
+		}
+		assert.Equal(t, cloudresourcesv1beta1.StateError, loaded.State())
+		assert.False(t, IsQuotaExceeded(loaded), "object with refused resize is still provisioned")
+	})
+
+	t.Run("provisioned object resized within capacity quota passes", func(t *testing.T) {
+		err, loaded := runResizeQuotaCheck(t, 1000,
+			newGcpNfsVolume("third", 1500, time.Second),
+			newGcpNfsVolume("first", 500, 3*time.Second),
+			newGcpNfsVolume("second", 500, 2*time.Second),
+		)
+		assert.NoError(t, err)
+		assert.Nil(t, meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded))
+	})
+
+	t.Run("provisioned object over quota without growth passes", func(t *testing.T) {
+		obj := newGcpNfsVolume("second", 2000, time.Second)
+		obj.SetState(cloudresourcesv1beta1.StateError)
+		obj.Status.Conditions = []metav1.Condition{{
+			Type:   cloudresourcesv1beta1.ConditionTypeQuotaExceeded,
+			Status: metav1.ConditionTrue,
+			Reason: cloudresourcesv1beta1.ConditionReasonQuotaExceededOnResize,
+		}}
+		err, loaded := runResizeQuotaCheck(t, 2000, obj, newGcpNfsVolume("first", 1000, 2*time.Second))
+		assert.NoError(t, err)
+		assert.Nil(t, meta.FindStatusCondition(loaded.Status.Conditions, cloudresourcesv1beta1.ConditionTypeQuotaExceeded))
+		assert.Equal(t, cloudresourcesv1beta1.StateProcessing, loaded.State())
+	})
+}
@@ -0,0 +1,46 @@
+package quotacheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// New returns a composed.Action that checks the SKR quotas of the reconciled object kind.
+// All objects of the same kind in the SKR are sorted by creationTimestamp, older first, and
+// their usage is summed up to and including the reconciled object. If any of the quotas
+// configured in quota.SkrQuota for the kind is exceeded, with overrides selected by the
+// kyma and the global account, subaccount, broker plan and provider from the feature
+// context, the object gets the QuotaExceeded
+// condition with the usage and the limit in the message, and the flow stops with requeue.
+// Once the quota allows the object, the QuotaExceeded condition is removed and the flow continues.
+// The total count quota is always checked, and the capacity quotas returned by the optional
+// usage function. Objects that are provisioned or marked for deletion are not checked.
+// The provided state MUST implement the State interface, and it should run just before
+// the KCP counterpart of the reconciled object is created.
+func New(usage UsageFunc) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, ok := st.(State)
+		if !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("state %T provided to quotacheck flow does not implement quotacheck.State", st),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+
+		_, ok = state.Obj().(composed.ObjWithConditionsAndState)
+		if !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("object %T provided to quotacheck flow does not implement composed.ObjWithConditionsAndState", state.Obj()),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+
+		return checkQuota(usage)(ctx, state)
+	}
+}
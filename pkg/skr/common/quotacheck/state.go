@@ -0,0 +1,32 @@
+package quotacheck
+
+import (
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type State interface {
+	composed.State
+	GetKymaRef() klog.ObjectRef
+	// IsProvisioned returns true if the KCP counterpart or the cloud resource of the reconciled
+	// object already exists. Quota is not enforced on such objects, since they were created
+	// probably in time when quota was higher or not implemented, and they must be allowed to
+	// proceed and eventually be deleted.
+	IsProvisioned() bool
+}
+
+// StateWithProvisionedUsage is implemented by states of resizable objects. For provisioned
+// objects only the growth of the usage over the ProvisionedUsage is checked against the quota,
+// while the total count is not enforced.
+type StateWithProvisionedUsage interface {
+	State
+	// ProvisionedUsage returns the usage of the capacity quotas made by the KCP counterpart
+	// or the cloud resource, keyed by the quota name same as the UsageFunc.
+	ProvisionedUsage() map[string]int
+}
+
+// UsageFunc returns the usage the given object makes of the capacity quotas of its kind,
+// keyed by the quota name, for example quota.QuotaTotalCapacityGb. The quota.QuotaTotalCount
+// is always evaluated with usage of one per object and does not have to be returned.
+type UsageFunc func(obj client.Object) map[string]int
@@ -0,0 +1,85 @@
+package quotacheck
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// ObjUsage returns the usage of all quotas made by the given object, including the total count.
+func ObjUsage(obj client.Object, usage UsageFunc) map[string]int {
+	result := map[string]int{}
+	if usage != nil {
+		for k, v := range usage(obj) {
+			result[k] = v
+		}
+	}
+	result[quota.QuotaTotalCount] = 1
+	return result
+}
+
+// TotalUsage returns the sum of the usage of the given objects, except those that
+// exceed the quota themselves, since they are not provisioned.
+func TotalUsage(items []client.Object, usage UsageFunc) map[string]int {
+	result := map[string]int{}
+	for _, item := range items {
+		if IsQuotaExceeded(item) {
+			continue
+		}
+		for k, v := range ObjUsage(item, usage) {
+			result[k] += v
+		}
+	}
+	return result
+}
+
+// IsQuotaExceeded returns true if the object was not provisioned since it exceeds the quota.
+// Objects with refused resize are provisioned and not considered as exceeding the quota.
+func IsQuotaExceeded(obj client.Object) bool {
+	objWithConditions, ok := obj.(composed.ObjWithConditions)
+	if !ok {
+		return false
+	}
+	cond := meta.FindStatusCondition(*objWithConditions.Conditions(), cloudresourcesv1beta1.ConditionTypeQuotaExceeded)
+	return cond != nil &&
+		cond.Status == metav1.ConditionTrue &&
+		cond.Reason != cloudresourcesv1beta1.ConditionReasonQuotaExceededOnResize
+}
+
+// ListKind returns all objects in the cluster of the same kind as the given object.
+func ListKind(ctx context.Context, reader client.Reader, scheme *runtime.Scheme, obj client.Object) ([]client.Object, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("error getting GVK for %T: %w", obj, err)
+	}
+	listObj, err := scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, fmt.Errorf("error creating list for %s: %w", gvk.Kind, err)
+	}
+	list, ok := listObj.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("list type %T for %s is not client.ObjectList", listObj, gvk.Kind)
+	}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+	arr, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting list of %s: %w", gvk.Kind, err)
+	}
+	result := make([]client.Object, 0, len(arr))
+	for _, o := range arr {
+		if co, ok := o.(client.Object); ok {
+			result = append(result, co)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package gcpnfsbackupschedule
+
+import (
+	"context"
+	"errors"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// backupVerifier test restores GcpNfsVolumeBackups into a temporary GcpNfsVolume.
+type backupVerifier struct{}
+
+func (v *backupVerifier) EmptyScratchVolume() client.Object {
+	return &cloudresourcesv1beta1.GcpNfsVolume{}
+}
+
+func (v *backupVerifier) NewScratchVolume(ctx context.Context, clnt client.Client, backup client.Object, name string) (client.Object, error) {
+	x, ok := backup.(*cloudresourcesv1beta1.GcpNfsVolumeBackup)
+	if !ok {
+		return nil, errors.New("backup Object should be of type GcpNfsVolumeBackup")
+	}
+
+	source := &cloudresourcesv1beta1.GcpNfsVolume{}
+	err := clnt.Get(ctx, x.Spec.Source.Volume.ToNamespacedName(x.Namespace), source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloudresourcesv1beta1.GcpNfsVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: x.Namespace,
+		},
+		Spec: cloudresourcesv1beta1.GcpNfsVolumeSpec{
+			IpRange:       source.Spec.IpRange,
+			Location:      source.Spec.Location,
+			Tier:          source.Spec.Tier,
+			FileShareName: source.Spec.FileShareName,
+			CapacityGb:    source.Spec.CapacityGb,
+		},
+	}, nil
+}
+
+func (v *backupVerifier) IsScratchVolumeReady(volume client.Object) bool {
+	x, ok := volume.(*cloudresourcesv1beta1.GcpNfsVolume)
+	return ok && meta.IsStatusConditionTrue(x.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady)
+}
+
+func (v *backupVerifier) EmptyRestore() composed.ObjWithConditions {
+	return &cloudresourcesv1beta1.GcpNfsVolumeRestore{}
+}
+
+func (v *backupVerifier) NewRestore(backup client.Object, volume client.Object, name string) client.Object {
+	return &cloudresourcesv1beta1.GcpNfsVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: backup.GetNamespace(),
+		},
+		Spec: cloudresourcesv1beta1.GcpNfsVolumeRestoreSpec{
+			Source: cloudresourcesv1beta1.GcpNfsVolumeRestoreSource{
+				Backup: cloudresourcesv1beta1.GcpNfsVolumeBackupRef{
+					Name:      backup.GetName(),
+					Namespace: backup.GetNamespace(),
+				},
+			},
+			Destination: cloudresourcesv1beta1.GcpNfsVolumeRestoreDestination{
+				Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{
+					Name:      volume.GetName(),
+					Namespace: volume.GetNamespace(),
+				},
+			},
+		},
+	}
+}
+
+func (v *backupVerifier) GetRestoreState(restore composed.ObjWithConditions) string {
+	x, ok := restore.(*cloudresourcesv1beta1.GcpNfsVolumeRestore)
+	if !ok {
+		return ""
+	}
+	return x.Status.State
+}
+
+func (v *backupVerifier) IsBackupReady(backup client.Object) bool {
+	x, ok := backup.(*cloudresourcesv1beta1.GcpNfsVolumeBackup)
+	return ok && x.Status.State == cloudresourcesv1beta1.GcpNfsBackupReady
+}
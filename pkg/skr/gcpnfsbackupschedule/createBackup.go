@@ -45,34 +45,28 @@ func createBackup(ctx context.Context, st composed.State) (error, context.Contex
 
 	gcpSchedule := state.ObjAsGcpNfsBackupSchedule()
 
-	backup := &cloudresourcesv1beta1.GcpNfsVolumeBackup{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: schedule.GetNamespace(),
-			Labels: map[string]string{
-				cloudresourcesv1beta1.LabelScheduleName:      schedule.GetName(),
-				cloudresourcesv1beta1.LabelScheduleNamespace: schedule.GetNamespace(),
-			},
-		},
-		Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
-			Location: gcpSchedule.Spec.Location,
-			Source: cloudresourcesv1beta1.GcpNfsVolumeBackupSource{
-				Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{
-					Name:      state.Source.Name,
-					Namespace: state.Source.Namespace,
-				},
-			},
-			AccessibleFrom: gcpSchedule.Spec.AccessibleFrom,
-		},
+	// The backup in the schedule location, followed by one copy per configured copy location
+	backups := []*cloudresourcesv1beta1.GcpNfsVolumeBackup{
+		newScheduledBackup(state, name, gcpSchedule.Spec.Location, ""),
+	}
+	for _, backupCopy := range gcpSchedule.Spec.Copies {
+		copyName := fmt.Sprintf("%s-%s", name, backupCopy.Location)
+		backups = append(backups, newScheduledBackup(state, copyName, backupCopy.Location, backupCopy.Location))
 	}
 
-	// Check if backup already exists before creating
-	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{
-		Name:      backup.Name,
-		Namespace: backup.Namespace,
-	}, &cloudresourcesv1beta1.GcpNfsVolumeBackup{})
-	if err != nil && apierrors.IsNotFound(err) {
-		err = state.Cluster().K8sClient().Create(ctx, backup)
+	var err error
+	for _, backup := range backups {
+		// Check if backup already exists before creating
+		err = state.Cluster().K8sClient().Get(ctx, types.NamespacedName{
+			Name:      backup.Name,
+			Namespace: backup.Namespace,
+		}, &cloudresourcesv1beta1.GcpNfsVolumeBackup{})
+		if err != nil && apierrors.IsNotFound(err) {
+			err = state.Cluster().K8sClient().Create(ctx, backup)
+		}
+		if err != nil {
+			break
+		}
 	}
 
 	if err != nil {
@@ -89,17 +83,58 @@ func createBackup(ctx context.Context, st composed.State) (error, context.Contex
 			Run(ctx, state)
 	}
 
+	var lastCopies []corev1.ObjectReference
+	for _, backup := range backups[1:] {
+		lastCopies = append(lastCopies, corev1.ObjectReference{
+			Kind:      "GcpNfsVolumeBackup",
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		})
+	}
+
 	schedule.SetState(cloudresourcesv1beta1.JobStateActive)
 	schedule.SetBackupIndex(index)
-	schedule.SetBackupCount(len(state.Backups) + 1)
+	schedule.SetBackupCount(len(state.Backups) + len(backups))
 	schedule.SetLastCreateRun(&metav1.Time{Time: state.nextRunTime.UTC()})
 	schedule.SetLastCreatedBackup(corev1.ObjectReference{
 		Kind:      "GcpNfsVolumeBackup",
-		Namespace: backup.Namespace,
-		Name:      backup.Name,
+		Namespace: backups[0].Namespace,
+		Name:      backups[0].Name,
 	})
+	gcpSchedule.Status.LastCreatedCopies = lastCopies
 	return composed.PatchStatus(schedule).
 		SetExclusiveConditions().
 		SuccessError(composed.StopWithRequeue).
 		Run(ctx, state)
 }
+
+// newScheduledBackup builds a GcpNfsVolumeBackup of the schedule source in the given location.
+// Copies are labeled with their copy location, so they are pruned by the retention of their copy entry.
+func newScheduledBackup(state *State, name, location, copyLocation string) *cloudresourcesv1beta1.GcpNfsVolumeBackup {
+	schedule := state.ObjAsGcpNfsBackupSchedule()
+	backup := &cloudresourcesv1beta1.GcpNfsVolumeBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: schedule.GetNamespace(),
+			Labels: map[string]string{
+				cloudresourcesv1beta1.LabelScheduleName:      schedule.GetName(),
+				cloudresourcesv1beta1.LabelScheduleNamespace: schedule.GetNamespace(),
+			},
+		},
+		Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
+			Location: location,
+			Source: cloudresourcesv1beta1.GcpNfsVolumeBackupSource{
+				Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{
+					Name:      state.Source.Name,
+					Namespace: state.Source.Namespace,
+				},
+			},
+			AccessibleFrom: schedule.Spec.AccessibleFrom,
+			Hooks:          schedule.Spec.Hooks.DeepCopy(),
+		},
+	}
+	if copyLocation != "" {
+		backup.Labels[cloudresourcesv1beta1.LabelScheduleCopyLocation] = copyLocation
+	}
+	return backup
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 func deleteBackups(ctx context.Context, st composed.State) (error, context.Context) {
@@ -28,8 +29,10 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 		return nil, nil
 	}
 
-	// If the number of backups is zero, OR maxRetentionDays is not positive, requeue to update next run time
-	if len(state.Backups) == 0 || schedule.GetMaxRetentionDays() <= 0 {
+	// If the number of backups is zero, OR maxRetentionDays is not positive and there is no retention policy,
+	// requeue to update next run time
+	policy := schedule.GetRetentionPolicy()
+	if len(state.Backups) == 0 || (schedule.GetMaxRetentionDays() <= 0 && policy == nil) {
 		schedule.SetLastDeleteRun(&metav1.Time{Time: state.nextRunTime.UTC()})
 		schedule.SetNextDeleteTimes(nil)
 		schedule.SetLastDeletedBackups(nil)
@@ -44,15 +47,39 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 
 	nextDeleteTimes := map[string]string{}
 	var lastDeleted []corev1.ObjectReference
-	readyCount, failedCount := 0, 0
+	// Ready and failed backups are counted per copy location, the schedule location being ""
+	readyCount, failedCount := map[string]int{}, map[string]int{}
 
+	// Ready backups outside every bucket of the retention policy are deleted, the buckets are per copy location
+	readyBackups := map[string][]*cloudresourcesv1beta1.GcpNfsVolumeBackup{}
 	for _, backup := range state.Backups {
+		if backup.Status.State == cloudresourcesv1beta1.StateReady {
+			copyLocation := backup.GetLabels()[cloudresourcesv1beta1.LabelScheduleCopyLocation]
+			readyBackups[copyLocation] = append(readyBackups[copyLocation], backup)
+		}
+	}
+	retained := sets.New[string]()
+	for _, backups := range readyBackups {
+		retained = retained.Union(backupschedule.RetainedByPolicy(policy, backups))
+	}
+
+	for _, backup := range state.Backups {
+		copyLocation := backup.GetLabels()[cloudresourcesv1beta1.LabelScheduleCopyLocation]
+		maxRetentionDays, maxReadyBackups := backupRetention(state.ObjAsGcpNfsBackupSchedule(), copyLocation)
+
+		// Keep the backup while it is being verified
+		if backupschedule.IsVerificationInProgress(backup) {
+			continue
+		}
+
 		// Check if the backup object should be deleted
-		toRetain := time.Duration(schedule.GetMaxRetentionDays()) * 24 * time.Hour
+		retainedByPolicy := policy != nil && backup.Status.State == cloudresourcesv1beta1.StateReady
+		toRetain := time.Duration(maxRetentionDays) * 24 * time.Hour
 		elapsed := time.Since(backup.GetCreationTimestamp().Time)
-		if elapsed > toRetain ||
-			(backup.Status.State == cloudresourcesv1beta1.StateReady && readyCount >= schedule.GetMaxReadyBackups()) ||
-			(backup.Status.State == cloudresourcesv1beta1.StateFailed && failedCount >= schedule.GetMaxFailedBackups()) {
+		if (retainedByPolicy && !retained.Has(backup.GetName())) ||
+			(!retainedByPolicy && elapsed > toRetain) ||
+			(!retainedByPolicy && backup.Status.State == cloudresourcesv1beta1.StateReady && readyCount[copyLocation] >= maxReadyBackups) ||
+			(backup.Status.State == cloudresourcesv1beta1.StateFailed && failedCount[copyLocation] >= schedule.GetMaxFailedBackups()) {
 			logger.WithValues("Backup", backup.GetName()).Info("Deleting backup object")
 			err := state.Cluster().K8sClient().Delete(ctx, backup)
 			if err != nil {
@@ -66,14 +93,15 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 		} else {
 			switch backup.Status.State {
 			case cloudresourcesv1beta1.StateReady:
-				readyCount++
+				readyCount[copyLocation]++
 			case cloudresourcesv1beta1.StateFailed:
-				failedCount++
+				failedCount[copyLocation]++
 			}
 		}
-		if uint(len(nextDeleteTimes)) < backupschedule.MaxSchedules {
+		// The deletion time of backups retained by the policy is not known in advance
+		if uint(len(nextDeleteTimes)) < backupschedule.MaxSchedules && !retainedByPolicy {
 			backupName := fmt.Sprintf("%s/%s", backup.GetNamespace(), backup.GetName())
-			deleteTime := backup.GetCreationTimestamp().AddDate(0, 0, schedule.GetMaxRetentionDays())
+			deleteTime := backup.GetCreationTimestamp().AddDate(0, 0, maxRetentionDays)
 			nextDeleteTimes[backupName] = deleteTime.UTC().Format(time.RFC3339)
 		}
 	}
@@ -88,3 +116,24 @@ func deleteBackups(ctx context.Context, st composed.State) (error, context.Conte
 		SuccessError(composed.StopWithRequeue).
 		Run(ctx, state)
 }
+
+// backupRetention returns the MaxRetentionDays and MaxReadyBackups for the backups in the given copy location.
+// Unset copy values, and copies whose location is no longer listed in the schedule, fall back to the schedule values.
+func backupRetention(schedule *cloudresourcesv1beta1.GcpNfsBackupSchedule, copyLocation string) (int, int) {
+	maxRetentionDays, maxReadyBackups := schedule.GetMaxRetentionDays(), schedule.GetMaxReadyBackups()
+	if copyLocation == "" {
+		return maxRetentionDays, maxReadyBackups
+	}
+	for _, backupCopy := range schedule.Spec.Copies {
+		if backupCopy.Location != copyLocation {
+			continue
+		}
+		if backupCopy.MaxRetentionDays > 0 {
+			maxRetentionDays = backupCopy.MaxRetentionDays
+		}
+		if backupCopy.MaxReadyBackups > 0 {
+			maxReadyBackups = backupCopy.MaxReadyBackups
+		}
+	}
+	return maxRetentionDays, maxReadyBackups
+}
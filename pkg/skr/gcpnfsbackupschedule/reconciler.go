@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/types"
@@ -53,12 +54,14 @@ func (r *Reconciler) newAction() composed.Action {
 		composed.LoadObj,
 		actions.AddCommonFinalizer(),
 		loadBackups,
+		backupschedule.VerifyBackups,
 		composed.IfElse(
 			composed.Not(composed.MarkedForDeletionPredicate),
 			// Main (create) path
 			composed.ComposeActions(
 				"gcpNfsBackupScheduleV2-main",
 				// Common scheduling actions
+				quotacheck.New(nil),
 				backupschedule.CheckCompleted,
 				backupschedule.CheckSuspension,
 				backupschedule.ValidateSchedule,
@@ -80,6 +83,7 @@ func (r *Reconciler) newAction() composed.Action {
 				actions.RemoveCommonFinalizer(),
 			),
 		),
+		backupschedule.RequeueWhileVerifying,
 		composed.StopAndForgetAction,
 	)
 }
@@ -13,6 +13,7 @@ import (
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type State struct {
@@ -28,6 +29,8 @@ type State struct {
 	createRunDone  bool
 	deleteRunDone  bool
 
+	verificationRunning bool
+
 	// GCP-specific (concrete types, no interfaces)
 	Scope   *cloudcontrolv1beta1.Scope
 	Source  *cloudresourcesv1beta1.GcpNfsVolume
@@ -55,6 +58,27 @@ func (s *State) SetCreateRunCompleted(v bool)             { s.createRunDone = v
 func (s *State) IsDeleteRunCompleted() bool               { return s.deleteRunDone }
 func (s *State) SetDeleteRunCompleted(v bool)             { s.deleteRunDone = v }
 
+// Implement backupschedule.VerificationState interface
+
+func (s *State) GetBackups() []client.Object {
+	objects := make([]client.Object, 0, len(s.Backups))
+	for _, backup := range s.Backups {
+		objects = append(objects, backup)
+	}
+	return objects
+}
+
+func (s *State) GetBackupVerifier() backupschedule.BackupVerifier {
+	return &backupVerifier{}
+}
+
+func (s *State) Now() time.Time {
+	return s.Scheduler.Now()
+}
+
+func (s *State) IsVerificationRunning() bool   { return s.verificationRunning }
+func (s *State) SetVerificationRunning(v bool) { s.verificationRunning = v }
+
 func (s *State) ObjAsGcpNfsBackupSchedule() *cloudresourcesv1beta1.GcpNfsBackupSchedule {
 	return s.Obj().(*cloudresourcesv1beta1.GcpNfsBackupSchedule)
 }
@@ -96,3 +120,11 @@ func (f *stateFactory) NewState(ctx context.Context, baseState composed.State) (
 		Scheduler:  backupschedule.NewScheduleCalculator(f.clk, 1*time.Second),
 	}, nil
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.ObjAsBackupSchedule().GetLastCreateRun() != nil && !s.ObjAsBackupSchedule().GetLastCreateRun().IsZero()
+}
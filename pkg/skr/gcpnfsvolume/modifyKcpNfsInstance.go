@@ -10,6 +10,7 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -80,6 +81,7 @@ func createKcpNfsInstance(ctx context.Context, state *State, logger logr.Logger)
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpNfsInstance)
 	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpNfsInstance)
 	if err != nil {
 		logger.Error(err, "Error creating KCP NfsInstance")
@@ -13,6 +13,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -74,6 +75,7 @@ func (r *Reconciler) newAction() composed.Action {
 		loadPersistenceVolume,
 		sanitizeReleasedVolume,
 		loadPersistentVolumeClaim,
+		quotacheck.New(QuotaUsage),
 		modifyKcpNfsInstance,
 		removePersistenceVolumeClaimFinalizer,
 		removePersistenceVolumeFinalizer,
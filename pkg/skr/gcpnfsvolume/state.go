@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpnfsbackupclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v2"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"github.com/kyma-project/cloud-manager/pkg/util"
@@ -128,3 +129,20 @@ func (s *State) IsAllowedToRestoreBackup() bool {
 func ConvertToAccessibleFromKey(name string) string {
 	return fmt.Sprintf("cm-allow-%s", name)
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpNfsInstance != nil
+}
+
+func (s *State) ProvisionedUsage() map[string]int {
+	if s.KcpNfsInstance == nil || s.KcpNfsInstance.Spec.Instance.Gcp == nil {
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalCapacityGb: s.KcpNfsInstance.Spec.Instance.Gcp.CapacityGb,
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpnfsbackupclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v2"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/backuphooks"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,6 +21,7 @@ import (
 type Reconciler struct {
 	composedStateFactory composed.StateFactory
 	stateFactory         StateFactory
+	executor             backuphooks.Executor
 }
 
 func (r *Reconciler) Run(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -53,7 +56,7 @@ func (r *Reconciler) newAction() composed.Action {
 		"crGcpNfsVolumeBackupMain",
 		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.GcpNfsVolumeBackup{}),
 		composed.LoadObj,
-		composeActions(),
+		composeActions(r.executor),
 	)
 }
 
@@ -66,14 +69,16 @@ func NewReconciler(scopeProvider scopeprovider.ScopeProvider, kcpCluster cluster
 	return Reconciler{
 		composedStateFactory: composedStateFactory,
 		stateFactory:         stateFactory,
+		executor:             backuphooks.NewExecutor(skrCluster.GetConfig()),
 	}
 }
 
-func composeActions() composed.Action {
+func composeActions(executor backuphooks.Executor) composed.Action {
 	return composed.ComposeActions(
 		"gcpNfsVolumeBackupV2",
 		loadScope,
 		clientCreate,
+		backuphooks.NewResume(executor),
 		shortCircuitCompleted,
 		markFailed,
 		actions.AddCommonFinalizer(),
@@ -86,6 +91,8 @@ func composeActions() composed.Action {
 		composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
 			composed.ComposeActions(
 				"gcpNfsVolumeBackupV2-create",
+				quotacheck.New(nil),
+				backuphooks.New(executor),
 				createNfsBackup,
 				waitBackupReady,
 				addLabelsToNfsBackup,
@@ -93,6 +100,7 @@ func composeActions() composed.Action {
 			),
 			composed.ComposeActions(
 				"gcpNfsVolumeBackupV2-delete",
+				backuphooks.New(executor),
 				deleteNfsBackup,
 				waitBackupDeleted,
 				actions.RemoveCommonFinalizer(),
@@ -280,3 +280,15 @@ func IsAccessibleFromKey(key string) bool {
 func StripAccessibleFromPrefix(key string) string {
 	return strings.TrimPrefix(key, "cm-allow-")
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.fileBackup != nil || len(s.ObjAsGcpNfsVolumeBackup().Status.OpIdentifier) > 0
+}
+
+func (s *State) IsBackupTriggered() bool {
+	return s.IsProvisioned()
+}
@@ -7,6 +7,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -75,6 +76,7 @@ func createKcpGcpRedisCluster(ctx context.Context, st composed.State) (error, co
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpGcpRedisCluster)
 	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpGcpRedisCluster)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating KCP GcpRedisCluster", composed.StopWithRequeue, ctx)
@@ -0,0 +1,34 @@
+package gcprediscluster
+
+import (
+	"math"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// gcpRedisClusterTierToMemoryGbMap is the memory of the node type of each tier, in GiB
+var gcpRedisClusterTierToMemoryGbMap = map[cloudresourcesv1beta1.GcpRedisClusterTier]float64{
+	cloudresourcesv1beta1.GcpRedisClusterTierC1: 1.4,
+	cloudresourcesv1beta1.GcpRedisClusterTierC3: 6.5,
+	cloudresourcesv1beta1.GcpRedisClusterTierC4: 13,
+	cloudresourcesv1beta1.GcpRedisClusterTierC6: 58,
+}
+
+// QuotaUsage returns the memory, as defined by the tier of the shard times the shard count and
+// rounded up, the cluster makes of the SKR quota. Replicas hold copies of the same data and are not counted.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.GcpRedisCluster)
+	if !ok {
+		return nil
+	}
+	memoryGb, ok := gcpRedisClusterTierToMemoryGbMap[redis.Spec.RedisTier]
+	if !ok {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: int(math.Ceil(memoryGb * float64(max(1, redis.Spec.ShardCount)))),
+	}
+}
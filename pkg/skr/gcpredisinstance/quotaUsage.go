@@ -0,0 +1,23 @@
+package gcpredisinstance
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// QuotaUsage returns the memory, as defined by the tier, the instance makes of the SKR quota.
+func QuotaUsage(obj client.Object) map[string]int {
+	redis, ok := obj.(*cloudresourcesv1beta1.GcpRedisInstance)
+	if !ok {
+		return nil
+	}
+	_, memorySizeGb, err := redisTierToTierAndMemorySizeConverter(redis.Spec.RedisTier)
+	if err != nil {
+		// unknown tier is reported by the KCP, here it makes no memory usage
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalMemoryGb: int(memorySizeGb),
+	}
+}
@@ -135,3 +135,11 @@ func areMaintenancePoliciesDifferent(skrPolicy *cloudresourcesv1beta1.Maintenanc
 
 	return false
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpRedisInstance != nil
+}
@@ -7,6 +7,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -49,6 +50,7 @@ func createKcpGcpSubnet(ctx context.Context, st composed.State) (error, context.
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpGcpSubnet)
 	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpGcpSubnet)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating KCP GcpSubnet", composed.StopWithRequeue, ctx)
@@ -53,3 +53,11 @@ func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State,
 func (s *State) ObjAsGcpVpcPeering() *cloudresourcesv1beta1.GcpVpcPeering {
 	return s.Obj().(*cloudresourcesv1beta1.GcpVpcPeering)
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpRemoteNetwork != nil || s.KcpVpcPeering != nil
+}
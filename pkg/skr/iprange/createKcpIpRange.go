@@ -8,6 +8,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -59,6 +60,7 @@ func createKcpIpRange(ctx context.Context, st composed.State) (error, context.Co
 			Name: common.KcpNetworkKymaCommonName(state.KymaRef.Name),
 		}
 	}
+	tracing.InjectIntoObj(ctx, state.KcpIpRange)
 	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpIpRange)
 	if err != nil {
 		logger.Error(err, "Error creating KCP IpRange")
@@ -8,6 +8,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -63,16 +64,20 @@ func (r *reconciler) newAction() composed.Action {
 		preventCidrOverlap,
 		removeOverlapCondition,
 		loadKcpIpRange,
-		checkQuota,
+		quotacheck.New(nil),
 		addFinalizer,
 		createKcpIpRange,
 		setProcessingStateForDeletion,
 		preventDeleteOnAwsNfsVolumeUsage,
 		preventDeleteOnGcpNfsVolumeUsage,
+		preventDeleteOnAzureNfsVolumeUsage,
+		preventDeleteOnAlicloudNfsVolumeUsage,
 		preventDeleteOnAzureRedisInstanceUsage,
 		preventDeleteOnAwsRedisInstanceUsage,
 		preventDeleteOnGcpRedisInstanceUsage,
 		preventDeleteOnAwsRedisClusterUsage,
+		preventDeleteOnAlicloudRedisInstanceUsage,
+		preventDeleteOnAlicloudRedisClusterUsage,
 		deleteKcpIpRange,
 		removeFinalizer,
 		updateStatus,
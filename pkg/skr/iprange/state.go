@@ -88,3 +88,11 @@ func (s *State) MapConditionToState() (f func(obj composed.ObjWithConditions) (s
 		return "", false
 	}
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpIpRange != nil
+}
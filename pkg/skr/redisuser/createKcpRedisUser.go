@@ -0,0 +1,78 @@
+package redisuser
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpRedisUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpRedisUser != nil {
+		return nil, ctx
+	}
+
+	redisUser := state.ObjAsRedisUser()
+	_, kcpRedisKind, _ := newSkrRedis(redisUser.Spec.RedisRef.Kind)
+
+	var azure *cloudcontrolv1beta1.RedisUserAzure
+	if redisUser.Spec.Azure != nil {
+		azure = &cloudcontrolv1beta1.RedisUserAzure{
+			ObjectId: redisUser.Spec.Azure.ObjectId,
+		}
+	}
+
+	state.KcpRedisUser = &cloudcontrolv1beta1.RedisUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      redisUser.Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				common.LabelKymaModule: common.FieldOwner,
+			},
+			Annotations: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      redisUser.Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: redisUser.Namespace,
+			},
+		},
+		Spec: cloudcontrolv1beta1.RedisUserSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: redisUser.Namespace,
+				Name:      redisUser.Name,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			Redis: cloudcontrolv1beta1.RedisUserTarget{
+				Kind: kcpRedisKind,
+				Name: state.SkrRedisId,
+			},
+			UserName:    redisUser.Name,
+			KeyPatterns: redisUser.Spec.KeyPatterns,
+			Commands:    redisUser.Spec.Commands,
+			Azure:       azure,
+		},
+	}
+
+	tracing.InjectIntoObj(ctx, state.KcpRedisUser)
+	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpRedisUser)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP RedisUser", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP RedisUser")
+
+	redisUser.Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(redisUser).
+		ErrorLogMessage("Error setting Creating state on RedisUser").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
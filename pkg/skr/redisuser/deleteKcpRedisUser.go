@@ -0,0 +1,56 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteKcpRedisUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpRedisUser == nil {
+		return nil, ctx
+	}
+
+	if composed.IsMarkedForDeletion(state.KcpRedisUser) {
+		return nil, ctx
+	}
+
+	redisUser := state.ObjAsRedisUser()
+
+	err, _ := composed.UpdateStatus(redisUser).
+		SetCondition(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeDeleting,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonDeletingInstance,
+			Message: fmt.Sprintf("Deleting RedisUser %s", state.Name()),
+		}).
+		ErrorLogMessage("Error setting ConditionReasonDeletingInstance condition on RedisUser").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+	if err != nil {
+		return err, ctx
+	}
+
+	logger.Info("Deleting KCP RedisUser")
+
+	err = state.KcpCluster.K8sClient().Delete(ctx, state.KcpRedisUser)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error deleting KCP RedisUser", composed.StopWithRequeue, ctx)
+	}
+
+	redisUser.Status.State = cloudresourcesv1beta1.StateDeleting
+	err = state.UpdateObjStatus(ctx)
+
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Failed status update on RedisUser", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
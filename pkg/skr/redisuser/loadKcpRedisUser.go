@@ -0,0 +1,38 @@
+package redisuser
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func loadKcpRedisUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.ObjAsRedisUser().Status.Id == "" {
+		// deleted before the id was assigned, there's nothing in KCP
+		return nil, ctx
+	}
+
+	kcpRedisUser := &cloudcontrolv1beta1.RedisUser{}
+	err := state.KcpCluster.K8sClient().Get(ctx, types.NamespacedName{
+		Namespace: state.KymaRef.Namespace,
+		Name:      state.ObjAsRedisUser().Status.Id,
+	}, kcpRedisUser)
+	if apierrors.IsNotFound(err) {
+		state.KcpRedisUser = nil
+		logger.Info("KCP RedisUser does not exist")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading KCP RedisUser", composed.StopWithRequeue, ctx)
+	}
+
+	state.KcpRedisUser = kcpRedisUser
+
+	return nil, ctx
+}
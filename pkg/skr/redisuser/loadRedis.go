@@ -0,0 +1,79 @@
+package redisuser
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// loadRedis loads the referred SKR redis, that must be Ready before the KCP RedisUser
+// is created, since its status.id is the name of the KCP redis the user is created in.
+func loadRedis(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.KcpRedisUser != nil {
+		return nil, ctx
+	}
+
+	redisUser := state.ObjAsRedisUser()
+	ref := redisUser.Spec.RedisRef
+
+	obj, _, ok := newSkrRedis(ref.Kind)
+	if !ok {
+		return updateStatusRedisError(ctx, state,
+			cloudresourcesv1beta1.ConditionReasonError,
+			fmt.Sprintf("Unsupported redis kind %s", ref.Kind),
+			composed.StopAndForget,
+		)
+	}
+
+	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{
+		Namespace: redisUser.Namespace,
+		Name:      ref.Name,
+	}, obj)
+	if apierrors.IsNotFound(err) {
+		return updateStatusRedisError(ctx, state,
+			cloudresourcesv1beta1.ConditionReasonRedisNotFound,
+			fmt.Sprintf("The %s %s does not exist", ref.Kind, ref.Name),
+			composed.StopWithRequeueDelay(util.Timing.T60000ms()),
+		)
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading SKR redis referred by RedisUser", composed.StopWithRequeue, ctx)
+	}
+
+	state.SkrRedis = obj.(composed.ObjWithConditionsAndState)
+	state.SkrRedisId = getSkrRedisId(obj)
+
+	if state.SkrRedisId == "" || state.SkrRedis.State() != cloudresourcesv1beta1.StateReady {
+		return updateStatusRedisError(ctx, state,
+			cloudresourcesv1beta1.ConditionReasonRedisNotReady,
+			fmt.Sprintf("The %s %s is not ready", ref.Kind, ref.Name),
+			composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+		)
+	}
+
+	return nil, ctx
+}
+
+func updateStatusRedisError(ctx context.Context, state *State, reason, message string, result error) (error, context.Context) {
+	redisUser := state.ObjAsRedisUser()
+	redisUser.Status.State = cloudresourcesv1beta1.StateError
+
+	return composed.UpdateStatus(redisUser).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  reason,
+			Message: message,
+		}).
+		ErrorLogMessage("Error updating SKR RedisUser status with redis error").
+		SuccessError(result).
+		Run(ctx, state)
+}
@@ -0,0 +1,31 @@
+package redisuser
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func modifyKcpRedisUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if !state.ShouldModifyKcp() {
+		return nil, ctx
+	}
+
+	redisUser := state.ObjAsRedisUser()
+
+	state.KcpRedisUser.Spec.KeyPatterns = redisUser.Spec.KeyPatterns
+	state.KcpRedisUser.Spec.Commands = redisUser.Spec.Commands
+
+	err := state.KcpCluster.K8sClient().Update(ctx, state.KcpRedisUser)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP RedisUser", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("KCP RedisUser modified")
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
@@ -0,0 +1,109 @@
+package redisuser
+
+import (
+	"maps"
+	"net"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newSkrRedis returns an empty SKR redis object of the referred kind and the kind
+// of its KCP counterpart
+func newSkrRedis(kind cloudresourcesv1beta1.RedisUserRedisKind) (client.Object, cloudcontrolv1beta1.RedisUserTargetKind, bool) {
+	switch kind {
+	case cloudresourcesv1beta1.RedisUserRedisKindAwsRedisInstance:
+		return &cloudresourcesv1beta1.AwsRedisInstance{}, cloudcontrolv1beta1.RedisUserTargetKindRedisInstance, true
+	case cloudresourcesv1beta1.RedisUserRedisKindAwsRedisCluster:
+		return &cloudresourcesv1beta1.AwsRedisCluster{}, cloudcontrolv1beta1.RedisUserTargetKindRedisCluster, true
+	case cloudresourcesv1beta1.RedisUserRedisKindAzureManagedRedis:
+		return &cloudresourcesv1beta1.AzureManagedRedis{}, cloudcontrolv1beta1.RedisUserTargetKindAzureManagedRedis, true
+	}
+	return nil, "", false
+}
+
+func getSkrRedisId(obj client.Object) string {
+	switch x := obj.(type) {
+	case *cloudresourcesv1beta1.AwsRedisInstance:
+		return x.Status.Id
+	case *cloudresourcesv1beta1.AwsRedisCluster:
+		return x.Status.Id
+	case *cloudresourcesv1beta1.AzureManagedRedis:
+		return x.Status.Id
+	}
+	return ""
+}
+
+func getAuthSecretName(redisUser *cloudresourcesv1beta1.RedisUser) string {
+	if redisUser.Spec.AuthSecret != nil && len(redisUser.Spec.AuthSecret.Name) > 0 {
+		return redisUser.Spec.AuthSecret.Name
+	}
+
+	return redisUser.Name
+}
+
+func getAuthSecretLabels(redisUser *cloudresourcesv1beta1.RedisUser) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if redisUser.Spec.AuthSecret != nil {
+		for labelName, labelValue := range redisUser.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelRedisUserStatusId, redisUser.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelRedisUserNamespace, redisUser.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+
+	return labelsBuilder.Build()
+}
+
+func getAuthSecretAnnotations(redisUser *cloudresourcesv1beta1.RedisUser) map[string]string {
+	if redisUser.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, redisUser.Spec.AuthSecret.Annotations)
+	return result
+}
+
+// getAuthSecretBaseData returns the username and the endpoint of the user. The authString
+// is empty for Azure, where the workload authenticates with an Entra ID token, and the
+// username is the object id of its principal.
+func getAuthSecretBaseData(kcpRedisUser *cloudcontrolv1beta1.RedisUser) map[string][]byte {
+	result := map[string][]byte{}
+
+	if kcpRedisUser.Spec.Azure != nil {
+		result["username"] = []byte(kcpRedisUser.Spec.Azure.ObjectId)
+	} else {
+		result["username"] = []byte(kcpRedisUser.Spec.UserName)
+	}
+
+	if len(kcpRedisUser.Status.Endpoint) > 0 {
+		result["endpoint"] = []byte(kcpRedisUser.Status.Endpoint)
+
+		host, port, err := net.SplitHostPort(kcpRedisUser.Status.Endpoint)
+		if err == nil {
+			result["host"] = []byte(host)
+			result["port"] = []byte(port)
+		}
+	}
+
+	if len(kcpRedisUser.Status.AuthString) > 0 {
+		result["authString"] = []byte(kcpRedisUser.Status.AuthString)
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
@@ -9,10 +9,19 @@ import (
 var NewLooper = looper.New
 var NewActiveSkrCollection = looper.NewActiveSkrCollection
 var NewNotificationListener = looper.NewNotificationListener
+var NewLeaseShard = looper.NewLeaseShard
+var NewShardForwardServer = looper.NewShardForwardServer
+var NewAdminServer = looper.NewAdminServer
+var NewKubeAdminAuth = looper.NewKubeAdminAuth
+
+type ShardOptions = looper.ShardOptions
+type AdminServerOptions = looper.AdminServerOptions
 
 // NotificationComponentName is the runtime-watcher component name for cloud-manager.
 const NotificationComponentName = looper.NotificationComponentName
 
+const ShardTokenAudience = looper.ShardTokenAudience
+
 //var ActiveSkrCollectionToCtx = looper.ActiveSkrCollectionToCtx
 //var ActiveSkrCollectionFromCtx = looper.ActiveSkrCollectionFromCtx
 
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"time"
 
 	"github.com/kyma-project/cloud-manager/pkg/config"
@@ -12,6 +13,10 @@ type ConfigStruct struct {
 	SkrGateConflictRetryDelay time.Duration
 	SkrWorkerTimeout          time.Duration
 	SkrNotifMinInterval       time.Duration
+	SkrShardLeaseDuration     time.Duration
+	SkrShardRenewInterval     time.Duration
+	SkrPriorityMinInterval    time.Duration
+	SkrPriorityBrokerPlans    []string
 
 	ProvidersDir         string `yaml:"providersDir,omitempty" json:"providersDir,omitempty"`
 	Concurrency          int    `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`
@@ -31,6 +36,38 @@ type ConfigStruct struct {
 	// dropped (coalesced), so a hot SKR cannot monopolize the notification sleeve. It does NOT
 	// bound the cyclic sleeve — every SKR still gets its normal fair cyclic turn.
 	NotifMinInterval string `yaml:"notifMinInterval,omitempty" json:"notifMinInterval,omitempty"`
+
+	// Sharding partitions active SKRs across cloud-manager replicas. Each replica holds a
+	// membership lease and owns the SKRs that rendezvous-hash onto it. ShardReplicaAddr is the
+	// host:port other replicas use to forward notifications for SKRs this replica owns, and
+	// ShardForwardAddr is the local bind address of that forward endpoint.
+	ShardingEnabled    bool   `yaml:"shardingEnabled,omitempty" json:"shardingEnabled,omitempty"`
+	ShardLeaseDuration string `yaml:"shardLeaseDuration,omitempty" json:"shardLeaseDuration,omitempty"`
+	ShardRenewInterval string `yaml:"shardRenewInterval,omitempty" json:"shardRenewInterval,omitempty"`
+	ShardForwardAddr   string `yaml:"shardForwardAddr,omitempty" json:"shardForwardAddr,omitempty"`
+	ShardReplicaAddr   string `yaml:"shardReplicaAddr,omitempty" json:"shardReplicaAddr,omitempty"`
+	// ShardTokenFile is the projected service account token with the cloud-manager-skr-looper-shard
+	// audience forwarded notifications are authenticated with. It is dedicated to the forward
+	// endpoint, since it is sent to peers over plain http.
+	ShardTokenFile string `yaml:"shardTokenFile,omitempty" json:"shardTokenFile,omitempty"`
+
+	// Priority lane. SKRs on a PriorityBrokerPlans plan (comma separated) and SKRs whose last
+	// connect saw objects being deleted or in Error state are additionally served by a
+	// dedicated pool of PriorityConcurrency workers, each at most once per PriorityMinInterval.
+	// The lane never touches the cyclic rotation, so every SKR keeps its fair cyclic turn.
+	// A zero PriorityConcurrency disables the lane.
+	PriorityConcurrency int    `yaml:"priorityConcurrency,omitempty" json:"priorityConcurrency,omitempty"`
+	PriorityMinInterval string `yaml:"priorityMinInterval,omitempty" json:"priorityMinInterval,omitempty"`
+	PriorityBrokerPlans string `yaml:"priorityBrokerPlans,omitempty" json:"priorityBrokerPlans,omitempty"`
+
+	// AdminAddr is the bind address of the SKR looper admin API, authenticated with
+	// TokenReviews and authorized with SubjectAccessReviews on its non-resource URLs.
+	// Empty disables the admin API. Unless AdminCertFile and AdminKeyFile are set, the
+	// admin API is served only on the loopback interface and reached with
+	// kubectl port-forward, so bearer tokens are never sent over the network in plain text.
+	AdminAddr     string `yaml:"adminAddr,omitempty" json:"adminAddr,omitempty"`
+	AdminCertFile string `yaml:"adminCertFile,omitempty" json:"adminCertFile,omitempty"`
+	AdminKeyFile  string `yaml:"adminKeyFile,omitempty" json:"adminKeyFile,omitempty"`
 }
 
 func (c *ConfigStruct) AfterConfigLoaded() {
@@ -70,6 +107,29 @@ func (c *ConfigStruct) AfterConfigLoaded() {
 	if c.NotificationListenerAddr == "" {
 		c.NotificationListenerAddr = ":8083"
 	}
+
+	c.SkrShardLeaseDuration = max(GetDuration(c.ShardLeaseDuration, 30*time.Second), time.Second)
+	// Renew well within the lease duration so a live replica never appears expired to its peers.
+	c.SkrShardRenewInterval = min(GetDuration(c.ShardRenewInterval, 10*time.Second), c.SkrShardLeaseDuration/2)
+
+	if c.ShardForwardAddr == "" {
+		c.ShardForwardAddr = ":8084"
+	}
+
+	if c.PriorityConcurrency < 0 {
+		c.PriorityConcurrency = 0
+	}
+	if c.PriorityConcurrency > 100 {
+		c.PriorityConcurrency = 100
+	}
+	// Floor-clamp so a priority SKR can not hot-loop its lane on misconfiguration.
+	c.SkrPriorityMinInterval = max(GetDuration(c.PriorityMinInterval, 30*time.Second), time.Second)
+	c.SkrPriorityBrokerPlans = nil
+	for _, plan := range strings.Split(c.PriorityBrokerPlans, ",") {
+		if plan = strings.TrimSpace(plan); plan != "" {
+			c.SkrPriorityBrokerPlans = append(c.SkrPriorityBrokerPlans, plan)
+		}
+	}
 }
 
 var SkrRuntimeConfig = &ConfigStruct{}
@@ -125,6 +185,61 @@ func InitConfig(cfg config.Config) {
 			config.DefaultScalar("10s"),
 			config.SourceEnv("SKR_RUNTIME_NOTIF_MIN_INTERVAL"),
 		),
+		config.Path(
+			"shardingEnabled",
+			config.DefaultScalar(false),
+			config.SourceEnv("SKR_RUNTIME_SHARDING_ENABLED"),
+		),
+		config.Path(
+			"shardLeaseDuration",
+			config.DefaultScalar("30s"),
+			config.SourceEnv("SKR_RUNTIME_SHARD_LEASE_DURATION"),
+		),
+		config.Path(
+			"shardRenewInterval",
+			config.DefaultScalar("10s"),
+			config.SourceEnv("SKR_RUNTIME_SHARD_RENEW_INTERVAL"),
+		),
+		config.Path(
+			"shardForwardAddr",
+			config.DefaultScalar(":8084"),
+			config.SourceEnv("SKR_RUNTIME_SHARD_FORWARD_ADDR"),
+		),
+		config.Path(
+			"shardReplicaAddr",
+			config.SourceEnv("SKR_RUNTIME_SHARD_REPLICA_ADDR"),
+		),
+		config.Path(
+			"shardTokenFile",
+			config.DefaultScalar("/var/run/secrets/cloud-manager.kyma-project.io/skr-looper-shard/token"),
+			config.SourceEnv("SKR_RUNTIME_SHARD_TOKEN_FILE"),
+		),
+		config.Path(
+			"priorityConcurrency",
+			config.DefaultScalar(2),
+			config.SourceEnv("SKR_RUNTIME_PRIORITY_CONCURRENCY"),
+		),
+		config.Path(
+			"priorityMinInterval",
+			config.DefaultScalar("30s"),
+			config.SourceEnv("SKR_RUNTIME_PRIORITY_MIN_INTERVAL"),
+		),
+		config.Path(
+			"priorityBrokerPlans",
+			config.SourceEnv("SKR_RUNTIME_PRIORITY_BROKER_PLANS"),
+		),
+		config.Path(
+			"adminAddr",
+			config.SourceEnv("SKR_RUNTIME_ADMIN_ADDR"),
+		),
+		config.Path(
+			"adminCertFile",
+			config.SourceEnv("SKR_RUNTIME_ADMIN_CERT_FILE"),
+		),
+		config.Path(
+			"adminKeyFile",
+			config.SourceEnv("SKR_RUNTIME_ADMIN_KEY_FILE"),
+		),
 		config.SourceFile("skrRuntime.yaml"),
 		config.Bind(SkrRuntimeConfig),
 	)
@@ -76,6 +76,38 @@ func TestConfigDefaults(t *testing.T) {
 	assert.Equal(t, ":8083", SkrRuntimeConfig.NotificationListenerAddr)
 	assert.Equal(t, 1*time.Second, SkrRuntimeConfig.SkrGateConflictRetryDelay)
 	assert.Equal(t, 10*time.Minute, SkrRuntimeConfig.SkrWorkerTimeout)
+	assert.False(t, SkrRuntimeConfig.ShardingEnabled)
+	assert.Equal(t, 30*time.Second, SkrRuntimeConfig.SkrShardLeaseDuration)
+	assert.Equal(t, 10*time.Second, SkrRuntimeConfig.SkrShardRenewInterval)
+	assert.Equal(t, ":8084", SkrRuntimeConfig.ShardForwardAddr)
+	assert.Equal(t, 2, SkrRuntimeConfig.PriorityConcurrency)
+	assert.Equal(t, 30*time.Second, SkrRuntimeConfig.SkrPriorityMinInterval)
+	assert.Empty(t, SkrRuntimeConfig.SkrPriorityBrokerPlans)
+	assert.Empty(t, SkrRuntimeConfig.AdminAddr, "the admin API is disabled by default")
+}
+
+func TestPriorityLaneFromFile(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cloud-manager-config")
+	assert.NoError(t, err, "error creating tmp dir")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	err = os.WriteFile(filepath.Join(dir, "skrRuntime.yaml"), []byte(`
+priorityConcurrency: 4
+priorityMinInterval: 100ms
+priorityBrokerPlans: "aws, gcp ,,azure"
+`), 0644)
+	assert.NoError(t, err, "error creating key file")
+
+	env := abstractions.NewMockedEnvironment(map[string]string{})
+	cfg := config.NewConfig(env)
+	cfg.BaseDir(dir)
+	InitConfig(cfg)
+	cfg.Read()
+
+	assert.Equal(t, 4, SkrRuntimeConfig.PriorityConcurrency)
+	assert.Equal(t, time.Second, SkrRuntimeConfig.SkrPriorityMinInterval)
+	assert.Equal(t, []string{"aws", "gcp", "azure"}, SkrRuntimeConfig.SkrPriorityBrokerPlans)
 }
 
 func TestGateConflictRetryDelayFloor(t *testing.T) {
@@ -0,0 +1,167 @@
+package looper
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// heldRecheckDelay is how long a paused SKR, or any SKR while the looper is draining,
+// waits before its cyclic or priority turn is looked at again.
+const heldRecheckDelay = 5 * time.Second
+
+// SkrInfo is the looper's view of one active SKR, as listed by the admin API.
+type SkrInfo struct {
+	Kyma string `json:"kyma"`
+	// CyclicPosition is the position in the cyclic queue. It is nil while the SKR waits
+	// for its cyclic min interval or is being connected.
+	CyclicPosition *int `json:"cyclicPosition,omitempty"`
+	// NotificationPosition is the position in the notification queue, nil if not queued.
+	NotificationPosition *int `json:"notificationPosition,omitempty"`
+	Priority             bool `json:"priority,omitempty"`
+	Paused               bool `json:"paused,omitempty"`
+	// Connecting is true while a worker holds the SkrGate claim of the SKR, GateOwner
+	// names that worker, ie "cyclic/3".
+	Connecting  bool       `json:"connecting,omitempty"`
+	GateOwner   string     `json:"gateOwner,omitempty"`
+	LastConnect *time.Time `json:"lastConnect,omitempty"`
+}
+
+// Pause stops connecting kymaName until Resume is called. It stays active and keeps its
+// place in the rotation bookkeeping. Returns false if kymaName is not looped here.
+func (l *activeSkrCollection) Pause(kymaName string) bool {
+	if !l.cyclicQueue.Contains(kymaName) {
+		return false
+	}
+	l.adminMu.Lock()
+	defer l.adminMu.Unlock()
+	l.paused[kymaName] = struct{}{}
+	return true
+}
+
+// Resume undoes Pause. Returns false if kymaName is not looped here.
+func (l *activeSkrCollection) Resume(kymaName string) bool {
+	if !l.cyclicQueue.Contains(kymaName) {
+		return false
+	}
+	l.adminMu.Lock()
+	defer l.adminMu.Unlock()
+	delete(l.paused, kymaName)
+	return true
+}
+
+func (l *activeSkrCollection) isPaused(kymaName string) bool {
+	l.adminMu.Lock()
+	defer l.adminMu.Unlock()
+	_, ok := l.paused[kymaName]
+	return ok
+}
+
+// SetDraining holds back every new connect while on, so the connects in flight can
+// finish before the replica is stopped. Queues and membership are left intact.
+func (l *activeSkrCollection) SetDraining(draining bool) {
+	l.draining.Store(draining)
+}
+
+func (l *activeSkrCollection) Draining() bool {
+	return l.draining.Load()
+}
+
+func (l *activeSkrCollection) isHeld(kymaName string) bool {
+	return l.Draining() || l.isPaused(kymaName)
+}
+
+// Reconnect queues an immediate full connect of kymaName on the notification sleeve,
+// bypassing the notification rate limiter.
+func (l *activeSkrCollection) Reconnect(kymaName string) error {
+	if !l.cyclicQueue.Contains(kymaName) {
+		return fmt.Errorf("%w: %s", ErrSkrNotLooped, kymaName)
+	}
+	if l.isHeld(kymaName) {
+		return fmt.Errorf("%w: %s", ErrSkrHeld, kymaName)
+	}
+	l.notifMu.Lock()
+	pending, ok := l.notifPending[kymaName]
+	if !ok {
+		pending = &pendingNotif{}
+		l.notifPending[kymaName] = pending
+	}
+	pending.add(nil)
+	l.notifMu.Unlock()
+	l.notifQueue.Add(kymaName)
+	return nil
+}
+
+// recordConnect stamps the time a connect of kymaName completed, on any sleeve.
+func (l *activeSkrCollection) recordConnect(kymaName string) {
+	l.adminMu.Lock()
+	defer l.adminMu.Unlock()
+	l.lastConnect[kymaName] = l.clock.Now()
+}
+
+// forgetAdminState drops the admin state of a deactivated SKR.
+func (l *activeSkrCollection) forgetAdminState(kymaName string) {
+	l.adminMu.Lock()
+	defer l.adminMu.Unlock()
+	delete(l.paused, kymaName)
+	delete(l.lastConnect, kymaName)
+}
+
+// SkrInfos lists the SKRs looped by this replica, sorted by name.
+func (l *activeSkrCollection) SkrInfos() []SkrInfo {
+	cyclicPositions := l.cyclicQueue.Positions()
+	notifPositions := l.notifQueue.Positions()
+	owners := l.gate.Owners()
+
+	kymaNames := l.cyclicQueue.Items()
+	slices.Sort(kymaNames)
+
+	l.adminMu.Lock()
+	defer l.adminMu.Unlock()
+
+	result := make([]SkrInfo, 0, len(kymaNames))
+	for _, kymaName := range kymaNames {
+		info := SkrInfo{
+			Kyma:     kymaName,
+			Priority: l.isPriority(kymaName),
+		}
+		if pos, ok := cyclicPositions[kymaName]; ok {
+			info.CyclicPosition = &pos
+		}
+		if pos, ok := notifPositions[kymaName]; ok {
+			info.NotificationPosition = &pos
+		}
+		_, info.Paused = l.paused[kymaName]
+		info.GateOwner, info.Connecting = owners[kymaName]
+		if t, ok := l.lastConnect[kymaName]; ok {
+			info.LastConnect = &t
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+// InFlight is the number of connects in progress.
+func (l *activeSkrCollection) InFlight() int {
+	return len(l.gate.Owners())
+}
+
+// =====================================================================
+
+// holdReAdd takes care of an SKR that is paused or whose turn came while draining. The
+// notification is dropped, a resumed SKR gets its full sync on the cyclic sleeve. The
+// cyclic and priority turns are looked at again after heldRecheckDelay.
+func (l *skrLooper) holdReAdd(q *Queue, sleeve string, kymaName string) {
+	switch sleeve {
+	case "notification":
+		return
+	case "priority":
+		if !l.isPriority(kymaName) {
+			return
+		}
+	}
+	if !l.Contains(kymaName) {
+		return
+	}
+	q.AddAfter(kymaName, heldRecheckDelay)
+}
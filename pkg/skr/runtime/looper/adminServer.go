@@ -0,0 +1,282 @@
+package looper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// adminPathPrefix prefixes all admin API paths. They are authorized as non-resource URLs,
+// so an operator needs a ClusterRole with nonResourceURLs ["/skr-looper/v1/admin/*"].
+const adminPathPrefix = "/skr-looper/v1/admin"
+
+var (
+	ErrSkrNotLooped = errors.New("SKR is not looped by this replica")
+	ErrSkrHeld      = errors.New("SKR is paused or the looper is draining")
+)
+
+// SkrLooperAdmin is the part of the active SKR collection steered by the admin API.
+type SkrLooperAdmin interface {
+	SkrInfos() []SkrInfo
+	InFlight() int
+	Reconnect(kymaName string) error
+	Pause(kymaName string) bool
+	Resume(kymaName string) bool
+	SetDraining(draining bool)
+	Draining() bool
+}
+
+// AdminAuth wraps the admin API and the shard forward handlers with authentication and authorization.
+type AdminAuth func(next http.Handler) http.Handler
+
+type AdminServerOptions struct {
+	// Addr is the bind address of the admin API. Without TLS, the host is replaced with the
+	// loopback address, so the bearer tokens are never sent in plain text over the network,
+	// and the admin API is reached with kubectl port-forward.
+	Addr string
+	// CertFile and KeyFile are the serving certificate and key of the admin API. When both
+	// are set, the admin API is served over TLS on Addr as it is.
+	CertFile string
+	KeyFile  string
+}
+
+func (o AdminServerOptions) tlsEnabled() bool {
+	return o.CertFile != "" && o.KeyFile != ""
+}
+
+// adminServer is a manager.Runnable serving the SKR looper admin API. It runs on every
+// replica and acts on the SKRs looped by that replica only.
+type adminServer struct {
+	opts   AdminServerOptions
+	admin  SkrLooperAdmin
+	auth   AdminAuth
+	logger logr.Logger
+}
+
+var _ manager.Runnable = &adminServer{}
+var _ manager.LeaderElectionRunnable = &adminServer{}
+
+func NewAdminServer(opts AdminServerOptions, admin SkrLooperAdmin, auth AdminAuth, logger logr.Logger) *adminServer {
+	return &adminServer{
+		opts:   opts,
+		admin:  admin,
+		auth:   auth,
+		logger: logger.WithName("skr-looper-admin"),
+	}
+}
+
+func (s *adminServer) NeedLeaderElection() bool {
+	return false
+}
+
+type adminSkrsResponse struct {
+	Draining bool      `json:"draining"`
+	InFlight int       `json:"inFlight"`
+	Skrs     []SkrInfo `json:"skrs"`
+}
+
+type adminDrainResponse struct {
+	Draining bool `json:"draining"`
+	InFlight int  `json:"inFlight"`
+}
+
+// Handler returns the admin API routes, wrapped with auth when set:
+//
+//	GET    /skr-looper/v1/admin/skrs                 lists the looped SKRs
+//	POST   /skr-looper/v1/admin/skrs/{kyma}/reconnect queues an immediate full connect
+//	POST   /skr-looper/v1/admin/skrs/{kyma}/pause     stops connecting the SKR
+//	POST   /skr-looper/v1/admin/skrs/{kyma}/resume    undoes pause
+//	GET    /skr-looper/v1/admin/drain                 reports draining and connects in flight
+//	POST   /skr-looper/v1/admin/drain                 holds back all new connects
+//	DELETE /skr-looper/v1/admin/drain                 ends draining
+func (s *adminServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+adminPathPrefix+"/skrs", func(w http.ResponseWriter, r *http.Request) {
+		s.writeJSON(w, http.StatusOK, adminSkrsResponse{
+			Draining: s.admin.Draining(),
+			InFlight: s.admin.InFlight(),
+			Skrs:     s.admin.SkrInfos(),
+		})
+	})
+	mux.HandleFunc("POST "+adminPathPrefix+"/skrs/{kyma}/reconnect", func(w http.ResponseWriter, r *http.Request) {
+		kymaName := r.PathValue("kyma")
+		err := s.admin.Reconnect(kymaName)
+		switch {
+		case errors.Is(err, ErrSkrNotLooped):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, ErrSkrHeld):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			s.logger.Info("SKR reconnect requested", "kyma", kymaName)
+			w.WriteHeader(http.StatusAccepted)
+		}
+	})
+	mux.HandleFunc("POST "+adminPathPrefix+"/skrs/{kyma}/pause", func(w http.ResponseWriter, r *http.Request) {
+		s.pauseOrResume(w, r.PathValue("kyma"), s.admin.Pause, "SKR paused")
+	})
+	mux.HandleFunc("POST "+adminPathPrefix+"/skrs/{kyma}/resume", func(w http.ResponseWriter, r *http.Request) {
+		s.pauseOrResume(w, r.PathValue("kyma"), s.admin.Resume, "SKR resumed")
+	})
+	mux.HandleFunc(adminPathPrefix+"/drain", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			s.admin.SetDraining(true)
+			s.logger.Info("SKR looper draining")
+		case http.MethodDelete:
+			s.admin.SetDraining(false)
+			s.logger.Info("SKR looper draining ended")
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, adminDrainResponse{
+			Draining: s.admin.Draining(),
+			InFlight: s.admin.InFlight(),
+		})
+	})
+
+	if s.auth == nil {
+		return mux
+	}
+	return s.auth(mux)
+}
+
+func (s *adminServer) pauseOrResume(w http.ResponseWriter, kymaName string, fn func(string) bool, msg string) {
+	if !fn(kymaName) {
+		http.Error(w, ErrSkrNotLooped.Error(), http.StatusNotFound)
+		return
+	}
+	s.logger.Info(msg, "kyma", kymaName)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *adminServer) writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		s.logger.V(1).Info("Error writing SKR looper admin response", "error", err.Error())
+	}
+}
+
+func (s *adminServer) Start(ctx context.Context) error {
+	addr := s.opts.Addr
+	if !s.opts.tlsEnabled() {
+		addr = loopbackAddr(addr)
+	}
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+	s.logger.Info("SKR looper admin server started", "addr", addr, "tls", s.opts.tlsEnabled())
+	var err error
+	if s.opts.tlsEnabled() {
+		err = srv.ListenAndServeTLS(s.opts.CertFile, s.opts.KeyFile)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// loopbackAddr replaces the host of addr with the loopback address, unless it already is one.
+func loopbackAddr(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	if host == "localhost" {
+		return addr
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return addr
+	}
+	return net.JoinHostPort("127.0.0.1", port)
+}
+
+// =====================================================================
+
+// NewKubeAdminAuth authenticates the bearer token of each request with a TokenReview
+// and authorizes the request path and method with a non-resource SubjectAccessReview, the
+// same way the kube-apiserver authorizes its own non-resource URLs. The manager needs to be
+// allowed to create tokenreviews and subjectaccessreviews. When audiences are given, only
+// tokens issued for one of them are accepted, otherwise the kube-apiserver audience is required.
+func NewKubeAdminAuth(c client.Client, audiences ...string) AdminAuth {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			tr := &authenticationv1.TokenReview{
+				Spec: authenticationv1.TokenReviewSpec{
+					Token:     token,
+					Audiences: audiences,
+				},
+			}
+			if err := c.Create(r.Context(), tr); err != nil {
+				http.Error(w, "error reviewing token", http.StatusInternalServerError)
+				return
+			}
+			if !tr.Status.Authenticated {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			// the authenticator may ignore the requested audiences, so they are verified here
+			if len(audiences) > 0 && !slices.ContainsFunc(tr.Status.Audiences, func(a string) bool {
+				return slices.Contains(audiences, a)
+			}) {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			extra := make(map[string]authorizationv1.ExtraValue, len(tr.Status.User.Extra))
+			for k, v := range tr.Status.User.Extra {
+				extra[k] = authorizationv1.ExtraValue(v)
+			}
+			sar := &authorizationv1.SubjectAccessReview{
+				Spec: authorizationv1.SubjectAccessReviewSpec{
+					User:   tr.Status.User.Username,
+					UID:    tr.Status.User.UID,
+					Groups: tr.Status.User.Groups,
+					Extra:  extra,
+					NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+						Path: r.URL.Path,
+						Verb: strings.ToLower(r.Method),
+					},
+				},
+			}
+			if err := c.Create(r.Context(), sar); err != nil {
+				http.Error(w, "error reviewing access", http.StatusInternalServerError)
+				return
+			}
+			if !sar.Status.Allowed {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
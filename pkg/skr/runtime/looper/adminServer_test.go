@@ -0,0 +1,193 @@
+package looper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func adminRequest(t *testing.T, h http.Handler, method, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+// TestAdminListSkrs: the listing reports queue positions, the gate owner and the last
+// connect stamped on the fake clock.
+func TestAdminListSkrs(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	col := newTestCollection(fakeClock)
+	l := newTestLooper(col, func(int, string) {})
+	h := NewAdminServer(AdminServerOptions{}, col, nil, logr.Discard()).Handler()
+
+	col.AddKyma(context.Background(), kymaObj("k1"))
+	col.AddKyma(context.Background(), kymaObj("k2"))
+	require.False(t, l.processOne(0, col.CyclicQueue(), "cyclic", l.cyclicReAdd, col.CyclicQueue().Add))
+	col.Gate().TryClaimBy("k1", "notification/1")
+
+	rec := adminRequest(t, h, http.MethodGet, adminPathPrefix+"/skrs")
+	require.Equal(t, http.StatusOK, rec.Code)
+	resp := adminSkrsResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	assert.Equal(t, 1, resp.InFlight)
+	require.Len(t, resp.Skrs, 2)
+	k1, k2 := resp.Skrs[0], resp.Skrs[1]
+	assert.Equal(t, "k1", k1.Kyma)
+	require.NotNil(t, k1.LastConnect)
+	assert.True(t, k1.LastConnect.Equal(fakeClock.Now()))
+	assert.True(t, k1.Connecting)
+	assert.Equal(t, "notification/1", k1.GateOwner)
+	require.NotNil(t, k1.CyclicPosition)
+	assert.Equal(t, 1, *k1.CyclicPosition, "k1 was re-added behind k2")
+	require.NotNil(t, k2.CyclicPosition)
+	assert.Equal(t, 0, *k2.CyclicPosition)
+	assert.Nil(t, k2.LastConnect)
+	assert.False(t, k2.Connecting)
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+	col.PriorityQueue().ShutDown()
+}
+
+// TestAdminPauseResumeAndReconnect: a paused SKR is not connected and keeps its cyclic
+// membership; reconnect is refused until resumed, then queues a full notification connect
+// bypassing the notification rate limiter.
+func TestAdminPauseResumeAndReconnect(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	col := newTestCollection(fakeClock)
+	col.notifMinInterval = time.Hour
+	var calls int64
+	l := newTestLooper(col, func(int, string) { atomic.AddInt64(&calls, 1) })
+	h := NewAdminServer(AdminServerOptions{}, col, nil, logr.Discard()).Handler()
+
+	col.AddKyma(context.Background(), kymaObj("k"))
+	col.recordNotifConnect("k")
+
+	assert.Equal(t, http.StatusNotFound, adminRequest(t, h, http.MethodPost, adminPathPrefix+"/skrs/unknown/pause").Code)
+	assert.Equal(t, http.StatusOK, adminRequest(t, h, http.MethodPost, adminPathPrefix+"/skrs/k/pause").Code)
+	assert.Equal(t, http.StatusConflict, adminRequest(t, h, http.MethodPost, adminPathPrefix+"/skrs/k/reconnect").Code)
+
+	base := fakeClock.Waiters()
+	require.False(t, l.processOne(0, col.CyclicQueue(), "cyclic", l.cyclicReAdd, col.CyclicQueue().Add))
+	assert.Equal(t, int64(0), atomic.LoadInt64(&calls), "a paused SKR is not connected")
+	assert.True(t, col.Contains("k"))
+	assert.Equal(t, 0, col.CyclicQueue().Len())
+	stepAfterWaiter(t, fakeClock, base, heldRecheckDelay)
+	assert.Eventually(t, func() bool { return col.CyclicQueue().Len() == 1 }, time.Second, 10*time.Millisecond,
+		"the held SKR is looked at again after heldRecheckDelay")
+
+	assert.Equal(t, http.StatusOK, adminRequest(t, h, http.MethodPost, adminPathPrefix+"/skrs/k/resume").Code)
+	assert.Equal(t, http.StatusAccepted, adminRequest(t, h, http.MethodPost, adminPathPrefix+"/skrs/k/reconnect").Code)
+	assert.Equal(t, 1, col.NotificationQueue().Len(), "reconnect bypasses the notification rate limiter")
+	assert.Nil(t, col.takeNotifTargets("k"), "reconnect is a full sync")
+
+	assert.Equal(t, http.StatusNotFound, adminRequest(t, h, http.MethodPost, adminPathPrefix+"/skrs/unknown/reconnect").Code)
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+	col.PriorityQueue().ShutDown()
+}
+
+// TestAdminDrain: while draining no new connect starts and the in-flight count is
+// reported, so a deploy can wait for it to reach zero.
+func TestAdminDrain(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	col := newTestCollection(fakeClock)
+	var calls int64
+	l := newTestLooper(col, func(int, string) { atomic.AddInt64(&calls, 1) })
+	h := NewAdminServer(AdminServerOptions{}, col, nil, logr.Discard()).Handler()
+
+	col.AddKyma(context.Background(), kymaObj("k1"))
+	col.AddKyma(context.Background(), kymaObj("k2"))
+	col.Gate().TryClaimBy("k2", "cyclic/0")
+
+	rec := adminRequest(t, h, http.MethodPost, adminPathPrefix+"/drain")
+	require.Equal(t, http.StatusOK, rec.Code)
+	resp := adminDrainResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.True(t, resp.Draining)
+	assert.Equal(t, 1, resp.InFlight)
+
+	require.False(t, l.processOne(0, col.CyclicQueue(), "cyclic", l.cyclicReAdd, col.CyclicQueue().Add))
+	assert.Equal(t, int64(0), atomic.LoadInt64(&calls), "no connect starts while draining")
+	assert.True(t, col.Contains("k1"))
+
+	col.Gate().Release("k2")
+	rec = adminRequest(t, h, http.MethodGet, adminPathPrefix+"/drain")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, 0, resp.InFlight)
+
+	rec = adminRequest(t, h, http.MethodDelete, adminPathPrefix+"/drain")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.False(t, resp.Draining)
+	require.False(t, l.processOne(0, col.CyclicQueue(), "cyclic", l.cyclicReAdd, col.CyclicQueue().Add))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+	col.PriorityQueue().ShutDown()
+}
+
+func TestKubeAdminAuth(t *testing.T) {
+	var sarAttrs *authorizationv1.NonResourceAttributes
+	c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			switch x := obj.(type) {
+			case *authenticationv1.TokenReview:
+				x.Status.Authenticated = x.Spec.Token != "bad"
+				x.Status.User.Username = x.Spec.Token
+			case *authorizationv1.SubjectAccessReview:
+				sarAttrs = x.Spec.NonResourceAttributes
+				x.Status.Allowed = x.Spec.User == "admin"
+			}
+			return nil
+		},
+	}).Build()
+
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	h := NewAdminServer(AdminServerOptions{}, col, NewKubeAdminAuth(c), logr.Discard()).Handler()
+
+	call := func(token string) int {
+		req := httptest.NewRequest(http.MethodPost, adminPathPrefix+"/drain", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, call(""))
+	assert.Equal(t, http.StatusUnauthorized, call("bad"))
+	assert.Equal(t, http.StatusForbidden, call("viewer"))
+	assert.False(t, col.Draining())
+	assert.Equal(t, http.StatusOK, call("admin"))
+	assert.True(t, col.Draining())
+	require.NotNil(t, sarAttrs)
+	assert.Equal(t, adminPathPrefix+"/drain", sarAttrs.Path)
+	assert.Equal(t, "post", sarAttrs.Verb)
+}
+
+func TestAdminServerLoopbackAddr(t *testing.T) {
+	assert.Equal(t, "127.0.0.1:8085", loopbackAddr(":8085"))
+	assert.Equal(t, "127.0.0.1:8085", loopbackAddr("0.0.0.0:8085"))
+	assert.Equal(t, "127.0.0.1:8085", loopbackAddr("10.0.0.7:8085"))
+	assert.Equal(t, "localhost:8085", loopbackAddr("localhost:8085"))
+	assert.Equal(t, "[::1]:8085", loopbackAddr("[::1]:8085"))
+}
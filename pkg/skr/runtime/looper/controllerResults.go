@@ -0,0 +1,125 @@
+package looper
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ControllerResult is the outcome of one controller during an SKR run.
+type ControllerResult struct {
+	ObjKindGroup      string
+	ObjectsReconciled int
+	ObjectsInError    int
+	LastError         string
+	Duration          time.Duration
+	TimedOut          bool
+}
+
+// Failing is true if the controller saw objects in Error state or got a reconcile error.
+func (r ControllerResult) Failing() bool {
+	return r.ObjectsInError > 0 || r.LastError != ""
+}
+
+// SkrRunResults aggregates the controller outcomes of one SKR run. Partial is set for
+// notification-driven runs narrowed to some controllers, their results only replace the
+// results of the controllers they ran.
+type SkrRunResults struct {
+	Controllers []ControllerResult
+	Partial     bool
+	TimedOut    bool
+}
+
+// =====================================================================
+
+// controllerRecorder collects the outcome of one controller during an SKR run. It is fed
+// by the ObserveObject and ObserveReconcile hooks of the controller's reconciler arguments,
+// which are called from the controller workers concurrently.
+type controllerRecorder struct {
+	objKindGroup string
+	// runCtx is the context of the whole SKR run, its deadline is the SKR worker timeout.
+	runCtx context.Context
+
+	mu         sync.Mutex
+	reconciled map[types.NamespacedName]struct{}
+	inError    map[types.NamespacedName]struct{}
+	lastError  string
+	duration   time.Duration
+	inFlight   int
+	timedOut   bool
+}
+
+func newControllerRecorder(runCtx context.Context, objKindGroup string) *controllerRecorder {
+	return &controllerRecorder{
+		objKindGroup: objKindGroup,
+		runCtx:       runCtx,
+		reconciled:   map[types.NamespacedName]struct{}{},
+		inError:      map[types.NamespacedName]struct{}{},
+	}
+}
+
+// Observe tracks the Error state of the objects of the reconciled kind.
+func (c *controllerRecorder) Observe(obj client.Object) {
+	x, ok := obj.(composed.ObjWithConditionsAndState)
+	if !ok {
+		return
+	}
+	key := client.ObjectKeyFromObject(obj)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if x.State() != cloudresourcesv1beta1.StateError {
+		delete(c.inError, key)
+		return
+	}
+	c.inError[key] = struct{}{}
+	if cond := meta.FindStatusCondition(*x.Conditions(), cloudresourcesv1beta1.ConditionTypeError); cond != nil && cond.Message != "" {
+		c.lastError = cond.Message
+	}
+}
+
+// Reconcile is called when a reconcile of request starts, the returned func when it returned.
+func (c *controllerRecorder) Reconcile(request ctrlreconcile.Request) func(err error) {
+	started := time.Now()
+	c.mu.Lock()
+	c.inFlight++
+	c.reconciled[request.NamespacedName] = struct{}{}
+	c.mu.Unlock()
+
+	return func(err error) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.inFlight--
+		c.duration += time.Since(started)
+		if errors.Is(c.runCtx.Err(), context.DeadlineExceeded) {
+			// the reconcile was still running when the worker timeout hit
+			c.timedOut = true
+		}
+		if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+			c.lastError = err.Error()
+		}
+	}
+}
+
+// Result snapshots the outcome. runTimedOut tells whether the run hit the worker timeout,
+// reconciles still in flight at that point count as timed out.
+func (c *controllerRecorder) Result(runTimedOut bool) ControllerResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return ControllerResult{
+		ObjKindGroup:      c.objKindGroup,
+		ObjectsReconciled: len(c.reconciled),
+		ObjectsInError:    len(c.inError),
+		LastError:         c.lastError,
+		Duration:          c.duration,
+		TimedOut:          c.timedOut || (runTimedOut && c.inFlight > 0),
+	}
+}
@@ -0,0 +1,145 @@
+package looper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrlreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func gcpNfsVolume(name string, state cloudresourcesv1beta1.GcpNfsVolumeState, errMsg string) *cloudresourcesv1beta1.GcpNfsVolume {
+	obj := &cloudresourcesv1beta1.GcpNfsVolume{}
+	obj.Name = name
+	obj.Namespace = "default"
+	obj.Status.State = state
+	if errMsg != "" {
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Error",
+			Message: errMsg,
+		})
+	}
+	return obj
+}
+
+func TestControllerRecorder(t *testing.T) {
+	rec := newControllerRecorder(context.Background(), "gcpnfsvolume.cloud-resources.kyma-project.io")
+
+	rec.Observe(gcpNfsVolume("a", cloudresourcesv1beta1.StateError, "quota exceeded"))
+	rec.Observe(gcpNfsVolume("b", cloudresourcesv1beta1.StateError, ""))
+	rec.Observe(gcpNfsVolume("b", cloudresourcesv1beta1.StateReady, ""))
+
+	rec.Reconcile(ctrlreconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "a"}})(nil)
+	rec.Reconcile(ctrlreconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "a"}})(nil)
+	rec.Reconcile(ctrlreconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "b"}})(context.Canceled)
+
+	res := rec.Result(false)
+	assert.Equal(t, "gcpnfsvolume.cloud-resources.kyma-project.io", res.ObjKindGroup)
+	assert.Equal(t, 2, res.ObjectsReconciled, "objects are counted once")
+	assert.Equal(t, 1, res.ObjectsInError, "an object leaving Error state is not counted")
+	assert.Equal(t, "quota exceeded", res.LastError, "cancellation is not a reconcile error")
+	assert.False(t, res.TimedOut)
+	assert.True(t, res.Failing())
+
+	rec.Reconcile(ctrlreconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "b"}})(errors.New("boom"))
+	assert.Equal(t, "boom", rec.Result(false).LastError)
+}
+
+func TestControllerRecorderTimedOut(t *testing.T) {
+	runCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	rec := newControllerRecorder(runCtx, "x")
+	assert.False(t, rec.Result(true).TimedOut, "a controller idle at the worker timeout did not time out")
+
+	done := rec.Reconcile(ctrlreconcile.Request{})
+	assert.True(t, rec.Result(true).TimedOut, "a reconcile in flight at the worker timeout")
+	assert.False(t, rec.Result(false).TimedOut)
+	done(nil)
+	assert.True(t, rec.Result(false).TimedOut, "a reconcile returning after the worker timeout")
+}
+
+func TestMergeSkrStatusResults(t *testing.T) {
+	now := metav1.Now()
+	full := SkrRunResults{
+		Controllers: []ControllerResult{
+			{ObjKindGroup: "gcpnfsvolume.cloud-resources.kyma-project.io", ObjectsReconciled: 2, Duration: 1500 * time.Millisecond},
+			{ObjKindGroup: "gcpredisinstance.cloud-resources.kyma-project.io", ObjectsReconciled: 1, ObjectsInError: 1, LastError: "quota"},
+		},
+		TimedOut: true,
+	}
+	status := mergeSkrStatusResults(cloudcontrolv1beta1.SkrStatusStatus{}, full, now)
+	require.Len(t, status.Controllers, 2)
+	assert.Equal(t, int64(1500), status.Controllers[0].DurationMilliseconds)
+	assert.Equal(t, 1, status.FailingControllers)
+	assert.True(t, status.TimedOut)
+	assert.Equal(t, &now, status.LastRunTime)
+
+	labels := failingControllerLabels(map[string]string{"foo": "bar"}, status.Controllers)
+	assert.Equal(t, map[string]string{
+		"foo": "bar",
+		cloudcontrolv1beta1.LabelSkrStatusFailingPrefix + "gcpredisinstance": "true",
+	}, labels)
+
+	// a partial run only replaces the results of the controllers it ran
+	partial := SkrRunResults{
+		Controllers: []ControllerResult{{ObjKindGroup: "gcpredisinstance.cloud-resources.kyma-project.io", ObjectsReconciled: 1}},
+		Partial:     true,
+	}
+	status = mergeSkrStatusResults(status, partial, now)
+	require.Len(t, status.Controllers, 2)
+	assert.Equal(t, "gcpnfsvolume.cloud-resources.kyma-project.io", status.Controllers[0].ObjKindGroup)
+	assert.Equal(t, 0, status.FailingControllers)
+	assert.False(t, status.TimedOut)
+	assert.Equal(t, map[string]string{"foo": "bar"}, failingControllerLabels(labels, status.Controllers))
+
+	// a full run drops the controllers it did not start
+	status = mergeSkrStatusResults(status, SkrRunResults{Controllers: full.Controllers[:1]}, now)
+	assert.Len(t, status.Controllers, 1)
+}
+
+func TestReportResultsFleetCounts(t *testing.T) {
+	ctx := context.Background()
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	col.AddKyma(ctx, kymaObj("k1"))
+	col.AddKyma(ctx, kymaObj("k2"))
+
+	redis := "gcpredisinstance.cloud-resources.kyma-project.io"
+	nfs := "gcpnfsvolume.cloud-resources.kyma-project.io"
+	failing := func(kind string) ControllerResult { return ControllerResult{ObjKindGroup: kind, ObjectsInError: 1} }
+	ok := func(kind string) ControllerResult { return ControllerResult{ObjKindGroup: kind} }
+
+	col.reportResults("k1", SkrRunResults{Controllers: []ControllerResult{failing(redis), failing(nfs)}, TimedOut: true})
+	col.reportResults("k2", SkrRunResults{Controllers: []ControllerResult{failing(redis), ok(nfs)}})
+	assert.Equal(t, 2, col.failingSkrCount(redis))
+	assert.Equal(t, 1, col.failingSkrCount(nfs))
+	assert.Equal(t, 1, col.timedOutCount)
+
+	// a partial run keeps the failing state of the controllers it did not run
+	col.reportResults("k1", SkrRunResults{Controllers: []ControllerResult{ok(nfs)}, Partial: true})
+	assert.Equal(t, 2, col.failingSkrCount(redis))
+	assert.Equal(t, 0, col.failingSkrCount(nfs))
+	assert.Equal(t, 0, col.timedOutCount)
+
+	col.RemoveKyma(ctx, kymaObj("k2"))
+	assert.Equal(t, 1, col.failingSkrCount(redis))
+
+	// results of SKRs not looped here are ignored
+	col.reportResults("k2", SkrRunResults{Controllers: []ControllerResult{failing(redis)}})
+	assert.Equal(t, 1, col.failingSkrCount(redis))
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+	col.PriorityQueue().ShutDown()
+}
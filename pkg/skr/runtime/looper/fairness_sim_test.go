@@ -24,10 +24,12 @@ import (
 //   go test -tags looper_sim -run TestLooperFairnessSim -v -timeout 30m \
 //       ./pkg/skr/runtime/looper/ \
 //       -args -sim.fleet=730 -sim.cyclic=24 -sim.notif=8 -sim.hot=3 \
-//             -sim.connect=10ms -sim.duration=30s -sim.seed=1 -sim.addKymaEvery=30ms
+//             -sim.connect=10ms -sim.duration=30s -sim.seed=1 -sim.addKymaEvery=30ms \
+//             -sim.priority=2 -sim.prioritySkrs=20 -sim.priorityMin=50ms
 //
 // It exercises the REAL shipped fairness code (Queue, SkrGate, processOne, cyclicReAdd,
-// Notify, recordNotifConnect, and — via the AddKyma driver — the real add() path) with a
+// Notify, recordNotifConnect, the priority lane (markUrgent, priorityReAdd, priorityRetry),
+// and — via the AddKyma driver — the real add() path) with a
 // dummy sleeping handler in place of the per-SKR manager — no IO, no real 10s. Time is
 // SCALED: what matters for fairness is the ratios (hot vs cold notification rate, connect
 // time vs notifMinInterval, fleet/workers), not the absolute magnitudes. A ~30s wall-time run
@@ -55,6 +57,13 @@ var (
 	// disable the driver.
 	simAddKymaEvery = flag.Duration("sim.addKymaEvery", 30*time.Millisecond, "period between full-fleet AddKyma re-activation sweeps (0 disables)")
 
+	// Priority scenario: simPrioritySkrs SKRs are marked urgent (objects being deleted or in
+	// Error state) and served by simPriority priority workers at most once per simPriorityMin,
+	// on top of their cyclic turn. Set -sim.priority=0 to disable the lane.
+	simPriority     = flag.Int("sim.priority", 2, "priority worker count (0 disables the priority lane)")
+	simPrioritySkrs = flag.Int("sim.prioritySkrs", 20, "number of SKRs in the priority lane")
+	simPriorityMin  = flag.Duration("sim.priorityMin", 50*time.Millisecond, "per-SKR priority lane interval (scaled)")
+
 	maxGapFactor = 3.0 // fail if any max gap > maxGapFactor × mean gap
 )
 
@@ -94,7 +103,7 @@ func TestLooperFairnessSim(t *testing.T) {
 	col.notifMinInterval = *simNotifMin
 
 	rec := newServedRecorder()
-	handle := func(_ int, kyma string) {
+	handle := func(_ int, kyma string, _ []NotificationTarget) {
 		time.Sleep(*simConnect)      // stand-in for CreateManager + 10s skrManager.Start
 		rec.record(kyma, time.Now()) // record at end of connect (when the SKR is "served")
 	}
@@ -108,6 +117,8 @@ func TestLooperFairnessSim(t *testing.T) {
 		cyclicMinInterval:        60 * time.Second,
 		reconcileTimeout:         10 * time.Second,
 		cyclicImmediateThreshold: 1, // large fleet → FIFO re-add (matches prod)
+		priorityMinInterval:      *simPriorityMin,
+		gateConflictRetryDelay:   *simConnect,
 	}
 
 	// Seed the fleet: designate the first simHot as hot SKRs. Add to the cyclic queue,
@@ -117,6 +128,8 @@ func TestLooperFairnessSim(t *testing.T) {
 		kymas[i] = fmt.Sprintf("kyma-%04d", i)
 	}
 	hot := kymas[:*simHot]
+	// Priority SKRs are taken from the tail so they never overlap the hot ones.
+	priority := kymas[len(kymas)-*simPrioritySkrs:]
 
 	// Start the real workers (mirrors skrLooper.Start's worker launch).
 	stop := make(chan struct{})
@@ -138,11 +151,24 @@ func TestLooperFairnessSim(t *testing.T) {
 			}
 		}(x)
 	}
+	for x := 0; x < *simPriority; x++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for !l.processOne(id, l.PriorityQueue(), "priority", l.priorityReAdd, l.priorityRetry) {
+			}
+		}(x)
+	}
 
 	// Prime the cyclic rotation (also records membership).
 	for _, k := range kymas {
 		l.CyclicQueue().Add(k)
 	}
+	if *simPriority > 0 {
+		for _, k := range priority {
+			col.markUrgent(k, true)
+		}
+	}
 
 	// Notification driver: hot SKRs fire frequently; cold SKRs fire rarely (random).
 	var drivers sync.WaitGroup
@@ -208,12 +234,13 @@ func TestLooperFairnessSim(t *testing.T) {
 	drivers.Wait()
 	l.CyclicQueue().ShutDown()
 	l.NotificationQueue().ShutDown()
+	l.PriorityQueue().ShutDown()
 	wg.Wait()
 
-	report(t, rec, kymas, hot)
+	report(t, rec, kymas, hot, priority)
 }
 
-func report(t *testing.T, rec *servedRecorder, kymas, hot []string) {
+func report(t *testing.T, rec *servedRecorder, kymas, hot, priority []string) {
 	rec.mu.Lock()
 	defer rec.mu.Unlock()
 
@@ -263,6 +290,7 @@ func report(t *testing.T, rec *servedRecorder, kymas, hot []string) {
 	t.Logf("=== SKR Looper fairness simulation report ===")
 	t.Logf("fleet=%d cyclic=%d notif=%d hot=%d connect=%s notifMin=%s duration=%s seed=%d",
 		*simFleet, *simCyclic, *simNotif, *simHot, *simConnect, *simNotifMin, *simDuration, *simSeed)
+	t.Logf("priority=%d prioritySkrs=%d priorityMin=%s", *simPriority, *simPrioritySkrs, *simPriorityMin)
 	t.Logf("served %d/%d SKRs at least twice (need 2 services to measure a gap)", served, len(kymas))
 	t.Logf("theoretical fair mean gap = %s", fairMean)
 	t.Logf("observed gap: mean=%s p50=%s p95=%s p99=%s max=%s",
@@ -277,6 +305,11 @@ func report(t *testing.T, rec *servedRecorder, kymas, hot []string) {
 				hotMark = " [HOT]"
 			}
 		}
+		for _, p := range priority {
+			if p == worst[i].k {
+				hotMark = " [PRIORITY]"
+			}
+		}
 		t.Logf("  %s  max=%s  %.1f×mean%s", worst[i].k, worst[i].g, ratio, hotMark)
 	}
 
@@ -286,6 +319,26 @@ func report(t *testing.T, rec *servedRecorder, kymas, hot []string) {
 		t.Logf("  %s  connects=%d", h, rec.counts[h])
 	}
 
+	// Priority SKRs should get bonus connects from their lane, bounded by priorityMin, while
+	// the fairness assertion below still holds for the whole fleet.
+	if *simPriority > 0 && len(priority) > 0 {
+		var sum int
+		for _, p := range priority {
+			sum += rec.counts[p]
+		}
+		var coldSum, cold int
+		for _, k := range kymas[*simHot : len(kymas)-len(priority)] {
+			coldSum += rec.counts[k]
+			cold++
+		}
+		priorityAvg := float64(sum) / float64(len(priority))
+		coldAvg := float64(coldSum) / float64(max(cold, 1))
+		t.Logf("priority SKR connects: avg=%.1f vs regular avg=%.1f", priorityAvg, coldAvg)
+		if priorityAvg <= coldAvg {
+			t.Errorf("PRIORITY FAIL: priority SKRs (avg %.1f connects) were not served more often than regular SKRs (avg %.1f)", priorityAvg, coldAvg)
+		}
+	}
+
 	// Fairness assertion: no SKR's max gap may exceed maxGapFactor × mean.
 	worstRatio := float64(worst[0].g) / float64(mean)
 	if worstRatio > maxGapFactor {
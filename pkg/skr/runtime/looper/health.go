@@ -0,0 +1,88 @@
+package looper
+
+import (
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+)
+
+// skrHealth is the last known outcome of the SKR runs of one SKR, from which the fleet
+// level gauges are derived.
+type skrHealth struct {
+	// failing holds the kinds whose controllers last saw objects in Error state or errored
+	failing  map[string]struct{}
+	timedOut bool
+}
+
+// reportResults records the results of a finished run of an owned SKR and updates the
+// fleet level gauges. A partial run only updates the controllers it ran.
+func (l *activeSkrCollection) reportResults(kymaName string, results SkrRunResults) {
+	if !l.cyclicQueue.Contains(kymaName) {
+		return
+	}
+
+	l.healthMu.Lock()
+	defer l.healthMu.Unlock()
+
+	prev, ok := l.health[kymaName]
+	next := skrHealth{failing: map[string]struct{}{}, timedOut: results.TimedOut}
+	if ok && results.Partial {
+		for k := range prev.failing {
+			next.failing[k] = struct{}{}
+		}
+	}
+	for _, r := range results.Controllers {
+		if r.Failing() {
+			next.failing[r.ObjKindGroup] = struct{}{}
+		} else {
+			delete(next.failing, r.ObjKindGroup)
+		}
+	}
+	l.health[kymaName] = next
+
+	l.updateHealthMetrics(prev, next)
+}
+
+// clearHealth forgets a deactivated SKR.
+func (l *activeSkrCollection) clearHealth(kymaName string) {
+	l.healthMu.Lock()
+	defer l.healthMu.Unlock()
+
+	prev, ok := l.health[kymaName]
+	if !ok {
+		return
+	}
+	delete(l.health, kymaName)
+
+	l.updateHealthMetrics(prev, skrHealth{})
+}
+
+// updateHealthMetrics applies the change of one SKR's health to the fleet counts.
+// Must be called with healthMu held.
+func (l *activeSkrCollection) updateHealthMetrics(prev, next skrHealth) {
+	for k := range prev.failing {
+		if _, ok := next.failing[k]; !ok {
+			l.failingCount[k]--
+			metrics.SkrLooperFailingSkrCount.WithLabelValues(k).Set(float64(l.failingCount[k]))
+		}
+	}
+	for k := range next.failing {
+		if _, ok := prev.failing[k]; !ok {
+			l.failingCount[k]++
+			metrics.SkrLooperFailingSkrCount.WithLabelValues(k).Set(float64(l.failingCount[k]))
+		}
+	}
+	if prev.timedOut != next.timedOut {
+		if next.timedOut {
+			l.timedOutCount++
+		} else {
+			l.timedOutCount--
+		}
+		metrics.SkrLooperTimedOutSkrCount.Set(float64(l.timedOutCount))
+	}
+}
+
+// failingSkrCount returns the number of SKRs whose last run of the objKindGroup controller failed.
+func (l *activeSkrCollection) failingSkrCount(objKindGroup string) int {
+	l.healthMu.Lock()
+	defer l.healthMu.Unlock()
+	return l.failingCount[objKindGroup]
+}
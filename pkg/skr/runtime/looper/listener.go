@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	skrruntimeconfig "github.com/kyma-project/cloud-manager/pkg/skr/runtime/config"
 )
 
 // NotificationComponentName is the runtime-watcher component name for cloud-manager.
@@ -21,18 +22,20 @@ const NotificationComponentName = "cloud-manager"
 // received notification to the notification sleeve via notify (== Notify).
 //
 // runtime-id == kymaName, so no lookup layer is needed: the runtime-id carried
-// by the notification is the SKR's kymaName directly.
+// by the notification is the SKR's kymaName directly. When the notification also
+// names the watched object it is passed along as a target.
 type notificationListener struct {
 	listener *watcherevent.SKREventListener
-	notify   func(kymaName string)
+	notify   func(kymaName string, targets ...NotificationTarget)
 	logger   logr.Logger
 }
 
 var _ manager.Runnable = (*notificationListener)(nil)
+var _ manager.LeaderElectionRunnable = (*notificationListener)(nil)
 
 // NewNotificationListener constructs the runtime-watcher listener bound to addr
 // under the given componentName, forwarding valid notifications to notify.
-func NewNotificationListener(addr, componentName string, notify func(kymaName string), logger logr.Logger) *notificationListener {
+func NewNotificationListener(addr, componentName string, notify func(kymaName string, targets ...NotificationTarget), logger logr.Logger) *notificationListener {
 	l := watcherevent.NewSKREventListener(addr, componentName)
 	l.Logger = logger.WithName("skr-notification-listener")
 	return &notificationListener{
@@ -42,6 +45,12 @@ func NewNotificationListener(addr, componentName string, notify func(kymaName st
 	}
 }
 
+// NeedLeaderElection lets every replica receive notifications when the looper is sharded;
+// notifications for SKRs owned by another replica are forwarded by Notify.
+func (n *notificationListener) NeedLeaderElection() bool {
+	return !skrruntimeconfig.SkrRuntimeConfig.ShardingEnabled
+}
+
 // Start launches the adapter goroutine and runs the listener's HTTP server.
 // It blocks until ctx is done; the adapter goroutine exits when the listener
 // closes its events channel on shutdown.
@@ -50,9 +59,9 @@ func (n *notificationListener) Start(ctx context.Context) error {
 	return n.listener.Start(ctx)
 }
 
-// adapt drains received notifications, extracts the runtime-id (== kymaName),
-// and forwards it to notify. Notifications with a missing/invalid runtime-id are
-// dropped. Returns when ch is closed.
+// adapt drains received notifications, extracts the runtime-id (== kymaName) and
+// the watched object if present, and forwards them to notify. Notifications with a
+// missing/invalid runtime-id are dropped. Returns when ch is closed.
 func (n *notificationListener) adapt(ch <-chan watchertypes.GenericEvent) {
 	for evt := range ch {
 		kymaName, ok := runtimeIDFromEvent(evt)
@@ -62,7 +71,11 @@ func (n *notificationListener) adapt(ch <-chan watchertypes.GenericEvent) {
 			continue
 		}
 		metrics.SkrLooperNotificationReceivedTotal.Inc()
-		n.notify(kymaName)
+		if target, ok := targetFromEvent(evt); ok {
+			n.notify(kymaName, target)
+		} else {
+			n.notify(kymaName)
+		}
 	}
 }
 
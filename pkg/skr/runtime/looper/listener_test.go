@@ -2,12 +2,16 @@ package looper
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	watchertypes "github.com/kyma-project/runtime-watcher/listener/pkg/v2/types"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	clocktesting "k8s.io/utils/clock/testing"
 
 	"github.com/kyma-project/cloud-manager/pkg/metrics"
@@ -52,7 +56,7 @@ func TestNotificationListenerAdapt(t *testing.T) {
 
 	var got []string
 	n := &notificationListener{
-		notify: func(kymaName string) { got = append(got, kymaName) },
+		notify: func(kymaName string, _ ...NotificationTarget) { got = append(got, kymaName) },
 	}
 
 	ch := make(chan watchertypes.GenericEvent)
@@ -114,3 +118,89 @@ func TestNotificationListenerAdaptToRealCollection(t *testing.T) {
 	close(ch)
 	<-done
 }
+
+func TestTargetFromEvent(t *testing.T) {
+	gvk := metav1.GroupVersionKind{Group: "cloud-resources.kyma-project.io", Version: "v1beta1", Kind: "GcpNfsVolume"}
+	want := NotificationTarget{
+		Gvk:       schema.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+		Namespace: "default",
+		Name:      "vol",
+	}
+	tests := []struct {
+		name   string
+		obj    map[string]any
+		want   NotificationTarget
+		wantOk bool
+	}{
+		{"typed", map[string]any{
+			"watched":     types.NamespacedName{Namespace: "default", Name: "vol"},
+			"watched-gvk": gvk,
+		}, want, true},
+		{"map", map[string]any{
+			"watched":     map[string]any{"Namespace": "default", "Name": "vol"},
+			"watched-gvk": map[string]any{"group": gvk.Group, "version": gvk.Version, "kind": gvk.Kind},
+		}, want, true},
+		{"missing gvk", map[string]any{
+			"watched": types.NamespacedName{Namespace: "default", Name: "vol"},
+		}, NotificationTarget{}, false},
+		{"missing watched", map[string]any{"watched-gvk": gvk}, NotificationTarget{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := targetFromEvent(watchertypes.GenericEvent{Object: &unstructured.Unstructured{Object: tt.obj}})
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestNotifyAccumulatesTargets: targets of notifications coalesced into one queued connect
+// are merged; a notification without a target or too many targets turn it into a full sync.
+func TestNotifyAccumulatesTargets(t *testing.T) {
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	col.AddKyma(context.Background(), kymaObj("k1"))
+
+	t1 := NotificationTarget{Gvk: schema.GroupVersionKind{Kind: "GcpNfsVolume"}, Namespace: "default", Name: "a"}
+	t2 := NotificationTarget{Gvk: schema.GroupVersionKind{Kind: "GcpNfsVolume"}, Namespace: "default", Name: "b"}
+
+	col.Notify("k1", t1)
+	col.Notify("k1", t2)
+	col.Notify("k1", t1)
+	assert.Equal(t, 1, col.NotificationQueue().Len())
+	assert.Equal(t, []NotificationTarget{t1, t2}, col.takeNotifTargets("k1"))
+	assert.Nil(t, col.takeNotifTargets("k1"), "targets are taken once")
+
+	col.Notify("k1", t1)
+	col.Notify("k1")
+	col.Notify("k1", t2)
+	assert.Nil(t, col.takeNotifTargets("k1"), "a notification without target means full sync")
+
+	for i := 0; i <= maxNotifTargets; i++ {
+		col.Notify("k1", NotificationTarget{Gvk: schema.GroupVersionKind{Kind: "GcpNfsVolume"}, Name: fmt.Sprintf("v%d", i)})
+	}
+	assert.Nil(t, col.takeNotifTargets("k1"), "too many targets fall back to full sync")
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+}
+
+// TestOnlyNotificationSleeveIsTargeted: the notification worker hands the pending targets
+// to the handler, the cyclic worker always runs a full sync.
+func TestOnlyNotificationSleeveIsTargeted(t *testing.T) {
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	col.AddKyma(context.Background(), kymaObj("k1"))
+
+	var got [][]NotificationTarget
+	l := newTestLooper(col, nil)
+	l.handleFn = func(_ int, _ string, targets []NotificationTarget) { got = append(got, targets) }
+
+	target := NotificationTarget{Gvk: schema.GroupVersionKind{Kind: "GcpNfsVolume"}, Namespace: "default", Name: "a"}
+	col.Notify("k1", target)
+
+	assert.False(t, l.processOne(0, col.cyclicQueue, "cyclic", func(string) {}, func(string) {}))
+	assert.False(t, l.processOne(0, col.notifQueue, "notification", func(string) {}, func(string) {}))
+	assert.Equal(t, [][]NotificationTarget{nil, {target}}, got)
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+}
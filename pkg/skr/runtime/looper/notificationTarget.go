@@ -0,0 +1,94 @@
+package looper
+
+import (
+	watchertypes "github.com/kyma-project/runtime-watcher/listener/pkg/v2/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// maxNotifTargets bounds the targets kept per SKR between two notification-driven
+// connects. Beyond it the connect falls back to a full sync with all controllers.
+const maxNotifTargets = 20
+
+// NotificationTarget identifies the SKR object a runtime-watcher notification was raised
+// for. A notification-driven connect carrying targets starts only the controllers
+// reconciling those kinds and only for those objects; the cyclic sleeve always does a
+// full sync.
+type NotificationTarget struct {
+	Gvk       schema.GroupVersionKind `json:"gvk"`
+	Namespace string                  `json:"namespace,omitempty"`
+	Name      string                  `json:"name"`
+}
+
+func (t NotificationTarget) ObjectKey() types.NamespacedName {
+	return types.NamespacedName{Namespace: t.Namespace, Name: t.Name}
+}
+
+// pendingNotif accumulates the targets of notifications received for an SKR until its
+// next notification-driven connect. full is set once any notification without a target
+// arrived, or too many targets piled up.
+type pendingNotif struct {
+	full    bool
+	targets []NotificationTarget
+}
+
+func (p *pendingNotif) add(targets []NotificationTarget) {
+	if p.full {
+		return
+	}
+	if len(targets) == 0 {
+		p.full = true
+		p.targets = nil
+		return
+	}
+	for _, t := range targets {
+		found := false
+		for _, x := range p.targets {
+			if x == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			p.targets = append(p.targets, t)
+		}
+	}
+	if len(p.targets) > maxNotifTargets {
+		p.full = true
+		p.targets = nil
+	}
+}
+
+// targetFromEvent extracts the watched object from a runtime-watcher GenericEvent. The
+// listener stores the watched key and GVK as typed values; the map forms are accepted
+// too so a JSON round-tripped event is understood the same way. Returns false if the
+// event does not name an object, in which case the whole SKR is synced.
+func targetFromEvent(evt watchertypes.GenericEvent) (NotificationTarget, bool) {
+	if evt.Object == nil {
+		return NotificationTarget{}, false
+	}
+	var result NotificationTarget
+
+	switch w := evt.Object.Object["watched"].(type) {
+	case types.NamespacedName:
+		result.Namespace, result.Name = w.Namespace, w.Name
+	case map[string]any:
+		result.Namespace, _ = w["Namespace"].(string)
+		result.Name, _ = w["Name"].(string)
+	}
+
+	switch g := evt.Object.Object["watched-gvk"].(type) {
+	case metav1.GroupVersionKind:
+		result.Gvk = schema.GroupVersionKind{Group: g.Group, Version: g.Version, Kind: g.Kind}
+	case map[string]any:
+		result.Gvk.Group, _ = g["group"].(string)
+		result.Gvk.Version, _ = g["version"].(string)
+		result.Gvk.Kind, _ = g["kind"].(string)
+	}
+
+	if result.Name == "" || result.Gvk.Kind == "" {
+		return NotificationTarget{}, false
+	}
+	return result, true
+}
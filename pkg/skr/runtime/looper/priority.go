@@ -0,0 +1,130 @@
+package looper
+
+import (
+	"sync/atomic"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// priorityReason is a bitmask of the reasons an SKR is in the priority lane. The SKR
+// leaves the lane once no reason is left.
+type priorityReason int
+
+const (
+	// priorityReasonBrokerPlan the SKR is on one of the configured priority broker plans.
+	priorityReasonBrokerPlan priorityReason = 1 << iota
+	// priorityReasonObjects the last full connect saw objects being deleted or in Error state.
+	priorityReasonObjects
+)
+
+// updatePriority sets or clears one priority reason of an owned SKR and moves it in or
+// out of the priority queue. The priority lane is served in addition to the cyclic
+// rotation, it never reorders the cyclic queue.
+func (l *activeSkrCollection) updatePriority(kymaName string, reason priorityReason, on bool) {
+	if on && !l.cyclicQueue.Contains(kymaName) {
+		return
+	}
+
+	l.priorityMu.Lock()
+	prev := l.priorityReasons[kymaName]
+	next := prev &^ reason
+	if on {
+		next |= reason
+	}
+	if next == 0 {
+		delete(l.priorityReasons, kymaName)
+	} else {
+		l.priorityReasons[kymaName] = next
+	}
+	count := len(l.priorityReasons)
+	l.priorityMu.Unlock()
+
+	if prev == 0 && next != 0 {
+		l.priorityQueue.Add(kymaName)
+	}
+	if prev != 0 && next == 0 {
+		l.priorityQueue.Remove(kymaName)
+	}
+	metrics.SkrLooperPriorityCount.Set(float64(count))
+}
+
+// clearPriority takes a deactivated SKR out of the priority lane.
+func (l *activeSkrCollection) clearPriority(kymaName string) {
+	l.updatePriority(kymaName, priorityReasonBrokerPlan|priorityReasonObjects, false)
+}
+
+func (l *activeSkrCollection) isPriority(kymaName string) bool {
+	l.priorityMu.Lock()
+	defer l.priorityMu.Unlock()
+	return l.priorityReasons[kymaName] != 0
+}
+
+func (l *activeSkrCollection) isPriorityBrokerPlan(brokerPlanName string) bool {
+	_, ok := l.priorityBrokerPlans[brokerPlanName]
+	return ok && brokerPlanName != ""
+}
+
+func (l *activeSkrCollection) markUrgent(kymaName string, urgent bool) {
+	l.updatePriority(kymaName, priorityReasonObjects, urgent)
+}
+
+// =====================================================================
+
+func (l *skrLooper) priorityWorker(id int) {
+	defer l.wg.Done()
+	logger := l.logger.WithValues("skrPriorityWorkerId", id)
+	logger.Info("SKR Looper priority worker started")
+	q := l.PriorityQueue()
+	// On gate conflict the SKR is being served by another sleeve right now, which is as good
+	// as a priority connect, so retry it only after the conflict delay. The cyclic queue is
+	// never written from here.
+	for !l.processOne(id, q, "priority", l.priorityReAdd, l.priorityRetry) {
+	}
+	logger.Info("SKR Looper priority worker returning")
+}
+
+// priorityReAdd re-schedules a priority SKR after priorityMinInterval on the success path.
+// The interval is the per-SKR budget of the lane: however many SKRs are prioritized, none
+// of them is connected more often than once per interval by the priority workers.
+func (l *skrLooper) priorityReAdd(kymaName string) {
+	if !l.Contains(kymaName) || !l.isPriority(kymaName) {
+		return
+	}
+	l.PriorityQueue().AddAfter(kymaName, l.priorityMinInterval)
+}
+
+func (l *skrLooper) priorityRetry(kymaName string) {
+	if !l.isPriority(kymaName) {
+		return
+	}
+	l.PriorityQueue().AddAfter(kymaName, l.gateConflictRetryDelay)
+}
+
+// =====================================================================
+
+// urgencyObserver watches the objects of the reconciled kinds seen during one connect and
+// records if any of them is being deleted or is in Error state. Such an SKR is put in the
+// priority lane until a full connect sees none.
+type urgencyObserver struct {
+	urgent atomic.Bool
+}
+
+func (o *urgencyObserver) Observe(obj client.Object) {
+	if o.urgent.Load() {
+		return
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		o.urgent.Store(true)
+		return
+	}
+	if x, ok := obj.(composed.ObjWithConditionsAndState); ok && x.State() == cloudresourcesv1beta1.StateError {
+		o.urgent.Store(true)
+	}
+}
+
+func (o *urgencyObserver) Urgent() bool {
+	return o.urgent.Load()
+}
@@ -0,0 +1,105 @@
+package looper
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// TestPriorityLaneMembership: SKRs on a priority broker plan and SKRs reported urgent join
+// the priority lane; they leave it when the last reason is gone or they are deactivated.
+func TestPriorityLaneMembership(t *testing.T) {
+	ctx := context.Background()
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	col.priorityBrokerPlans = map[string]struct{}{"paid": {}}
+
+	paid := kymaObj("paid-kyma")
+	paid.SetLabels(map[string]string{cloudcontrolv1beta1.LabelScopeBrokerPlanName: "paid"})
+	col.AddKyma(ctx, paid)
+	col.AddKyma(ctx, kymaObj("free-kyma"))
+
+	assert.True(t, col.isPriority("paid-kyma"))
+	assert.False(t, col.isPriority("free-kyma"))
+	assert.Equal(t, 1, col.PriorityQueue().Len())
+	assert.Equal(t, 2, col.CyclicQueue().Len(), "the priority lane is in addition to the cyclic rotation")
+
+	col.markUrgent("free-kyma", true)
+	assert.True(t, col.isPriority("free-kyma"))
+	col.markUrgent("free-kyma", false)
+	assert.False(t, col.isPriority("free-kyma"))
+	assert.False(t, col.PriorityQueue().Contains("free-kyma"))
+
+	// the broker plan reason survives a cleared urgency
+	col.markUrgent("paid-kyma", true)
+	col.markUrgent("paid-kyma", false)
+	assert.True(t, col.isPriority("paid-kyma"))
+
+	col.RemoveKyma(ctx, paid)
+	assert.False(t, col.isPriority("paid-kyma"))
+	assert.False(t, col.PriorityQueue().Contains("paid-kyma"))
+
+	// inactive SKRs are never put in the lane
+	col.markUrgent("unknown", true)
+	assert.False(t, col.isPriority("unknown"))
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+	col.PriorityQueue().ShutDown()
+}
+
+// TestPriorityWorkerReAddsOnlyWhilePrioritized: the priority worker re-schedules after
+// priorityMinInterval and stops once the SKR left the lane; it never touches the cyclic queue.
+func TestPriorityWorkerReAddsOnlyWhilePrioritized(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	col := newTestCollection(fakeClock)
+
+	var calls int64
+	l := newTestLooper(col, func(_ int, _ string) { atomic.AddInt64(&calls, 1) })
+	l.priorityMinInterval = 30 * time.Second
+
+	col.AddKyma(context.Background(), kymaObj("k"))
+	col.markUrgent("k", true)
+	cyclicLen := col.CyclicQueue().Len()
+
+	base := fakeClock.Waiters()
+	require.False(t, l.processOne(0, col.priorityQueue, "priority", l.priorityReAdd, l.priorityRetry))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	assert.Equal(t, cyclicLen, col.CyclicQueue().Len())
+	assert.Equal(t, 0, col.PriorityQueue().Len(), "not dispatchable before priorityMinInterval")
+
+	stepAfterWaiter(t, fakeClock, base, 30*time.Second)
+	assert.Eventually(t, func() bool { return col.PriorityQueue().Len() == 1 }, time.Second, 10*time.Millisecond)
+
+	col.markUrgent("k", false)
+	require.False(t, l.processOne(0, col.priorityQueue, "priority", l.priorityReAdd, l.priorityRetry))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls), "an SKR that left the lane is dropped by the membership guard")
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+	col.PriorityQueue().ShutDown()
+}
+
+func TestUrgencyObserver(t *testing.T) {
+	o := &urgencyObserver{}
+	o.Observe(&cloudresourcesv1beta1.GcpNfsVolume{})
+	assert.False(t, o.Urgent())
+
+	errored := &cloudresourcesv1beta1.GcpNfsVolume{}
+	errored.Status.State = cloudresourcesv1beta1.StateError
+	o.Observe(errored)
+	assert.True(t, o.Urgent())
+
+	o = &urgencyObserver{}
+	deleting := &cloudresourcesv1beta1.GcpNfsVolume{}
+	deleting.SetDeletionTimestamp(new(metav1.Now()))
+	o.Observe(deleting)
+	assert.True(t, o.Urgent())
+}
@@ -11,41 +11,63 @@ import (
 // slidingQueue is a slice-backed workqueue.Queue whose Touch moves an already
 // queued item to the tail. workqueue calls Touch when Add is invoked for an item
 // that is already queued and not yet being processed; this gives us the
-// move-to-end ("Delay") behavior the two-sleeve design relies on. All methods are
-// called by workqueue from a single goroutine while holding its lock, so no
-// locking is needed here.
-type slidingQueue[T comparable] []T
+// move-to-end ("Delay") behavior the two-sleeve design relies on. workqueue calls
+// all methods from a single goroutine while holding its lock; the own mutex only
+// guards the positions snapshot read by the admin API.
+type slidingQueue[T comparable] struct {
+	mu    sync.Mutex
+	items []T
+}
 
 func (q *slidingQueue[T]) Push(item T) {
-	*q = append(*q, item)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items = append(q.items, item)
 }
 
 func (q *slidingQueue[T]) Len() int {
-	return len(*q)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
 }
 
 func (q *slidingQueue[T]) Pop() (item T) {
-	item = (*q)[0]
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	item = q.items[0]
 	// allow gc from the underlying array
-	(*q)[0] = *new(T)
-	*q = (*q)[1:]
+	q.items[0] = *new(T)
+	q.items = q.items[1:]
 	return item
 }
 
 // Touch moves item to the tail of the queue. If item is not present (should not
 // happen, workqueue only calls Touch for queued items) it is a no-op.
 func (q *slidingQueue[T]) Touch(item T) {
-	s := *q
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	s := q.items
 	for i, x := range s {
 		if x == item {
 			copy(s[i:], s[i+1:])
 			s[len(s)-1] = *new(T)
-			*q = append(s[:len(s)-1], item)
+			q.items = append(s[:len(s)-1], item)
 			return
 		}
 	}
 }
 
+// positions returns the zero based position of each queued item.
+func (q *slidingQueue[T]) positions() map[T]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	result := make(map[T]int, len(q.items))
+	for i, x := range q.items {
+		result[x] = i
+	}
+	return result
+}
+
 // Queue is the SKR looper work queue. It wraps a workqueue delaying queue (which
 // provides Add/AddAfter/Get/Done/ShutDown plus dirty-set dedup and free metrics)
 // and adds a membership set to back Contains/Remove/Items, which the workqueue
@@ -55,7 +77,8 @@ func (q *slidingQueue[T]) Touch(item T) {
 // AddAfter(CyclicMinInterval) on the success path, and the notification worker
 // drains FIFO without re-adding. Done therefore just marks processing complete.
 type Queue struct {
-	wq workqueue.TypedDelayingInterface[string]
+	wq    workqueue.TypedDelayingInterface[string]
+	ready *slidingQueue[string]
 
 	mu         sync.Mutex
 	membership map[string]struct{}
@@ -66,14 +89,16 @@ func NewQueue() *Queue {
 }
 
 func newQueueWithClock(c clock.WithTicker) *Queue {
+	ready := &slidingQueue[string]{}
 	return &Queue{
 		wq: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[string]{
 			Clock: c,
 			Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{
 				Clock: c,
-				Queue: &slidingQueue[string]{},
+				Queue: ready,
 			}),
 		}),
+		ready:      ready,
 		membership: map[string]struct{}{},
 	}
 }
@@ -148,6 +173,12 @@ func (q *Queue) Items() []string {
 	return items
 }
 
+// Positions returns the zero based position of each item ready for dispatch. Members
+// waiting for an AddAfter delay or being processed are not included.
+func (q *Queue) Positions() map[string]int {
+	return q.ready.positions()
+}
+
 func (q *Queue) ShutDown() {
 	q.wq.ShutDown()
 }
@@ -21,8 +21,10 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 )
 
@@ -30,6 +32,9 @@ type RunOptions struct {
 	timeout           time.Duration
 	checkSkrReadiness bool
 	provider          *cloudcontrolv1beta1.ProviderType
+	targets           []NotificationTarget
+	urgencyReport     func(urgent bool)
+	resultsReport     func(results SkrRunResults)
 }
 
 type RunOption = func(options *RunOptions)
@@ -47,6 +52,33 @@ func WithProvider(provider cloudcontrolv1beta1.ProviderType) RunOption {
 	}
 }
 
+// WithTargets narrows the run to the controllers reconciling the kinds of the given
+// targets, each limited to the target objects. The installer is skipped. If no
+// registered controller handles any of the targets the run is a full sync.
+func WithTargets(targets []NotificationTarget) RunOption {
+	return func(options *RunOptions) {
+		options.targets = targets
+	}
+}
+
+// WithUrgencyReport registers a callback told after the SKR manager stopped whether any
+// reconciled object seen during the run is being deleted or in Error state. A run
+// narrowed by WithTargets sees only some objects and therefore only reports urgency,
+// never its absence.
+func WithUrgencyReport(report func(urgent bool)) RunOption {
+	return func(options *RunOptions) {
+		options.urgencyReport = report
+	}
+}
+
+// WithResultsReport registers a callback given the per-controller outcomes of the run
+// after the SKR manager stopped. It is not called if the controllers were never started.
+func WithResultsReport(report func(results SkrRunResults)) RunOption {
+	return func(options *RunOptions) {
+		options.resultsReport = report
+	}
+}
+
 func WithTimeout(timeout time.Duration) RunOption {
 	return func(options *RunOptions) {
 		options.timeout = timeout
@@ -94,6 +126,31 @@ func (r *skrRunner) isObjectActiveForProvider(scheme *runtime.Scheme, provider *
 	return common.ObjSupportsProvider(obj, scheme, string(*provider))
 }
 
+// targetedObjects returns per registry builder the object keys of the targets matching
+// the builder's reconciled kind. Versions are ignored, a notification may carry any
+// served version of the kind. Returns nil if no builder matches any target.
+func (r *skrRunner) targetedObjects(scheme *runtime.Scheme, targets []NotificationTarget) map[registry.Builder][]types.NamespacedName {
+	if len(targets) == 0 {
+		return nil
+	}
+	var result map[registry.Builder][]types.NamespacedName
+	for _, b := range r.registry.Builders() {
+		gvk, err := apiutil.GVKForObject(b.GetForObj(), scheme)
+		if err != nil {
+			continue
+		}
+		for _, t := range targets {
+			if t.Gvk.GroupKind() == gvk.GroupKind() {
+				if result == nil {
+					result = map[registry.Builder][]types.NamespacedName{}
+				}
+				result[b] = append(result[b], t.ObjectKey())
+			}
+		}
+	}
+	return result
+}
+
 func (r *skrRunner) ScopeProvider() scopeprovider.ScopeProviderRegistry {
 	return r.scopeProvider
 }
@@ -132,8 +189,12 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 		}
 		metrics.SkrLooperConnectPhaseSeconds.WithLabelValues("skr_readiness", r.kymaName, strconv.FormatBool(ctx.Err() != nil)).Observe(time.Since(tReadiness).Seconds())
 
+		// A notification-driven run narrowed to the notified objects. The installer and the
+		// other controllers are left to the next full sync on the cyclic sleeve.
+		targeted := r.targetedObjects(skrManager.GetScheme(), options.targets)
+
 		tInstaller := time.Now()
-		if options.provider != nil {
+		if options.provider != nil && targeted == nil {
 			//logger.Info(fmt.Sprintf("This SKR cluster is started with provider option %s", ptr.Deref(options.provider, "")))
 			instlr := &installer{
 				skrStatus:        skrStatus,
@@ -167,6 +228,9 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 			IgnoreWatchErrors: skrManager.IgnoreWatchErrors,
 		}
 
+		urgency := &urgencyObserver{}
+		var recorders []*controllerRecorder
+
 		for _, indexer := range r.registry.Indexers() {
 			ctx := feature.ContextBuilderFromCtx(ctx).
 				Provider(util.CastInterfaceToString(options.provider)).
@@ -216,10 +280,29 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 
 			handle := skrStatus.Handle(ctx, "Controller")
 
+			bArgs := rArgs
+			if targeted != nil {
+				objects, ok := targeted[b]
+				if !ok {
+					handle.NotTargeted()
+					continue
+				}
+				bArgs.Objects = objects
+			}
+
 			if r.isObjectActiveForProvider(skrManager.GetScheme(), options.provider, b.GetForObj()) &&
 				!feature.ApiDisabled.Value(ctx) {
 				handle.Starting()
-				err = b.SetupWithManager(skrManager, rArgs)
+				recorder := newControllerRecorder(ctx, feature.NewContextReaderFromCtx(ctx).ObjKindGroup())
+				recorders = append(recorders, recorder)
+				bArgs.ObserveObject = func(obj client.Object) {
+					if options.urgencyReport != nil {
+						urgency.Observe(obj)
+					}
+					recorder.Observe(obj)
+				}
+				bArgs.ObserveReconcile = recorder.Reconcile
+				err = b.SetupWithManager(skrManager, bArgs)
 				if err != nil {
 					handle.Error(err)
 					err = fmt.Errorf("setup with manager error for %T: %w", b.GetForObj(), err)
@@ -268,6 +351,26 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 		if err != nil {
 			skrManager.GetLogger().Error(err, "error starting SKR manager")
 		}
+
+		if options.urgencyReport != nil && (targeted == nil || urgency.Urgent()) {
+			options.urgencyReport(urgency.Urgent())
+		}
+
+		// the run context is done after the worker timeout, the results are saved regardless
+		results := SkrRunResults{
+			Partial:  targeted != nil,
+			TimedOut: errors.Is(ctx.Err(), context.DeadlineExceeded),
+		}
+		for _, recorder := range recorders {
+			results.Controllers = append(results.Controllers, recorder.Result(results.TimedOut))
+		}
+		skrStatus.Results(results)
+		saveCtx, saveCancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer saveCancel()
+		r.saveSkrStatusResults(saveCtx, skrStatus, logger)
+		if options.resultsReport != nil {
+			options.resultsReport(results)
+		}
 	})
 	return
 }
@@ -288,3 +391,12 @@ func (r *skrRunner) saveSkrStatus(ctx context.Context, skrStatus *SkrStatus, log
 		skrStatus.IsSaved = true
 	}
 }
+
+func (r *skrRunner) saveSkrStatusResults(ctx context.Context, skrStatus *SkrStatus, logger logr.Logger) {
+	err := r.skrStatusSaver.SaveResults(ctx, skrStatus)
+	if util.IgnoreContextCanceledAndDeadlineExceeded(err) != nil {
+		if !apierrors.IsTimeout(err) {
+			logger.Error(err, "error saving SKR status results")
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package looper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kyma-project/cloud-manager/pkg/common/leases"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"golang.org/x/oauth2"
+	"k8s.io/client-go/transport"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// shardLeasePrefix is the name prefix of the per-replica membership leases in kcp-system.
+	shardLeasePrefix = "cloud-manager-skr-shard-"
+	// shardForwardPath is the path of the notification forward endpoint every sharded replica serves.
+	// It is authorized as a non-resource URL, so the manager service account needs a ClusterRole
+	// with nonResourceURLs ["/skr-looper/v1/notify"] and verb post.
+	shardForwardPath = "/skr-looper/v1/notify"
+	// ShardTokenAudience is the audience of the dedicated service account token forwarded
+	// notifications are authenticated with. The forward endpoint is served over plain http,
+	// so the token is bound to this audience and can not be used against the kube-apiserver.
+	ShardTokenAudience = "cloud-manager-skr-looper-shard"
+)
+
+// SkrShard decides which cloud-manager replica owns an SKR when the looper is sharded.
+// Only the owning replica keeps the SKR in its cyclic and notification queues.
+type SkrShard interface {
+	// Owns reports whether kymaName is owned by this replica. It is false for every
+	// SKR until the first membership sync completed.
+	Owns(kymaName string) bool
+	// Forward routes a notification for kymaName to the replica owning it. It is a
+	// no-op if the SKR is owned locally or no owner is known.
+	Forward(ctx context.Context, kymaName string, targets ...NotificationTarget)
+}
+
+// ShardRebalancer is notified by the shard whenever the membership changes, so the
+// ownership of each active SKR can be re-evaluated.
+type ShardRebalancer interface {
+	Rebalance(ctx context.Context)
+}
+
+// shardOwner picks the owner of kymaName among members with rendezvous (highest random
+// weight) hashing. When a member joins or leaves only the SKRs hashing onto that member
+// move, all others keep their owner. Returns false if there are no members.
+func shardOwner(members []string, kymaName string) (string, bool) {
+	var owner string
+	var best uint64
+	found := false
+	for _, m := range members {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(m))
+		_, _ = h.Write([]byte{'/'})
+		_, _ = h.Write([]byte(kymaName))
+		score := mix64(h.Sum64())
+		if !found || score > best || (score == best && m < owner) {
+			owner, best, found = m, score, true
+		}
+	}
+	return owner, found
+}
+
+// mix64 is the murmur3 64-bit finalizer. FNV alone barely avalanches for inputs sharing
+// a long prefix (like replica addresses), which would skew the rendezvous scores.
+func mix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+// shardLeaseName derives a DNS compatible lease name from the replica address.
+func shardLeaseName(self string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(self))
+	return fmt.Sprintf("%s%08x", shardLeasePrefix, h.Sum32())
+}
+
+type ShardOptions struct {
+	// Self is the host:port other replicas use to reach this replica's forward endpoint.
+	// It is also the holder identity of this replica's membership lease.
+	Self           string
+	Namespace      string
+	LeaseDuration  time.Duration
+	RenewInterval  time.Duration
+	ForwardTimeout time.Duration
+	// BearerTokenFile is the file with the token forwarded notifications are authenticated with
+	// by the owning replica, a projected service account token with the ShardTokenAudience
+	// audience. It must not be the token of the manager itself, since it is sent over plain
+	// http. It is re-read periodically, so a rotated token is picked up. Empty sends no token.
+	BearerTokenFile string
+}
+
+// NewLeaseShard creates a SkrShard whose membership is tracked with one lease per replica.
+// The returned shard is a manager.Runnable that must be added to the KCP manager; it runs
+// on every replica regardless of leader election.
+func NewLeaseShard(cluster composed.StateCluster, rebalancer ShardRebalancer, opts ShardOptions, logger logr.Logger) *leaseShard {
+	if opts.Namespace == "" {
+		opts.Namespace = "kcp-system"
+	}
+	if opts.ForwardTimeout == 0 {
+		opts.ForwardTimeout = 2 * time.Second
+	}
+	s := &leaseShard{
+		cluster:    cluster,
+		rebalancer: rebalancer,
+		opts:       opts,
+		leaseName:  shardLeaseName(opts.Self),
+		httpClient: &http.Client{Timeout: opts.ForwardTimeout},
+		logger:     logger.WithName("skr-looper-shard"),
+	}
+	if opts.BearerTokenFile != "" {
+		s.tokenSource = transport.NewCachedFileTokenSource(opts.BearerTokenFile)
+	}
+	return s
+}
+
+var _ SkrShard = &leaseShard{}
+var _ manager.Runnable = &leaseShard{}
+var _ manager.LeaderElectionRunnable = &leaseShard{}
+
+type leaseShard struct {
+	cluster    composed.StateCluster
+	rebalancer ShardRebalancer
+	opts       ShardOptions
+	leaseName  string
+	httpClient *http.Client
+	logger     logr.Logger
+	// tokenSource gives the bearer token of forwarded notifications, nil if none is sent.
+	tokenSource oauth2.TokenSource
+
+	mu      sync.RWMutex
+	members []string
+}
+
+func (s *leaseShard) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *leaseShard) Start(ctx context.Context) error {
+	if s.opts.Self == "" {
+		return errors.New("skr looper sharding requires the replica address (skrRuntime.shardReplicaAddr)")
+	}
+	s.logger.Info("SKR looper shard started", "self", s.opts.Self, "lease", s.leaseName)
+
+	s.sync(ctx)
+	ticker := time.NewTicker(s.opts.RenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.release()
+			return nil
+		case <-ticker.C:
+			s.sync(ctx)
+		}
+	}
+}
+
+// release gives up the membership lease on shutdown so peers rebalance right away
+// instead of waiting for the lease to expire.
+func (s *leaseShard) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := leases.Release(ctx, s.cluster, s.leaseName, s.opts.Namespace, s.opts.Self); err != nil {
+		s.logger.Error(err, "Error releasing SKR looper shard lease")
+	}
+	s.logger.Info("SKR looper shard stopped")
+}
+
+// sync renews this replica's membership lease, reaps the leases of dead replicas and
+// rebalances if the set of live members changed. On any API error the last known
+// membership is kept, so a transient failure does not move SKRs around.
+func (s *leaseShard) sync(ctx context.Context) {
+	leaseDurationSec := int32(s.opts.LeaseDuration.Seconds())
+	res, err := leases.Acquire(ctx, s.cluster, s.leaseName, s.opts.Namespace, s.opts.Self, leaseDurationSec)
+	if err != nil {
+		s.logger.Error(err, "Error renewing SKR looper shard lease")
+		return
+	}
+	if res == leases.OtherLeased {
+		s.logger.Error(nil, "SKR looper shard lease is held by another replica", "lease", s.leaseName)
+		return
+	}
+
+	holders, err := leases.ListHolders(ctx, s.cluster, s.opts.Namespace, shardLeasePrefix, leaseDurationSec)
+	if err != nil {
+		s.logger.Error(err, "Error listing SKR looper shard leases")
+		return
+	}
+
+	members := []string{s.opts.Self}
+	for _, h := range holders {
+		if h.Expired {
+			if err := leases.Release(ctx, s.cluster, h.LeaseName, s.opts.Namespace, h.HolderIdentity); err != nil {
+				s.logger.V(1).Info("Error reaping expired SKR looper shard lease", "lease", h.LeaseName, "error", err.Error())
+			}
+			continue
+		}
+		if h.HolderIdentity != "" && !slices.Contains(members, h.HolderIdentity) {
+			members = append(members, h.HolderIdentity)
+		}
+	}
+	slices.Sort(members)
+
+	s.mu.Lock()
+	changed := !slices.Equal(s.members, members)
+	s.members = members
+	s.mu.Unlock()
+
+	metrics.SkrLooperShardMembers.Set(float64(len(members)))
+
+	if changed {
+		s.logger.Info("SKR looper shard membership changed", "members", members)
+		s.rebalancer.Rebalance(ctx)
+	}
+}
+
+func (s *leaseShard) ownerOf(kymaName string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return shardOwner(s.members, kymaName)
+}
+
+func (s *leaseShard) Owns(kymaName string) bool {
+	owner, ok := s.ownerOf(kymaName)
+	return ok && owner == s.opts.Self
+}
+
+func (s *leaseShard) Forward(ctx context.Context, kymaName string, targets ...NotificationTarget) {
+	owner, ok := s.ownerOf(kymaName)
+	if !ok || owner == s.opts.Self {
+		return
+	}
+	u := url.URL{
+		Scheme: "http",
+		Host:   owner,
+		Path:   shardForwardPath,
+	}
+	body, err := json.Marshal(shardForwardRequest{Kyma: kymaName, Targets: targets})
+	var req *http.Request
+	if err == nil {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	}
+	if err == nil && s.tokenSource != nil {
+		var token *oauth2.Token
+		token, err = s.tokenSource.Token()
+		if err == nil {
+			req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+		}
+	}
+	if err == nil {
+		req.Header.Set("Content-Type", "application/json")
+		var resp *http.Response
+		resp, err = s.httpClient.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusAccepted {
+				err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+			}
+		}
+	}
+	if err != nil {
+		metrics.SkrLooperNotificationForwardedTotal.WithLabelValues("error").Inc()
+		s.logger.V(1).Info("Error forwarding SKR notification to owning shard", "kyma", kymaName, "owner", owner, "error", err.Error())
+		return
+	}
+	metrics.SkrLooperNotificationForwardedTotal.WithLabelValues("success").Inc()
+}
+
+// =====================================================================
+
+// shardForwardRequest is the body of a notification forwarded between replicas.
+type shardForwardRequest struct {
+	Kyma    string               `json:"kyma"`
+	Targets []NotificationTarget `json:"targets,omitempty"`
+}
+
+// shardForwardServer is a manager.Runnable serving the endpoint peers use to forward
+// notifications for SKRs owned by this replica. Forwarded notifications are handed to
+// notify (== NotifyLocal), which never forwards again, so a transiently diverging
+// membership view between replicas cannot bounce a notification back and forth.
+// Requests are authenticated and authorized by auth, the same way as the admin API, but
+// only tokens with the ShardTokenAudience audience should be accepted.
+type shardForwardServer struct {
+	addr   string
+	notify func(kymaName string, targets ...NotificationTarget)
+	auth   AdminAuth
+	logger logr.Logger
+}
+
+var _ manager.Runnable = &shardForwardServer{}
+var _ manager.LeaderElectionRunnable = &shardForwardServer{}
+
+func NewShardForwardServer(addr string, notify func(kymaName string, targets ...NotificationTarget), auth AdminAuth, logger logr.Logger) *shardForwardServer {
+	return &shardForwardServer{
+		addr:   addr,
+		notify: notify,
+		auth:   auth,
+		logger: logger.WithName("skr-looper-shard-forward"),
+	}
+}
+
+func (s *shardForwardServer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *shardForwardServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	fwd := &shardForwardRequest{}
+	if err := json.NewDecoder(io.LimitReader(r.Body, 1<<20)).Decode(fwd); err != nil || fwd.Kyma == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.notify(fwd.Kyma, fwd.Targets...)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Handler returns the forward endpoint route, wrapped with auth when set.
+func (s *shardForwardServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(shardForwardPath, s)
+	if s.auth == nil {
+		return mux
+	}
+	return s.auth(mux)
+}
+
+func (s *shardForwardServer) Start(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+	s.logger.Info("SKR looper shard forward server started", "addr", s.addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
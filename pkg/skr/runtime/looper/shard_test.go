@@ -0,0 +1,247 @@
+package looper
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+type fakeShard struct {
+	mu        sync.Mutex
+	owned     map[string]bool
+	forwarded []string
+}
+
+func (s *fakeShard) Owns(kymaName string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.owned[kymaName]
+}
+
+func (s *fakeShard) Forward(_ context.Context, kymaName string, _ ...NotificationTarget) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forwarded = append(s.forwarded, kymaName)
+}
+
+func (s *fakeShard) setOwned(owned ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.owned = map[string]bool{}
+	for _, k := range owned {
+		s.owned[k] = true
+	}
+}
+
+// TestShardOwnerIsStableOnMembershipChange: with rendezvous hashing a joining member
+// only takes SKRs over, it never moves SKRs between the existing members.
+func TestShardOwnerIsStableOnMembershipChange(t *testing.T) {
+	before := []string{"10.0.0.1:8084", "10.0.0.2:8084", "10.0.0.3:8084"}
+	after := append([]string{"10.0.0.4:8084"}, before...)
+
+	counts := map[string]int{}
+	for i := range 1000 {
+		kymaName := fmt.Sprintf("kyma-%d", i)
+		o1, ok := shardOwner(before, kymaName)
+		assert.True(t, ok)
+		o2, _ := shardOwner(after, kymaName)
+		if o2 != "10.0.0.4:8084" {
+			assert.Equal(t, o1, o2, "SKR %s moved between existing members", kymaName)
+		}
+		counts[o2]++
+	}
+	for _, m := range after {
+		assert.Greater(t, counts[m], 150, "member %s got an unfair share", m)
+	}
+
+	_, ok := shardOwner(nil, "kyma")
+	assert.False(t, ok)
+}
+
+// TestShardedCollectionLoopsOnlyOwnedSkrs: not owned SKRs are tracked as active but not
+// looped locally, and their notifications are forwarded.
+func TestShardedCollectionLoopsOnlyOwnedSkrs(t *testing.T) {
+	ctx := context.Background()
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	shard := &fakeShard{}
+	shard.setOwned("k1")
+	col.SetShard(shard)
+
+	col.AddKyma(ctx, kymaObj("k1"))
+	col.AddKyma(ctx, kymaObj("k2"))
+	assert.True(t, col.Contains("k1"))
+	assert.False(t, col.Contains("k2"))
+
+	col.Notify("k1")
+	col.Notify("k2")
+	col.Notify("unknown")
+	assert.Equal(t, 1, col.NotificationQueue().Len())
+	assert.Equal(t, []string{"k2"}, shard.forwarded, "only active SKRs of other shards are forwarded")
+
+	// forwarded notifications for SKRs not looped here are dropped, never re-forwarded
+	col.NotifyLocal("k2")
+	assert.Equal(t, 1, col.NotificationQueue().Len())
+	assert.Len(t, shard.forwarded, 1)
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+}
+
+// TestShardedCollectionRebalance: a membership change moves SKRs in and out of the
+// local rotation; removed SKRs are not resurrected by a rebalance.
+func TestShardedCollectionRebalance(t *testing.T) {
+	ctx := context.Background()
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	shard := &fakeShard{}
+	col.SetShard(shard)
+
+	col.AddKyma(ctx, kymaObj("k1"))
+	col.AddKyma(ctx, kymaObj("k2"))
+	col.AddKyma(ctx, kymaObj("k3"))
+	assert.Empty(t, col.GetKymaNames(), "nothing is owned before the first membership sync")
+
+	shard.setOwned("k1", "k2", "k3")
+	col.Rebalance(ctx)
+	assert.ElementsMatch(t, []string{"k1", "k2", "k3"}, col.GetKymaNames())
+
+	col.RemoveKyma(ctx, kymaObj("k3"))
+	shard.setOwned("k2", "k3")
+	col.Rebalance(ctx)
+	assert.ElementsMatch(t, []string{"k2"}, col.GetKymaNames())
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+}
+
+// TestActivateSkipsRemovedSkr: a Rebalance activating from a snapshot of the active set
+// taken before a concurrent remove does not put the removed SKR back into the rotation.
+func TestActivateSkipsRemovedSkr(t *testing.T) {
+	ctx := context.Background()
+	col := newTestCollection(clocktesting.NewFakeClock(time.Now()))
+	shard := &fakeShard{}
+	shard.setOwned("k1")
+	col.SetShard(shard)
+
+	col.AddKyma(ctx, kymaObj("k1"))
+	assert.True(t, col.Contains("k1"))
+
+	col.RemoveKyma(ctx, kymaObj("k1"))
+	col.activate(ctx, "k1", map[string]string{}, "Kyma moved to this SkrLooper shard")
+	assert.False(t, col.Contains("k1"))
+	assert.Equal(t, 0, col.CyclicQueue().MembershipLen())
+
+	col.CyclicQueue().ShutDown()
+	col.NotificationQueue().ShutDown()
+}
+
+// TestShardForwardRoundTrip: a notification forwarded by a non-owning replica lands in
+// the owner's NotifyLocal.
+func TestShardForwardRoundTrip(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	var receivedTargets []NotificationTarget
+	srv := NewShardForwardServer("", func(kymaName string, targets ...NotificationTarget) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, kymaName)
+		receivedTargets = append(receivedTargets, targets...)
+	}, nil, logr.Discard())
+	owner := httptest.NewServer(srv.Handler())
+	defer owner.Close()
+
+	ownerAddr := owner.Listener.Addr().String()
+	s := NewLeaseShard(nil, nil, ShardOptions{Self: "self:1"}, logr.Discard())
+	s.members = []string{ownerAddr}
+
+	assert.False(t, s.Owns("kyma"))
+	target := NotificationTarget{
+		Gvk:       schema.GroupVersionKind{Group: "cloud-resources.kyma-project.io", Version: "v1beta1", Kind: "GcpNfsVolume"},
+		Namespace: "default",
+		Name:      "vol",
+	}
+	s.Forward(context.Background(), "kyma", target)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"kyma"}, received)
+	assert.Equal(t, []NotificationTarget{target}, receivedTargets)
+}
+
+// TestShardForwardIsAuthenticated: the forward endpoint accepts only notifications carrying
+// a token with the shard audience allowed to post to it, and the shard sends the token from
+// its token file. The kube-apiserver token of an allowed peer is rejected.
+func TestShardForwardIsAuthenticated(t *testing.T) {
+	var sarAttrs *authorizationv1.NonResourceAttributes
+	var reviewedAudiences []string
+	c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			switch x := obj.(type) {
+			case *authenticationv1.TokenReview:
+				reviewedAudiences = x.Spec.Audiences
+				user, isApiserverToken := strings.CutSuffix(x.Spec.Token, "-apiserver")
+				x.Status.Authenticated = true
+				x.Status.User.Username = user
+				x.Status.Audiences = []string{ShardTokenAudience}
+				if isApiserverToken {
+					x.Status.Audiences = []string{"https://kubernetes.default.svc"}
+				}
+			case *authorizationv1.SubjectAccessReview:
+				sarAttrs = x.Spec.NonResourceAttributes
+				x.Status.Allowed = x.Spec.User == "peer"
+			}
+			return nil
+		},
+	}).Build()
+
+	var mu sync.Mutex
+	var received []string
+	srv := NewShardForwardServer("", func(kymaName string, targets ...NotificationTarget) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, kymaName)
+	}, NewKubeAdminAuth(c, ShardTokenAudience), logr.Discard())
+	owner := httptest.NewServer(srv.Handler())
+	defer owner.Close()
+	ownerAddr := owner.Listener.Addr().String()
+
+	newShard := func(token string) *leaseShard {
+		opts := ShardOptions{Self: "self:1"}
+		if token != "" {
+			opts.BearerTokenFile = filepath.Join(t.TempDir(), "token")
+			require.NoError(t, os.WriteFile(opts.BearerTokenFile, []byte(token), 0600))
+		}
+		s := NewLeaseShard(nil, nil, opts, logr.Discard())
+		s.members = []string{ownerAddr}
+		return s
+	}
+
+	newShard("").Forward(context.Background(), "kyma-anonymous")
+	newShard("stranger").Forward(context.Background(), "kyma-stranger")
+	newShard("peer-apiserver").Forward(context.Background(), "kyma-peer-apiserver")
+	newShard("peer").Forward(context.Background(), "kyma-peer")
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"kyma-peer"}, received)
+	assert.Equal(t, []string{ShardTokenAudience}, reviewedAudiences)
+	require.NotNil(t, sarAttrs)
+	assert.Equal(t, shardForwardPath, sarAttrs.Path)
+	assert.Equal(t, "post", sarAttrs.Verb)
+}
@@ -1,6 +1,7 @@
 package looper
 
 import (
+	"maps"
 	"sync"
 
 	"github.com/kyma-project/cloud-manager/pkg/metrics"
@@ -11,25 +12,38 @@ import (
 // for the whole handleOneSkr lifetime (~10s) but the gate mutex is NOT held
 // during that time.
 type SkrGate struct {
-	mu       sync.Mutex
-	inFlight map[string]struct{}
+	mu sync.Mutex
+	// inFlight maps each claimed kymaName to its owner, ie "cyclic/3"
+	inFlight map[string]string
 }
 
-func NewSkrGate() *SkrGate { return &SkrGate{inFlight: map[string]struct{}{}} }
+func NewSkrGate() *SkrGate { return &SkrGate{inFlight: map[string]string{}} }
 
 // TryClaim atomically inserts kymaName. Returns true if the caller now owns the
 // claim, false if another worker (either sleeve) already holds it. Never blocks.
 func (g *SkrGate) TryClaim(kymaName string) bool {
+	return g.TryClaimBy(kymaName, "")
+}
+
+// TryClaimBy is TryClaim recording the owner of the claim, reported by Owners.
+func (g *SkrGate) TryClaimBy(kymaName string, owner string) bool {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 	if _, held := g.inFlight[kymaName]; held {
 		return false
 	}
-	g.inFlight[kymaName] = struct{}{}
+	g.inFlight[kymaName] = owner
 	metrics.SkrLooperGateInFlight.Inc()
 	return true
 }
 
+// Owners returns a snapshot of the held claims with their owners.
+func (g *SkrGate) Owners() map[string]string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return maps.Clone(g.inFlight)
+}
+
 // Release removes the claim. Idempotent (defensive against double-release).
 func (g *SkrGate) Release(kymaName string) {
 	g.mu.Lock()
@@ -21,12 +21,21 @@ import (
 // don't care about rate limiting are unaffected (they use distinct keys / single notifies).
 func newTestCollection(c clock.WithTicker) *activeSkrCollection {
 	return &activeSkrCollection{
-		cyclicQueue:      newQueueWithClock(c),
-		notifQueue:       newQueueWithClock(c),
-		gate:             NewSkrGate(),
-		clock:            c,
-		notifMinInterval: 0, // disabled by default in tests; rate-limit tests set it explicitly
-		lastNotifConnect: map[string]time.Time{},
+		cyclicQueue:         newQueueWithClock(c),
+		notifQueue:          newQueueWithClock(c),
+		priorityQueue:       newQueueWithClock(c),
+		priorityBrokerPlans: map[string]struct{}{},
+		priorityReasons:     map[string]priorityReason{},
+		gate:                NewSkrGate(),
+		clock:               c,
+		notifMinInterval:    0, // disabled by default in tests; rate-limit tests set it explicitly
+		lastNotifConnect:    map[string]time.Time{},
+		notifPending:        map[string]*pendingNotif{},
+		active:              map[string]map[string]string{},
+		health:              map[string]skrHealth{},
+		failingCount:        map[string]int{},
+		paused:              map[string]struct{}{},
+		lastConnect:         map[string]time.Time{},
 	}
 }
 
@@ -38,7 +47,9 @@ func newTestCollection(c clock.WithTicker) *activeSkrCollection {
 func newTestLooper(col *activeSkrCollection, handle func(id int, kymaName string)) *skrLooper {
 	return &skrLooper{
 		ActiveSkrCollectionAdmin: col,
-		handleFn:                 handle,
+		handleFn: func(id int, kymaName string, _ []NotificationTarget) {
+			handle(id, kymaName)
+		},
 		cyclicMinInterval:        60 * time.Second,
 		reconcileTimeout:         10 * time.Second,
 		cyclicImmediateThreshold: 1,
@@ -3,9 +3,11 @@ package looper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -33,8 +35,9 @@ type ActiveSkrCollection interface {
 	AddKyma(ctx context.Context, kyma *unstructured.Unstructured)
 	RemoveKyma(ctx context.Context, kyma *unstructured.Unstructured)
 	// Notify enqueues a notification-driven reconcile for kymaName into the fast
-	// notification sleeve. It is a no-op if the SKR is not currently active.
-	Notify(kymaName string)
+	// notification sleeve. It is a no-op if the SKR is not currently active. With
+	// targets the connect reconciles only those objects, without it the whole SKR.
+	Notify(kymaName string, targets ...NotificationTarget)
 	Contains(kymaName string) bool
 	GetKymaNames() []string
 }
@@ -43,10 +46,37 @@ type ActiveSkrCollectionAdmin interface {
 	ActiveSkrCollection
 	CyclicQueue() *Queue
 	NotificationQueue() *Queue
+	// PriorityQueue holds the SKRs of the priority lane, see priorityReason.
+	PriorityQueue() *Queue
 	Gate() *SkrGate
 	// recordNotifConnect stamps a completed notification-driven connect for the per-SKR
 	// notification rate limiter. Called by the notification worker on the success path.
 	recordNotifConnect(kymaName string)
+	// takeNotifTargets returns and clears the targets accumulated for the next
+	// notification-driven connect of kymaName. Nil means a full sync.
+	takeNotifTargets(kymaName string) []NotificationTarget
+	// isPriority reports whether kymaName is in the priority lane.
+	isPriority(kymaName string) bool
+	// markUrgent puts kymaName in or out of the priority lane depending on whether its
+	// last connect saw objects being deleted or in Error state.
+	markUrgent(kymaName string, urgent bool)
+	// reportResults records the controller results of a finished run of kymaName for the
+	// fleet level failing and timed out SKR gauges.
+	reportResults(kymaName string, results SkrRunResults)
+	// recordConnect stamps the completion of a connect of kymaName on any sleeve.
+	recordConnect(kymaName string)
+	// isHeld reports whether kymaName must not be connected now, because it is paused or
+	// the looper is draining.
+	isHeld(kymaName string) bool
+	SkrLooperAdmin
+	// SetShard enables sharding: only SKRs owned by the shard are looped by this replica
+	// and notifications for other SKRs are forwarded to their owner. Must be called
+	// before the collection is used.
+	SetShard(shard SkrShard)
+	// NotifyLocal is Notify without shard routing, used for notifications forwarded
+	// by other replicas. It drops notifications for SKRs this replica does not loop.
+	NotifyLocal(kymaName string, targets ...NotificationTarget)
+	ShardRebalancer
 }
 
 func NewActiveSkrCollection() ActiveSkrCollectionAdmin {
@@ -54,13 +84,26 @@ func NewActiveSkrCollection() ActiveSkrCollectionAdmin {
 }
 
 func newActiveSkrCollectionWithClock(c clock.Clock) *activeSkrCollection {
+	priorityBrokerPlans := map[string]struct{}{}
+	for _, plan := range skrruntimeconfig.SkrRuntimeConfig.SkrPriorityBrokerPlans {
+		priorityBrokerPlans[plan] = struct{}{}
+	}
 	return &activeSkrCollection{
-		cyclicQueue:      NewQueue(),
-		notifQueue:       NewQueue(),
-		gate:             NewSkrGate(),
-		clock:            c,
-		notifMinInterval: skrruntimeconfig.SkrRuntimeConfig.SkrNotifMinInterval,
-		lastNotifConnect: map[string]time.Time{},
+		cyclicQueue:         NewQueue(),
+		notifQueue:          NewQueue(),
+		priorityQueue:       NewQueue(),
+		priorityBrokerPlans: priorityBrokerPlans,
+		priorityReasons:     map[string]priorityReason{},
+		gate:                NewSkrGate(),
+		clock:               c,
+		notifMinInterval:    skrruntimeconfig.SkrRuntimeConfig.SkrNotifMinInterval,
+		lastNotifConnect:    map[string]time.Time{},
+		notifPending:        map[string]*pendingNotif{},
+		active:              map[string]map[string]string{},
+		health:              map[string]skrHealth{},
+		failingCount:        map[string]int{},
+		paused:              map[string]struct{}{},
+		lastConnect:         map[string]time.Time{},
 	}
 }
 
@@ -72,6 +115,12 @@ type activeSkrCollection struct {
 	cyclicQueue *Queue
 	// notifQueue holds notification-driven SKRs for the fast user-facing sleeve.
 	notifQueue *Queue
+	// priorityQueue holds SKRs of the priority lane, served by their own worker pool
+	// in addition to their regular cyclic turn.
+	priorityQueue       *Queue
+	priorityBrokerPlans map[string]struct{}
+	priorityMu          sync.Mutex
+	priorityReasons     map[string]priorityReason
 	// gate guarantees at most one live manager per SKR across both sleeves.
 	gate *SkrGate
 
@@ -83,12 +132,46 @@ type activeSkrCollection struct {
 	notifMinInterval time.Duration
 	notifMu          sync.Mutex
 	lastNotifConnect map[string]time.Time
+	// notifPending holds per SKR the objects named by notifications since its last
+	// notification-driven connect, guarded by notifMu.
+	notifPending map[string]*pendingNotif
+
+	// active holds every SKR activated by the KCP reconcilers, with its labels, regardless of
+	// shard ownership. The cyclic queue holds only the owned subset. Without a shard the two
+	// are the same set.
+	shard    SkrShard
+	activeMu sync.Mutex
+	active   map[string]map[string]string
+
+	// health holds the last run results of every owned SKR, failingCount and timedOutCount
+	// the fleet counts derived from them, all guarded by healthMu.
+	healthMu      sync.Mutex
+	health        map[string]skrHealth
+	failingCount  map[string]int
+	timedOutCount int
+
+	// adminMu guards the state steered by the admin API, the paused SKRs, and the time of
+	// the last connect of each SKR. draining holds back every connect.
+	adminMu     sync.Mutex
+	paused      map[string]struct{}
+	lastConnect map[string]time.Time
+	draining    atomic.Bool
 }
 
 func (l *activeSkrCollection) CyclicQueue() *Queue       { return l.cyclicQueue }
 func (l *activeSkrCollection) NotificationQueue() *Queue { return l.notifQueue }
+func (l *activeSkrCollection) PriorityQueue() *Queue     { return l.priorityQueue }
 func (l *activeSkrCollection) Gate() *SkrGate            { return l.gate }
 
+func (l *activeSkrCollection) SetShard(shard SkrShard) {
+	l.shard = shard
+}
+
+// owns reports whether this replica handles kymaName. Without a shard every SKR is local.
+func (l *activeSkrCollection) owns(kymaName string) bool {
+	return l.shard == nil || l.shard.Owns(kymaName)
+}
+
 func (l *activeSkrCollection) AddScope(ctx context.Context, scope *cloudcontrolv1beta1.Scope) {
 	l.add(ctx, scope)
 }
@@ -104,6 +187,32 @@ func (l *activeSkrCollection) add(ctx context.Context, obj client.Object) {
 	if labels == nil {
 		labels = map[string]string{}
 	}
+
+	l.activeMu.Lock()
+	l.active[kymaName] = labels
+	l.activeMu.Unlock()
+
+	if !l.owns(kymaName) {
+		// Another replica's shard owns this SKR. It stays in the fleet-wide active set so
+		// a later Rebalance can pick it up if ownership moves to this replica.
+		return
+	}
+
+	l.activate(ctx, kymaName, labels, "Adding Kyma to SkrLooper")
+}
+
+// activate puts an owned SKR into the cyclic rotation. It is a no-op if the SKR is no
+// longer in the active set, so a Rebalance working on a stale snapshot of the active set
+// can not resurrect an SKR removed in the meantime.
+func (l *activeSkrCollection) activate(ctx context.Context, kymaName string, labels map[string]string, msg string) {
+	// Membership is re-checked and the SKR enqueued under activeMu, so a concurrent remove
+	// either runs before and is seen here, or runs after and deactivates what is enqueued here.
+	l.activeMu.Lock()
+	defer l.activeMu.Unlock()
+	if _, ok := l.active[kymaName]; !ok {
+		return
+	}
+
 	globalAccountId := labels[cloudcontrolv1beta1.LabelScopeGlobalAccountId]
 	subaccountId := labels[cloudcontrolv1beta1.LabelScopeSubaccountId]
 	shootName := labels[cloudcontrolv1beta1.LabelScopeShootName]
@@ -118,7 +227,7 @@ func (l *activeSkrCollection) add(ctx context.Context, obj client.Object) {
 		"shootName", shootName,
 		"region", region,
 		"brokerPlanName", brokerPlanName,
-	).Info("Adding Kyma to SkrLooper")
+	).Info(msg)
 
 	// Only enqueue (and count) a genuinely new activation. An already-active SKR is
 	// already in the cyclic rotation and must NOT be re-added: the KCP Kyma reconciler
@@ -135,10 +244,28 @@ func (l *activeSkrCollection) add(ctx context.Context, obj client.Object) {
 		metrics.
 			SkrRuntimeModuleActiveCount.WithLabelValues(kymaName, globalAccountId, subaccountId, shootName, region, brokerPlanName).
 			Add(1)
+		if l.shard != nil {
+			metrics.SkrLooperShardOwnedCount.Set(float64(l.cyclicQueue.MembershipLen()))
+		}
 	}
+	l.updatePriority(kymaName, priorityReasonBrokerPlan, l.isPriorityBrokerPlan(brokerPlanName))
 }
 
-func (l *activeSkrCollection) Notify(kymaName string) {
+func (l *activeSkrCollection) Notify(kymaName string, targets ...NotificationTarget) {
+	if !l.owns(kymaName) {
+		// Route to the owning replica, but only for SKRs that are active fleet-wide.
+		l.activeMu.Lock()
+		_, active := l.active[kymaName]
+		l.activeMu.Unlock()
+		if active {
+			l.shard.Forward(context.Background(), kymaName, targets...)
+		}
+		return
+	}
+	l.NotifyLocal(kymaName, targets...)
+}
+
+func (l *activeSkrCollection) NotifyLocal(kymaName string, targets ...NotificationTarget) {
 	// Drop notifications for SKRs that are not active (never added, or deactivated).
 	// Re-activation only ever comes from the KCP reconciler via AddKyma/AddScope.
 	if !l.cyclicQueue.Contains(kymaName) {
@@ -146,19 +273,36 @@ func (l *activeSkrCollection) Notify(kymaName string) {
 	}
 	// Per-SKR rate limit: drop (coalesce) notifications arriving within notifMinInterval of
 	// this SKR's last notification-driven connect. A disabled/zero interval lets all through.
+	l.notifMu.Lock()
 	if l.notifMinInterval > 0 {
-		l.notifMu.Lock()
 		last, ok := l.lastNotifConnect[kymaName]
-		tooSoon := ok && l.clock.Now().Sub(last) < l.notifMinInterval
-		l.notifMu.Unlock()
-		if tooSoon {
+		if ok && l.clock.Now().Sub(last) < l.notifMinInterval {
+			l.notifMu.Unlock()
 			metrics.SkrLooperNotificationRateLimitedTotal.WithLabelValues(kymaName).Inc()
 			return
 		}
 	}
+	pending, ok := l.notifPending[kymaName]
+	if !ok {
+		pending = &pendingNotif{}
+		l.notifPending[kymaName] = pending
+	}
+	pending.add(targets)
+	l.notifMu.Unlock()
 	l.notifQueue.Add(kymaName)
 }
 
+func (l *activeSkrCollection) takeNotifTargets(kymaName string) []NotificationTarget {
+	l.notifMu.Lock()
+	defer l.notifMu.Unlock()
+	pending, ok := l.notifPending[kymaName]
+	delete(l.notifPending, kymaName)
+	if !ok || pending.full {
+		return nil
+	}
+	return pending.targets
+}
+
 // recordNotifConnect stamps the time an SKR's notification-driven connect completed. The
 // next Notify within notifMinInterval of this stamp is coalesced. Keyed on connect time
 // (not receive time) so a burst collapses to one connect per interval, after which the SKR
@@ -179,14 +323,25 @@ func (l *activeSkrCollection) RemoveKyma(ctx context.Context, kyma *unstructured
 
 func (l *activeSkrCollection) remove(ctx context.Context, obj client.Object) {
 	kymaName := obj.GetName()
-	if !l.cyclicQueue.Contains(kymaName) {
-		return
-	}
+
+	l.activeMu.Lock()
+	delete(l.active, kymaName)
+	l.activeMu.Unlock()
 
 	labels := obj.GetLabels()
 	if labels == nil {
 		labels = map[string]string{}
 	}
+	l.deactivate(ctx, kymaName, labels, "Removing Kyma from SkrLooper")
+}
+
+// deactivate takes an SKR out of this replica's rotation. It is a no-op if the SKR is
+// not in the cyclic queue.
+func (l *activeSkrCollection) deactivate(ctx context.Context, kymaName string, labels map[string]string, msg string) {
+	if !l.cyclicQueue.Contains(kymaName) {
+		return
+	}
+
 	globalAccountId := labels[cloudcontrolv1beta1.LabelScopeGlobalAccountId]
 	subaccountId := labels[cloudcontrolv1beta1.LabelScopeSubaccountId]
 	shootName := labels[cloudcontrolv1beta1.LabelScopeShootName]
@@ -201,17 +356,47 @@ func (l *activeSkrCollection) remove(ctx context.Context, obj client.Object) {
 		"shootName", shootName,
 		"region", region,
 		"brokerPlanName", brokerPlanName,
-	).Info("Removing Kyma from SkrLooper")
+	).Info(msg)
 
 	// Clear membership on both queues. This never aborts a running manager nor
 	// touches the gate claim; the owning worker's Release frees the claim when its
 	// manager finishes (graceful teardown).
 	l.cyclicQueue.Remove(kymaName)
 	l.notifQueue.Remove(kymaName)
+	l.notifMu.Lock()
+	delete(l.notifPending, kymaName)
+	l.notifMu.Unlock()
+	l.clearPriority(kymaName)
+	l.clearHealth(kymaName)
+	l.forgetAdminState(kymaName)
 
 	metrics.
 		SkrRuntimeModuleActiveCount.WithLabelValues(kymaName, globalAccountId, subaccountId, shootName, region, brokerPlanName).
 		Add(-1)
+	if l.shard != nil {
+		metrics.SkrLooperShardOwnedCount.Set(float64(l.cyclicQueue.MembershipLen()))
+	}
+}
+
+// Rebalance re-evaluates shard ownership of every active SKR after a membership change.
+// SKRs that moved to this replica join the cyclic rotation, SKRs that moved away leave it.
+// A manager already running for an SKR that moved away finishes gracefully, so the new
+// owner may briefly connect to the same SKR in parallel, bounded by one reconcile timeout.
+func (l *activeSkrCollection) Rebalance(ctx context.Context) {
+	l.activeMu.Lock()
+	active := make(map[string]map[string]string, len(l.active))
+	for k, v := range l.active {
+		active[k] = v
+	}
+	l.activeMu.Unlock()
+
+	for kymaName, labels := range active {
+		if l.owns(kymaName) {
+			l.activate(ctx, kymaName, labels, "Kyma moved to this SkrLooper shard")
+		} else {
+			l.deactivate(ctx, kymaName, labels, "Kyma moved to another SkrLooper shard")
+		}
+	}
 }
 
 func (l *activeSkrCollection) Contains(kymaName string) bool {
@@ -240,6 +425,9 @@ func New(activeSkrCollection ActiveSkrCollectionAdmin, kcpCluster cluster.Cluste
 		notificationConcurrency:  skrruntimeconfig.SkrRuntimeConfig.NotificationConcurrency,
 		cyclicConcurrency:        skrruntimeconfig.SkrRuntimeConfig.CyclicConcurrency,
 		cyclicMinInterval:        skrruntimeconfig.SkrRuntimeConfig.SkrCyclicMinInterval,
+		priorityConcurrency:      skrruntimeconfig.SkrRuntimeConfig.PriorityConcurrency,
+		priorityMinInterval:      skrruntimeconfig.SkrRuntimeConfig.SkrPriorityMinInterval,
+		gateConflictRetryDelay:   skrruntimeconfig.SkrRuntimeConfig.SkrGateConflictRetryDelay,
 		reconcileTimeout:         debugged.When(15*time.Minute, 10*time.Second),
 		workerTimeout:            skrruntimeconfig.SkrRuntimeConfig.SkrWorkerTimeout,
 	}
@@ -265,6 +453,12 @@ type skrLooper struct {
 	cyclicConcurrency       int
 	cyclicMinInterval       time.Duration
 
+	// priorityConcurrency sizes the priority lane worker pool (0 disables the lane),
+	// priorityMinInterval is the minimum time between two priority connects of one SKR.
+	priorityConcurrency    int
+	priorityMinInterval    time.Duration
+	gateConflictRetryDelay time.Duration
+
 	// reconcileTimeout bounds a single handleOneSkr connection (10s normally, 15min
 	// under the `debug` build tag). It is also the denominator of the cyclic re-add
 	// threshold, so keeping it in one field keeps the two in sync.
@@ -286,7 +480,7 @@ type skrLooper struct {
 
 	// handleFn is the per-SKR handler; defaults to handleOneSkr and is injectable
 	// so the pool logic is testable without a live per-SKR manager (envtest).
-	handleFn func(skrWorkerId int, kymaName string)
+	handleFn func(skrWorkerId int, kymaName string, targets []NotificationTarget)
 
 	// wg the WorkGroup for workers
 	wg      sync.WaitGroup
@@ -296,6 +490,14 @@ type skrLooper struct {
 	ctx context.Context
 }
 
+var _ manager.LeaderElectionRunnable = &skrLooper{}
+
+// NeedLeaderElection keeps the unsharded looper on the leader only. A sharded looper
+// runs on every replica and loops the SKRs owned by the replica's shard.
+func (l *skrLooper) NeedLeaderElection() bool {
+	return !skrruntimeconfig.SkrRuntimeConfig.ShardingEnabled
+}
+
 func (l *skrLooper) Start(ctx context.Context) error {
 	if l.started {
 		return errors.New("looper already started")
@@ -306,23 +508,28 @@ func (l *skrLooper) Start(ctx context.Context) error {
 		"SkrLooper started",
 		"notificationConcurrency", l.notificationConcurrency,
 		"cyclicConcurrency", l.cyclicConcurrency,
+		"priorityConcurrency", l.priorityConcurrency,
 		"cyclicImmediateThreshold", l.cyclicImmediateThreshold,
 	)
 	l.ctx = ctx
 
-	l.wg.Add(l.notificationConcurrency + l.cyclicConcurrency)
+	l.wg.Add(l.notificationConcurrency + l.cyclicConcurrency + l.priorityConcurrency)
 	for x := 0; x < l.notificationConcurrency; x++ {
 		go l.notificationWorker(x)
 	}
 	for x := 0; x < l.cyclicConcurrency; x++ {
 		go l.cyclicWorker(x)
 	}
+	for x := 0; x < l.priorityConcurrency; x++ {
+		go l.priorityWorker(x)
+	}
 
 	<-ctx.Done()
 
 	l.logger.Info("SkrLooper context closed, shutting down the queues")
 	l.NotificationQueue().ShutDown()
 	l.CyclicQueue().ShutDown()
+	l.PriorityQueue().ShutDown()
 	l.logger.Info("SkrLooper waiting workers to finish")
 	l.wg.Wait()
 	l.logger.Info("SkrLooper stopped")
@@ -331,10 +538,11 @@ func (l *skrLooper) Start(ctx context.Context) error {
 
 // processOne performs one guarded Get→handle cycle on q. It returns true when the
 // queue is shutting down (the worker should exit). reAdd runs on the SUCCESS path
-// only (after handle returns) — never on the shutdown, membership-drop, or gate-
-// conflict paths. onConflict runs when the cross-sleeve gate is already held by the
-// other sleeve; it is sleeve-specific (see the worker call sites) and must NOT reorder
-// the cyclic queue (that is what caused the fairness long-tail).
+// only (after handle returns) — never on the shutdown, membership-drop, admin hold, or
+// gate-conflict paths; held SKRs are re-queued by holdReAdd. onConflict runs when the
+// cross-sleeve gate is already held by the other sleeve; it is sleeve-specific (see the
+// worker call sites) and must NOT reorder the cyclic queue (that is what caused the
+// fairness long-tail).
 func (l *skrLooper) processOne(id int, q *Queue, sleeve string, reAdd func(kymaName string), onConflict func(item string)) bool {
 	item, shuttingDown := q.Get()
 	if shuttingDown {
@@ -346,19 +554,33 @@ func (l *skrLooper) processOne(id int, q *Queue, sleeve string, reAdd func(kymaN
 		// Guard 1 — membership: drop stray work for deactivated runtimes. A stale
 		// delayed re-add OR a stray notification can deliver a kymaName that was
 		// removed while queued. Re-activation only ever comes from the KCP reconciler.
-		if !l.Contains(item) {
+		// The queue's own membership additionally drops SKRs that left the priority lane.
+		if !l.Contains(item) || !q.Contains(item) {
 			return // drop: no claim, no connect, no re-add
 		}
 
+		// Guard 1b — admin hold: a paused SKR, or any SKR while draining, is not connected.
+		if l.isHeld(item) {
+			l.holdReAdd(q, sleeve, item)
+			return
+		}
+
 		// Guard 2 — cross-sleeve single-manager guarantee.
-		if !l.Gate().TryClaim(item) {
+		if !l.Gate().TryClaimBy(item, fmt.Sprintf("%s/%d", sleeve, id)) {
 			metrics.SkrLooperGateConflictTotal.WithLabelValues(sleeve).Inc()
 			onConflict(item) // sleeve-specific; must not reshuffle the cyclic tail
 			return
 		}
 		defer l.Gate().Release(item) // INNER defer — runs before q.Done (LIFO)
 
-		l.handleFn(id, item)
+		// Only the notification sleeve narrows a connect to the notified objects; the
+		// cyclic sleeve is the full-sync fallback and leaves pending targets alone.
+		var targets []NotificationTarget
+		if sleeve == "notification" {
+			targets = l.takeNotifTargets(item)
+		}
+		l.handleFn(id, item, targets)
+		l.recordConnect(item)
 
 		reAdd(item) // success path only
 	}()
@@ -422,7 +644,7 @@ func (l *skrLooper) cyclicReAdd(kymaName string) {
 	}
 }
 
-func (l *skrLooper) handleOneSkr(skrWorkerId int, kymaName string) {
+func (l *skrLooper) handleOneSkr(skrWorkerId int, kymaName string, targets []NotificationTarget) {
 	defer func() {
 		metrics.SkrRuntimeReconcileTotal.WithLabelValues(kymaName).Inc()
 	}()
@@ -478,7 +700,18 @@ func (l *skrLooper) handleOneSkr(skrWorkerId int, kymaName string) {
 
 	runner := NewSkrRunner(l.registry, l.kcpCluster, l.skrStatusSaver, kymaName)
 
-	err = runner.Run(ctx, skrManager, WithTimeout(l.reconcileTimeout), WithProvider(scope.Spec.Provider))
+	err = runner.Run(
+		ctx, skrManager,
+		WithTimeout(l.reconcileTimeout),
+		WithProvider(scope.Spec.Provider),
+		WithTargets(targets),
+		WithUrgencyReport(func(urgent bool) {
+			l.markUrgent(kymaName, urgent)
+		}),
+		WithResultsReport(func(results SkrRunResults) {
+			l.reportResults(kymaName, results)
+		}),
+	)
 	if util.IgnoreContextCanceledAndDeadlineExceeded(err) != nil {
 		if !apierrors.IsTimeout(err) {
 			logger.Error(err, "Error running SKR Runner")
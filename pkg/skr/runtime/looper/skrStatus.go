@@ -3,7 +3,10 @@ package looper
 import (
 	"context"
 	"fmt"
+	"maps"
 	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/elliotchance/pie/v2"
@@ -26,6 +29,10 @@ func NewSkrStatusRepo(kcpClient client.Client) SkrStatusRepo {
 type SkrStatusRepo interface {
 	Load(ctx context.Context, name, namespace string) (*cloudcontrolv1beta1.SkrStatus, error)
 	Save(ctx context.Context, skrStatus *cloudcontrolv1beta1.SkrStatus) error
+	// Patch merge patches the metadata of skrStatus compared to base
+	Patch(ctx context.Context, skrStatus, base *cloudcontrolv1beta1.SkrStatus) error
+	// PatchStatus merge patches the status subresource of skrStatus compared to base
+	PatchStatus(ctx context.Context, skrStatus, base *cloudcontrolv1beta1.SkrStatus) error
 }
 
 type skrStatusRepo struct {
@@ -52,6 +59,14 @@ func (r *skrStatusRepo) Save(ctx context.Context, skrStatus *cloudcontrolv1beta1
 	return r.kcpClient.Patch(ctx, skrStatus, client.Apply, client.ForceOwnership, client.FieldOwner(common.FieldOwner)) //nolint:staticcheck // will be removed once client.Apply is removed
 }
 
+func (r *skrStatusRepo) Patch(ctx context.Context, skrStatus, base *cloudcontrolv1beta1.SkrStatus) error {
+	return r.kcpClient.Patch(ctx, skrStatus, client.MergeFrom(base))
+}
+
+func (r *skrStatusRepo) PatchStatus(ctx context.Context, skrStatus, base *cloudcontrolv1beta1.SkrStatus) error {
+	return r.kcpClient.Status().Patch(ctx, skrStatus, client.MergeFrom(base))
+}
+
 // SkrStatusSaver ==========================================================================
 
 func NewNoopStatusSaver() SkrStatusSaver {
@@ -67,6 +82,9 @@ func NewSkrStatusSaver(repo SkrStatusRepo, namespace string) SkrStatusSaver {
 
 type SkrStatusSaver interface {
 	Save(ctx context.Context, skrStatus *SkrStatus) error
+	// SaveResults writes the controller results of a finished run into the status of an
+	// already saved SkrStatus and labels it for each failing controller
+	SaveResults(ctx context.Context, skrStatus *SkrStatus) error
 }
 
 type noopSkrStatusSaver struct{}
@@ -75,6 +93,10 @@ func (s *noopSkrStatusSaver) Save(_ context.Context, _ *SkrStatus) error {
 	return nil
 }
 
+func (s *noopSkrStatusSaver) SaveResults(_ context.Context, _ *SkrStatus) error {
+	return nil
+}
+
 type skrStatusSaver struct {
 	repo      SkrStatusRepo
 	namespace string
@@ -152,6 +174,104 @@ func (s *skrStatusSaver) Save(ctx context.Context, skrStatus *SkrStatus) error {
 	return s.repo.Save(ctx, api.CloneForPatch())
 }
 
+func (s *skrStatusSaver) SaveResults(ctx context.Context, skrStatus *SkrStatus) error {
+	if skrStatus.results == nil {
+		return nil
+	}
+	api, err := s.repo.Load(ctx, skrStatus.kyma, s.namespace)
+	if err != nil {
+		return fmt.Errorf("error loading SkrStatus: %w", err)
+	}
+	if api.ResourceVersion == "" {
+		// the SkrStatus was never saved, there is nothing to attach the results to
+		return nil
+	}
+
+	base := api.DeepCopy()
+	api.Status = mergeSkrStatusResults(api.Status, *skrStatus.results, metav1.Now())
+	if err := s.repo.PatchStatus(ctx, api, base); err != nil {
+		return fmt.Errorf("error patching SkrStatus status: %w", err)
+	}
+
+	base = api.DeepCopy()
+	labels := failingControllerLabels(api.Labels, api.Status.Controllers)
+	if maps.Equal(labels, api.Labels) {
+		return nil
+	}
+	api.Labels = labels
+	if err := s.repo.Patch(ctx, api, base); err != nil {
+		return fmt.Errorf("error patching SkrStatus labels: %w", err)
+	}
+	return nil
+}
+
+// mergeSkrStatusResults sets the results of a run into the status. A full run replaces
+// all controller results, a partial run only the results of the controllers it ran.
+func mergeSkrStatusResults(status cloudcontrolv1beta1.SkrStatusStatus, results SkrRunResults, now metav1.Time) cloudcontrolv1beta1.SkrStatusStatus {
+	status.LastRunTime = &now
+	status.TimedOut = results.TimedOut
+
+	if !results.Partial {
+		status.Controllers = nil
+	}
+	for _, r := range results.Controllers {
+		x := cloudcontrolv1beta1.SkrStatusControllerResult{
+			ObjKindGroup:         r.ObjKindGroup,
+			ObjectsReconciled:    r.ObjectsReconciled,
+			ObjectsInError:       r.ObjectsInError,
+			LastError:            r.LastError,
+			DurationMilliseconds: r.Duration.Milliseconds(),
+			TimedOut:             r.TimedOut,
+		}
+		idx := slices.IndexFunc(status.Controllers, func(c cloudcontrolv1beta1.SkrStatusControllerResult) bool {
+			return c.ObjKindGroup == r.ObjKindGroup
+		})
+		if idx == -1 {
+			status.Controllers = append(status.Controllers, x)
+		} else {
+			status.Controllers[idx] = x
+		}
+	}
+
+	status.FailingControllers = 0
+	for _, c := range status.Controllers {
+		if controllerResultFailing(c) {
+			status.FailingControllers++
+		}
+	}
+	return status
+}
+
+func controllerResultFailing(c cloudcontrolv1beta1.SkrStatusControllerResult) bool {
+	return c.ObjectsInError > 0 || c.LastError != ""
+}
+
+// failingControllerLabels returns a copy of labels with one LabelSkrStatusFailingPrefix label
+// for each failing controller, so all SKRs failing on a kind are listed with a label selector.
+func failingControllerLabels(labels map[string]string, controllers []cloudcontrolv1beta1.SkrStatusControllerResult) map[string]string {
+	result := map[string]string{}
+	for k, v := range labels {
+		if !strings.HasPrefix(k, cloudcontrolv1beta1.LabelSkrStatusFailingPrefix) {
+			result[k] = v
+		}
+	}
+	for _, c := range controllers {
+		if controllerResultFailing(c) {
+			result[failingControllerLabel(c.ObjKindGroup)] = "true"
+		}
+	}
+	return result
+}
+
+// failingControllerLabel returns the failing label for the lowercase kind.group, named by the kind only.
+func failingControllerLabel(objKindGroup string) string {
+	kind, _, _ := strings.Cut(objKindGroup, ".")
+	if len(kind) > 63 {
+		kind = kind[:63]
+	}
+	return cloudcontrolv1beta1.LabelSkrStatusFailingPrefix + kind
+}
+
 // SkrStatus =============================================================================
 
 func NewSkrStatus(ctx context.Context) *SkrStatus {
@@ -184,6 +304,8 @@ type SkrStatus struct {
 
 	ok      bool
 	outcome string
+
+	results *SkrRunResults
 }
 
 type KindForm string
@@ -219,6 +341,11 @@ func (s *SkrStatus) Connected() {
 	s.ok = true
 }
 
+// Results called with the controller results once the SKR manager stopped
+func (s *SkrStatus) Results(results SkrRunResults) {
+	s.results = &results
+}
+
 // Handle called for each manifest found in the installation files. The outcome is recorded by called a method on the returned handle
 func (s *SkrStatus) Handle(ctx context.Context, title string) *KindHandle {
 	reader := feature.NewContextReaderFromCtx(ctx)
@@ -275,6 +402,13 @@ func (h *KindHandle) Starting() {
 	h.outcomes = append(h.outcomes, "Starting")
 }
 
+// NotTargeted called if a controller is not started because the connect is narrowed to
+// the objects of a notification handled by other controllers
+func (h *KindHandle) NotTargeted() {
+	h.outcomes = append(h.outcomes, "NotTargeted")
+	h.ok = true
+}
+
 // Error called when creating, updating or starting got an error
 func (h *KindHandle) Error(err error) {
 	h.outcomes = append(h.outcomes, fmt.Sprintf("Error: %v", err))
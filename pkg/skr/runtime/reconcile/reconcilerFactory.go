@@ -3,6 +3,8 @@ package reconcile
 import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -17,6 +19,18 @@ type ReconcilerArguments struct {
 	Provider *cloudcontrolv1beta1.ProviderType
 
 	IgnoreWatchErrors func(bool)
+
+	// Objects, when not empty, limits the controller to these objects of its reconciled
+	// kind. It is set for notification-driven runs narrowed to the notified objects.
+	Objects []types.NamespacedName
+
+	// ObserveObject, when set, is called for every object of the reconciled kind the
+	// controller receives an event for. It must not block.
+	ObserveObject func(obj client.Object)
+
+	// ObserveReconcile, when set, is called when the controller starts reconciling a
+	// request and the returned func when the reconcile returned. It must not block.
+	ObserveReconcile func(request reconcile.Request) (done func(err error))
 }
 
 type ReconcilerFactory interface {
@@ -1,9 +1,13 @@
 package registry
 
 import (
+	"context"
 	"errors"
+	"reflect"
+
 	"github.com/go-logr/logr"
 	"github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -130,9 +134,56 @@ func (b *skrBuilder) Complete() error {
 
 func (b *skrBuilder) SetupWithManager(mngr manager.Manager, args reconcile.ReconcilerArguments) error {
 	r := b.factory.New(args)
+	if args.ObserveReconcile != nil {
+		r = observedReconciler(r, args.ObserveReconcile)
+	}
 	cb := ctrl.NewControllerManagedBy(mngr)
 	for _, i := range b.items {
 		i(cb)
 	}
+	if args.ObserveObject != nil {
+		cb.WithEventFilter(objectsObserver(b.forObj, args.ObserveObject))
+	}
+	if len(args.Objects) > 0 {
+		cb.WithEventFilter(objectsFilter(b.forObj, args.Objects))
+	}
 	return cb.Complete(r)
 }
+
+// observedReconciler wraps r so observe sees the start and the outcome of each reconcile.
+func observedReconciler(r ctrlreconcile.Reconciler, observe func(request ctrlreconcile.Request) func(err error)) ctrlreconcile.Reconciler {
+	return ctrlreconcile.Func(func(ctx context.Context, request ctrlreconcile.Request) (ctrlreconcile.Result, error) {
+		done := observe(request)
+		res, err := r.Reconcile(ctx, request)
+		done(err)
+		return res, err
+	})
+}
+
+// objectsObserver passes all events and calls observe for objects of the reconciled kind.
+func objectsObserver(forObj client.Object, observe func(obj client.Object)) predicate.Predicate {
+	forType := reflect.TypeOf(forObj)
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if reflect.TypeOf(obj) == forType {
+			observe(obj)
+		}
+		return true
+	})
+}
+
+// objectsFilter passes events of the reconciled kind only for the given objects. Events
+// of owned and watched kinds pass unchanged, their handlers map them to requests.
+func objectsFilter(forObj client.Object, objects []types.NamespacedName) predicate.Predicate {
+	forType := reflect.TypeOf(forObj)
+	keys := make(map[types.NamespacedName]struct{}, len(objects))
+	for _, k := range objects {
+		keys[k] = struct{}{}
+	}
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if reflect.TypeOf(obj) != forType {
+			return true
+		}
+		_, ok := keys[client.ObjectKeyFromObject(obj)]
+		return ok
+	})
+}
@@ -57,9 +57,10 @@ func createSnapshot(ctx context.Context, st composed.State) (error, context.Cont
 	annotations := make(map[string]string)
 	maps.Copy(annotations, sapSchedule.Spec.Template.Annotations)
 
-	// Determine deleteAfterDays: override with MaxRetentionDays if set
+	// Determine deleteAfterDays: override with MaxRetentionDays if set,
+	// unless the retention policy decides when the snapshot is deleted
 	deleteAfterDays := sapSchedule.Spec.Template.Spec.DeleteAfterDays
-	if schedule.GetMaxRetentionDays() > 0 {
+	if schedule.GetMaxRetentionDays() > 0 && schedule.GetRetentionPolicy() == nil {
 		deleteAfterDays = schedule.GetMaxRetentionDays()
 	}
 
@@ -76,6 +77,7 @@ func createSnapshot(ctx context.Context, st composed.State) (error, context.Cont
 				Namespace: state.Source.Namespace,
 			},
 			DeleteAfterDays: deleteAfterDays,
+			Hooks:           sapSchedule.Spec.Template.Spec.Hooks.DeepCopy(),
 		},
 	}
 
@@ -28,8 +28,10 @@ func deleteSnapshots(ctx context.Context, st composed.State) (error, context.Con
 		return nil, ctx
 	}
 
-	// If the number of snapshots is zero, OR maxRetentionDays is not positive, requeue to update next run time
-	if len(state.Snapshots) == 0 || schedule.GetMaxRetentionDays() <= 0 {
+	// If the number of snapshots is zero, OR maxRetentionDays is not positive and there is no retention policy,
+	// requeue to update next run time
+	policy := schedule.GetRetentionPolicy()
+	if len(state.Snapshots) == 0 || (schedule.GetMaxRetentionDays() <= 0 && policy == nil) {
 		schedule.SetLastDeleteRun(&metav1.Time{Time: state.nextRunTime.UTC()})
 		schedule.SetNextDeleteTimes(nil)
 		schedule.SetLastDeletedBackups(nil)
@@ -46,12 +48,23 @@ func deleteSnapshots(ctx context.Context, st composed.State) (error, context.Con
 	var lastDeleted []corev1.ObjectReference
 	readyCount, failedCount := 0, 0
 
+	// Ready snapshots outside every bucket of the retention policy are deleted
+	var readySnapshots []*cloudresourcesv1beta1.SapNfsVolumeSnapshot
+	for _, snapshot := range state.Snapshots {
+		if snapshot.Status.State == cloudresourcesv1beta1.StateReady {
+			readySnapshots = append(readySnapshots, snapshot)
+		}
+	}
+	retained := backupschedule.RetainedByPolicy(policy, readySnapshots)
+
 	for _, snapshot := range state.Snapshots {
 		// Check if the snapshot object should be deleted
+		retainedByPolicy := policy != nil && snapshot.Status.State == cloudresourcesv1beta1.StateReady
 		toRetain := time.Duration(schedule.GetMaxRetentionDays()) * 24 * time.Hour
 		elapsed := time.Since(snapshot.GetCreationTimestamp().Time)
-		if elapsed > toRetain ||
-			(snapshot.Status.State == cloudresourcesv1beta1.StateReady && readyCount >= schedule.GetMaxReadyBackups()) ||
+		if (retainedByPolicy && !retained.Has(snapshot.GetName())) ||
+			(!retainedByPolicy && elapsed > toRetain) ||
+			(!retainedByPolicy && snapshot.Status.State == cloudresourcesv1beta1.StateReady && readyCount >= schedule.GetMaxReadyBackups()) ||
 			(snapshot.Status.State == cloudresourcesv1beta1.StateFailed && failedCount >= schedule.GetMaxFailedBackups()) {
 			logger.WithValues("Snapshot", snapshot.GetName()).Info("Deleting snapshot object")
 			err := state.Cluster().K8sClient().Delete(ctx, snapshot)
@@ -71,7 +84,8 @@ func deleteSnapshots(ctx context.Context, st composed.State) (error, context.Con
 				failedCount++
 			}
 		}
-		if uint(len(nextDeleteTimes)) < backupschedule.MaxSchedules {
+		// The deletion time of snapshots retained by the policy is not known in advance
+		if uint(len(nextDeleteTimes)) < backupschedule.MaxSchedules && !retainedByPolicy {
 			backupName := fmt.Sprintf("%s/%s", snapshot.GetNamespace(), snapshot.GetName())
 			deleteTime := snapshot.GetCreationTimestamp().AddDate(0, 0, schedule.GetMaxRetentionDays())
 			nextDeleteTimes[backupName] = deleteTime.UTC().Format(time.RFC3339)
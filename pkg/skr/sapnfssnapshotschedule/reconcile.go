@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/types"
@@ -59,6 +60,7 @@ func (r *Reconciler) newAction() composed.Action {
 			composed.ComposeActions(
 				"sapNfsVolumeSnapshotSchedule-main",
 				// Common scheduling actions
+				quotacheck.New(nil),
 				backupschedule.CheckCompleted,
 				backupschedule.CheckSuspension,
 				backupschedule.ValidateSchedule,
@@ -96,3 +96,11 @@ func (f *stateFactory) NewState(ctx context.Context, baseState composed.State) (
 		Scheduler:  backupschedule.NewScheduleCalculator(f.clk, 1*time.Second),
 	}, nil
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.ObjAsBackupSchedule().GetLastCreateRun() != nil && !s.ObjAsBackupSchedule().GetLastCreateRun().IsZero()
+}
@@ -6,6 +6,7 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -50,6 +51,7 @@ func kcpNfsInstanceCreate(ctx context.Context, st composed.State) (error, contex
 		},
 	}
 
+	tracing.InjectIntoObj(ctx, state.KcpNfsInstance)
 	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpNfsInstance)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating KCP NfsInstance for SapNfsVolume", composed.StopWithRequeue, ctx)
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -69,6 +70,7 @@ func (r *reconciler) newAction() composed.Action {
 
 		kcpNfsInstanceLoad,
 		dataSourceSnapshotLoad,
+		quotacheck.New(QuotaUsage),
 		kcpNfsInstanceCreate,
 		waitKcpNfsInstanceStatus,
 
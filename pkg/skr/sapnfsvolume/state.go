@@ -6,6 +6,7 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	corev1 "k8s.io/api/core/v1"
@@ -77,3 +78,20 @@ func (s *State) GetDataSourceSnapshotId() string {
 func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
 	return s.ObjAsSapNfsVolume()
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.KcpNfsInstance != nil
+}
+
+func (s *State) ProvisionedUsage() map[string]int {
+	if s.KcpNfsInstance == nil || s.KcpNfsInstance.Spec.Instance.OpenStack == nil {
+		return nil
+	}
+	return map[string]int{
+		quota.QuotaTotalCapacityGb: s.KcpNfsInstance.Spec.Instance.OpenStack.SizeGb,
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/backuphooks"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/quotacheck"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -15,6 +17,7 @@ import (
 type Reconciler struct {
 	composedStateFactory composed.StateFactory
 	stateFactory         StateFactory
+	executor             backuphooks.Executor
 }
 
 func (r *Reconciler) Run(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -45,15 +48,16 @@ func (r *Reconciler) newAction() composed.Action {
 		"crSapNfsVolumeSnapshotMain",
 		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.SapNfsVolumeSnapshot{}),
 		composed.LoadObj,
-		composeActions(),
+		composeActions(r.executor),
 	)
 }
 
-func composeActions() composed.Action {
+func composeActions(executor backuphooks.Executor) composed.Action {
 	return composed.ComposeActions(
 		"sapNfsVolumeSnapshot",
 		loadScope,
 		clientCreate,
+		backuphooks.NewResume(executor),
 		shortCircuit,
 		markFailed,
 		actions.AddCommonFinalizer(),
@@ -66,13 +70,16 @@ func composeActions() composed.Action {
 		composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
 			composed.ComposeActions(
 				"sapNfsVolumeSnapshot-create",
+				quotacheck.New(nil),
 				idGenerate,
+				backuphooks.New(executor),
 				snapshotCreate,
 				snapshotWaitAvailable,
 				statusReady,
 			),
 			composed.ComposeActions(
 				"sapNfsVolumeSnapshot-delete",
+				backuphooks.New(executor),
 				statusDeleting,
 				snapshotDelete,
 				snapshotWaitDeleted,
@@ -9,6 +9,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/backuphooks"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
@@ -93,11 +94,25 @@ func (f *ReconcilerFactory) New(
 	skrCluster composed.StateCluster,
 	provider sapclient.SapClientProvider[sapclient.SnapshotClient],
 	clk clock.Clock,
+	executor backuphooks.Executor,
 ) *Reconciler {
 	composedStateFactory := composed.NewStateFactory(skrCluster)
 	stateFactory := NewStateFactory(scopeProvider, kcpCluster, skrCluster, provider, clk)
 	return &Reconciler{
 		composedStateFactory: composedStateFactory,
 		stateFactory:         stateFactory,
+		executor:             executor,
 	}
 }
+
+func (s *State) GetKymaRef() klog.ObjectRef {
+	return s.KymaRef
+}
+
+func (s *State) IsProvisioned() bool {
+	return s.snapshot != nil
+}
+
+func (s *State) IsBackupTriggered() bool {
+	return s.snapshot != nil || s.ObjAsSapNfsVolumeSnapshot().Status.OpenstackId != ""
+}
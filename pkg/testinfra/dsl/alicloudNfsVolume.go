@@ -0,0 +1,52 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func WithAlicloudNfsVolumeCapacityGb(capacityGb int) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AlicloudNfsVolume); ok {
+				x.Spec.CapacityGb = capacityGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAlicloudNfsVolumeCapacityGb", obj))
+		},
+	}
+}
+
+func WithAlicloudNfsVolumeStorageType(storageType cloudresourcesv1beta1.AlicloudNfsStorageType) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AlicloudNfsVolume); ok {
+				x.Spec.StorageType = storageType
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAlicloudNfsVolumeStorageType", obj))
+		},
+	}
+}
+
+func CreateAlicloudNfsVolume(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AlicloudNfsVolume, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AlicloudNfsVolume{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR AlicloudNfsVolume must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
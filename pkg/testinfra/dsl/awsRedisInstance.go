@@ -183,3 +183,17 @@ func WithAwsRedisInstanceAuthSecretExtraData(extraData map[string]string) ObjAct
 		},
 	}
 }
+
+func WithAwsRedisInstanceStatusId(id string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AwsRedisInstance); ok {
+				if x.Status.Id == "" {
+					x.Status.Id = id
+				}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsRedisInstanceStatusId", obj))
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func WithAzureNfsVolumeCapacityGb(capacityGb int) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AzureNfsVolume); ok {
+				x.Spec.CapacityGb = capacityGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureNfsVolumeCapacityGb", obj))
+		},
+	}
+}
+
+func WithAzureNfsVolumeTier(tier cloudresourcesv1beta1.AzureNfsTier) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AzureNfsVolume); ok {
+				x.Spec.Tier = tier
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureNfsVolumeTier", obj))
+		},
+	}
+}
+
+func CreateAzureNfsVolume(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AzureNfsVolume, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AzureNfsVolume{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR AzureNfsVolume must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
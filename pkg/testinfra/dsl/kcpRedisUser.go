@@ -0,0 +1,104 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateKcpRedisUser(ctx context.Context, clnt client.Client, obj *cloudcontrolv1beta1.RedisUser, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudcontrolv1beta1.RedisUser{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultKcpNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the KCP RedisUser must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithKcpRedisUserRedis(kind cloudcontrolv1beta1.RedisUserTargetKind, name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.RedisUser); ok {
+				x.Spec.Redis = cloudcontrolv1beta1.RedisUserTarget{
+					Kind: kind,
+					Name: name,
+				}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpRedisUserRedis", obj))
+		},
+	}
+}
+
+func WithKcpRedisUserUserName(userName string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.RedisUser); ok {
+				x.Spec.UserName = userName
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpRedisUserUserName", obj))
+		},
+	}
+}
+
+func WithKcpRedisUserAcl(keyPatterns, commands []string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.RedisUser); ok {
+				x.Spec.KeyPatterns = keyPatterns
+				x.Spec.Commands = commands
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpRedisUserAcl", obj))
+		},
+	}
+}
+
+func WithKcpRedisUserAzureObjectId(objectId string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.RedisUser); ok {
+				x.Spec.Azure = &cloudcontrolv1beta1.RedisUserAzure{ObjectId: objectId}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpRedisUserAzureObjectId", obj))
+		},
+	}
+}
+
+func WithKcpRedisUserStatusEndpoint(endpoint string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.RedisUser); ok {
+				x.Status.Endpoint = endpoint
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpRedisUserStatusEndpoint", obj))
+		},
+	}
+}
+
+func WithKcpRedisUserStatusAuthString(authString string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.RedisUser); ok {
+				x.Status.AuthString = authString
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpRedisUserStatusAuthString", obj))
+		},
+	}
+}
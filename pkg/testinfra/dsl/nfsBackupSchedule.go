@@ -184,3 +184,15 @@ func WithGcpNfsBackupScheduleAccessibleFrom(accessibleFrom []string) ObjAction {
 		},
 	}
 }
+
+func WithGcpNfsBackupScheduleCopies(copies ...cloudresourcesv1beta1.GcpNfsBackupScheduleCopy) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.GcpNfsBackupSchedule); ok {
+				x.Spec.Copies = copies
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpNfsBackupScheduleCopies", obj))
+		},
+	}
+}
@@ -122,6 +122,19 @@ func WithNfsInstanceAlicloud() ObjAction {
 	}
 }
 
+func WithNfsInstanceAzure(capacityGb int) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.NfsInstance); ok {
+				x.Spec.Instance.Azure = &cloudcontrolv1beta1.NfsInstanceAzure{
+					CapacityGb: capacityGb,
+					Sku:        cloudcontrolv1beta1.AzureNfsSkuPremiumLRS,
+				}
+			}
+		},
+	}
+}
+
 func WithNfsInstanceGcp(location string) ObjAction {
 	return &objAction{
 		f: func(obj client.Object) {
@@ -0,0 +1,71 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateSkrRedisUser(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.RedisUser, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.RedisUser{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR RedisUser must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithSkrRedisUserRedisRef(kind cloudresourcesv1beta1.RedisUserRedisKind, name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.RedisUser); ok {
+				x.Spec.RedisRef = cloudresourcesv1beta1.RedisUserRedisRef{
+					Kind: kind,
+					Name: name,
+				}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSkrRedisUserRedisRef", obj))
+		},
+	}
+}
+
+func WithSkrRedisUserAcl(keyPatterns, commands []string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.RedisUser); ok {
+				x.Spec.KeyPatterns = keyPatterns
+				x.Spec.Commands = commands
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSkrRedisUserAcl", obj))
+		},
+	}
+}
+
+func WithSkrRedisUserAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.RedisUser); ok {
+				if x.Spec.AuthSecret == nil {
+					x.Spec.AuthSecret = &cloudresourcesv1beta1.RedisAuthSecretSpec{}
+				}
+				x.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSkrRedisUserAuthSecretName", obj))
+		},
+	}
+}
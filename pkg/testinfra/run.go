@@ -252,6 +252,7 @@ func StartEx(opts StartOptions) (Infra, error) {
 	_ = os.Setenv("GCP_API_TIMEOUT_DURATION", "300ms")
 	_ = os.Setenv("AWS_EFS_CAPACITY_CHECK_INTERVAL", "1s")
 	_ = os.Setenv("GCP_CAPACITY_CHECK_INTERVAL", "1s")
+	_ = os.Setenv("ALICLOUD_NAS_CAPACITY_CHECK_INTERVAL", "1s")
 
 	// init config
 	commonconfig.LoadConfigInstance(infra.Config())
@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TraceParentAnnotation holds the W3C traceparent of the flow that created the object,
+// so reconciliations of the object in another cluster continue the same trace.
+const TraceParentAnnotation = "cloud-manager.kyma-project.io/traceparent"
+
+const traceParentHeader = "traceparent"
+
+// InjectIntoObj sets the TraceParentAnnotation to the span in the ctx. Objects are
+// left untouched if the ctx has no valid span, so with tracing disabled no annotation is set.
+func InjectIntoObj(ctx context.Context, obj metav1.Object) {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	traceParent := carrier.Get(traceParentHeader)
+	if traceParent == "" {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[TraceParentAnnotation] = traceParent
+	obj.SetAnnotations(annotations)
+}
+
+// ExtractFromObj returns the ctx with the remote span from the TraceParentAnnotation of
+// the object as the parent of spans started later. If the object has no valid annotation
+// the ctx is returned unchanged.
+func ExtractFromObj(ctx context.Context, obj metav1.Object) context.Context {
+	traceParent, ok := obj.GetAnnotations()[TraceParentAnnotation]
+	if !ok {
+		return ctx
+	}
+	sc := trace.SpanContextFromContext(
+		propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{traceParentHeader: traceParent}),
+	)
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
@@ -0,0 +1,36 @@
+package tracing
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RecordCloudProviderCall records the finished cloud provider API call as a child span of
+// the span in the ctx. It is called from the same provider hooks that report the call
+// metrics, after the call is done, so the span is created with the call start timestamp.
+func RecordCloudProviderCall(ctx context.Context, provider, method, responseCode string, start time.Time, err error) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	_, span := Tracer().Start(ctx, provider+" "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(
+			attribute.String("cloud.provider", provider),
+			attribute.String("rpc.method", method),
+			attribute.String("response_code", responseCode),
+		),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if code, convErr := strconv.Atoi(responseCode); convErr == nil && code >= 400 {
+		span.SetStatus(codes.Error, "response code "+responseCode)
+	}
+	span.End()
+}
@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"github.com/kyma-project/cloud-manager/pkg/config"
+)
+
+const (
+	ExporterNone     = "none"
+	ExporterOtlpGrpc = "otlpgrpc"
+	ExporterOtlpHttp = "otlphttp"
+)
+
+type ConfigStruct struct {
+	// Exporter is one of none, otlpgrpc or otlphttp. Tracing is disabled with none.
+	Exporter string `json:"exporter,omitempty" yaml:"exporter,omitempty"`
+	// Endpoint is the host:port of the OTLP collector. If empty the exporter default is used.
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Insecure bool   `json:"insecure,omitempty" yaml:"insecure,omitempty"`
+	// SampleRatio is the ratio of traces sampled when there is no sampled parent.
+	SampleRatio float64 `json:"sampleRatio,omitempty" yaml:"sampleRatio,omitempty"`
+	ServiceName string  `json:"serviceName,omitempty" yaml:"serviceName,omitempty"`
+}
+
+func (c *ConfigStruct) AfterConfigLoaded() {
+	if c.Exporter == "" {
+		c.Exporter = ExporterNone
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		c.SampleRatio = 1
+	}
+	if c.ServiceName == "" {
+		c.ServiceName = "cloud-manager"
+	}
+}
+
+func (c *ConfigStruct) IsEnabled() bool {
+	return c.Exporter != "" && c.Exporter != ExporterNone
+}
+
+var TracingConfig = &ConfigStruct{}
+
+func InitConfig(cfg config.Config) {
+	cfg.Path(
+		"tracing",
+		config.Path(
+			"exporter",
+			config.DefaultScalar(ExporterNone),
+			config.SourceEnv("TRACING_EXPORTER"),
+		),
+		config.Path(
+			"endpoint",
+			config.SourceEnv("TRACING_ENDPOINT"),
+		),
+		config.Path(
+			"insecure",
+			config.DefaultScalar(false),
+			config.SourceEnv("TRACING_INSECURE"),
+		),
+		config.Path(
+			"sampleRatio",
+			config.DefaultScalar(1.0),
+			config.SourceEnv("TRACING_SAMPLE_RATIO"),
+		),
+		config.Path(
+			"serviceName",
+			config.DefaultScalar("cloud-manager"),
+		),
+		config.SourceFile("tracing.yaml"),
+		config.Bind(TracingConfig),
+	)
+}
@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/kyma-project/cloud-manager"
+
+// Tracer returns the cloud-manager tracer from the global tracer provider. Until Init
+// is called the global provider is a noop, so spans cost nothing when tracing is disabled.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Init creates the exporter configured in the ConfigStruct and installs the tracer provider
+// exporting to it as the global one. It returns the function flushing and stopping the
+// provider, that should be called on shutdown. If tracing is disabled nothing is installed.
+func Init(ctx context.Context, cfg *ConfigStruct) (func(context.Context) error, error) {
+	if !cfg.IsEnabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	tp := NewTracerProvider(cfg, sdktrace.WithBatcher(exporter))
+	Install(tp)
+	return tp.Shutdown, nil
+}
+
+// NewTracerProvider returns the provider sampling as configured in the ConfigStruct.
+// Tests can pass sdktrace.WithSyncer with an in-memory exporter instead of the
+// configured one.
+func NewTracerProvider(cfg *ConfigStruct, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	opts = append([]sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))),
+	}, opts...)
+	return sdktrace.NewTracerProvider(opts...)
+}
+
+// Install sets the given provider and the W3C trace context propagator as global.
+func Install(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+func newExporter(ctx context.Context, cfg *ConfigStruct) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterOtlpGrpc:
+		var opts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ExporterOtlpHttp:
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+	return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+}
@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func installInMemory(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	prev := otel.GetTracerProvider()
+	Install(NewTracerProvider(&ConfigStruct{SampleRatio: 1, ServiceName: "test"}, sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+	})
+	return exporter
+}
+
+func TestAnnotationPropagation(t *testing.T) {
+	exporter := installInMemory(t)
+
+	ctx, span := Tracer().Start(context.Background(), "skr")
+	obj := &metav1.ObjectMeta{}
+	InjectIntoObj(ctx, obj)
+	span.End()
+
+	assert.Contains(t, obj.Annotations[TraceParentAnnotation], span.SpanContext().TraceID().String())
+
+	kcpCtx := ExtractFromObj(context.Background(), obj)
+	_, kcpSpan := Tracer().Start(kcpCtx, "kcp")
+	kcpSpan.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+}
+
+func TestInjectWithoutSpan(t *testing.T) {
+	_ = installInMemory(t)
+
+	obj := &metav1.ObjectMeta{}
+	InjectIntoObj(context.Background(), obj)
+	assert.Empty(t, obj.Annotations)
+
+	ctx := context.Background()
+	assert.Equal(t, ctx, ExtractFromObj(ctx, &metav1.ObjectMeta{Annotations: map[string]string{TraceParentAnnotation: "invalid"}}))
+}
+
+func TestRecordCloudProviderCall(t *testing.T) {
+	exporter := installInMemory(t)
+
+	start := time.Now().Add(-time.Second)
+	RecordCloudProviderCall(context.Background(), "gcp", "GET /projects/{id}", "200", start, nil)
+	assert.Empty(t, exporter.GetSpans(), "call without parent span should not be recorded")
+
+	ctx, span := Tracer().Start(context.Background(), "reconcile")
+	RecordCloudProviderCall(ctx, "gcp", "GET /projects/{id}", "200", start, nil)
+	RecordCloudProviderCall(ctx, "gcp", "POST /projects/{id}", "429", start, nil)
+	RecordCloudProviderCall(ctx, "gcp", "DELETE /projects/{id}", "-1", start, errors.New("connection refused"))
+	span.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 4)
+	assert.Equal(t, "gcp GET /projects/{id}", spans[0].Name)
+	assert.Equal(t, start, spans[0].StartTime)
+	assert.Equal(t, span.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Equal(t, codes.Error, spans[2].Status.Code)
+}
@@ -3,6 +3,8 @@ package util
 import (
 	"context"
 	"errors"
+	"net/http"
+	"sync"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -39,3 +41,98 @@ func IgnoreContextDeadlineExceeded(err error) error {
 func IgnoreContextCanceledAndDeadlineExceeded(err error) error {
 	return IgnoreContextCanceled(IgnoreContextDeadlineExceeded(err))
 }
+
+// Error classes are the bounded set of values used to label reconcile outcomes
+// in metrics, so alerts can be written per kind of failure without exploding cardinality.
+const (
+	ErrorClassNone        = "none"
+	ErrorClassCanceled    = "canceled"
+	ErrorClassDeadline    = "deadline"
+	ErrorClassNotFound    = "not_found"
+	ErrorClassConflict    = "conflict"
+	ErrorClassForbidden   = "forbidden"
+	ErrorClassInvalid     = "invalid"
+	ErrorClassQuota       = "quota"
+	ErrorClassThrottled   = "throttled"
+	ErrorClassUnavailable = "unavailable"
+	ErrorClassOther       = "other"
+)
+
+// ErrorClassifier returns one of the ErrorClass constants for errors it recognizes,
+// or an empty string if the error is not known to it.
+type ErrorClassifier func(err error) string
+
+var (
+	errorClassifiersMu sync.RWMutex
+	errorClassifiers   []ErrorClassifier
+)
+
+// RegisterErrorClassifier adds the classifier consulted by ClassifyError. Providers
+// register their classifiers from their meta package, since only they know the
+// error types of their SDKs.
+func RegisterErrorClassifier(c ErrorClassifier) {
+	errorClassifiersMu.Lock()
+	defer errorClassifiersMu.Unlock()
+	errorClassifiers = append(errorClassifiers, c)
+}
+
+// ClassifyError maps the error to one of the ErrorClass constants. Context errors are
+// checked first, then the registered provider classifiers, and the k8s api errors last.
+// Unrecognized errors are classified as ErrorClassOther.
+func ClassifyError(err error) string {
+	if err == nil {
+		return ErrorClassNone
+	}
+	if errors.Is(err, context.Canceled) {
+		return ErrorClassCanceled
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassDeadline
+	}
+
+	errorClassifiersMu.RLock()
+	classifiers := errorClassifiers
+	errorClassifiersMu.RUnlock()
+	for _, c := range classifiers {
+		if class := c(err); class != "" {
+			return class
+		}
+	}
+
+	switch {
+	case apierrors.IsNotFound(err):
+		return ErrorClassNotFound
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		return ErrorClassConflict
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return ErrorClassForbidden
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return ErrorClassInvalid
+	case apierrors.IsTooManyRequests(err):
+		return ErrorClassThrottled
+	case apierrors.IsServerTimeout(err), apierrors.IsTimeout(err), apierrors.IsServiceUnavailable(err), apierrors.IsInternalError(err):
+		return ErrorClassUnavailable
+	}
+
+	return ErrorClassOther
+}
+
+// ErrorClassFromHttpStatus maps the http response status code to the error class, or
+// returns an empty string if the status code does not denote an error.
+func ErrorClassFromHttpStatus(statusCode int) string {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrorClassNotFound
+	case statusCode == http.StatusConflict:
+		return ErrorClassConflict
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return ErrorClassForbidden
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorClassThrottled
+	case statusCode >= 500:
+		return ErrorClassUnavailable
+	case statusCode >= 400:
+		return ErrorClassInvalid
+	}
+	return ""
+}
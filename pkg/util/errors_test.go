@@ -3,9 +3,12 @@ package util
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestContextErrors(t *testing.T) {
@@ -50,3 +53,43 @@ func TestContextErrors(t *testing.T) {
 	})
 
 }
+
+func TestClassifyError(t *testing.T) {
+	gr := schema.GroupResource{Group: "cloud-resources.kyma-project.io", Resource: "iprange"}
+
+	testData := []struct {
+		title    string
+		err      error
+		expected string
+	}{
+		{"nil", nil, ErrorClassNone},
+		{"canceled", fmt.Errorf("wrapped: %w", context.Canceled), ErrorClassCanceled},
+		{"deadline", context.DeadlineExceeded, ErrorClassDeadline},
+		{"k8s not found", apierrors.NewNotFound(gr, "a"), ErrorClassNotFound},
+		{"k8s conflict", apierrors.NewConflict(gr, "a", errors.New("x")), ErrorClassConflict},
+		{"k8s already exists", apierrors.NewAlreadyExists(gr, "a"), ErrorClassConflict},
+		{"k8s forbidden", apierrors.NewForbidden(gr, "a", errors.New("x")), ErrorClassForbidden},
+		{"k8s bad request", apierrors.NewBadRequest("x"), ErrorClassInvalid},
+		{"k8s too many requests", apierrors.NewTooManyRequests("x", 1), ErrorClassThrottled},
+		{"k8s unavailable", apierrors.NewServiceUnavailable("x"), ErrorClassUnavailable},
+		{"other", errors.New("some error"), ErrorClassOther},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifyError(tt.err))
+		})
+	}
+
+	t.Run("registered classifier", func(t *testing.T) {
+		quotaErr := errors.New("test quota exceeded")
+		RegisterErrorClassifier(func(err error) string {
+			if errors.Is(err, quotaErr) {
+				return ErrorClassQuota
+			}
+			return ""
+		})
+		assert.Equal(t, ErrorClassQuota, ClassifyError(fmt.Errorf("wrapped: %w", quotaErr)))
+		assert.Equal(t, ErrorClassOther, ClassifyError(errors.New("some error")))
+	})
+}